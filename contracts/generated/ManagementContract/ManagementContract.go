@@ -41,7 +41,8 @@ type StructsCrossChainMessage struct {
 
 // StructsHeaderCrossChainData is an auto generated low-level Go binding around an user-defined struct.
 type StructsHeaderCrossChainData struct {
-	Messages []StructsCrossChainMessage
+	Messages        []StructsCrossChainMessage
+	CrossChainRoots [][32]byte
 }
 
 // StructsMetaRollup is an auto generated low-level Go binding around an user-defined struct.
@@ -51,10 +52,19 @@ type StructsMetaRollup struct {
 	LastSequenceNumber *big.Int
 }
 
+// StructsValueTransferMessage is an auto generated low-level Go binding around an user-defined struct.
+type StructsValueTransferMessage struct {
+	Sender        common.Address
+	Receiver      common.Address
+	Amount        *big.Int
+	BatchSequence uint64
+	Index         uint32
+}
+
 // ManagementContractMetaData contains all meta data concerning the ManagementContract contract.
 var ManagementContractMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"_rollupData\",\"type\":\"string\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"crossChainData\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetHostAddresses\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_aggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_hostAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
-	Bin: "0x608060405234801561001057600080fd5b5061001a3361001f565b610090565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b612f5a8061009f6000396000f3fe60806040523480156200001157600080fd5b50600436106200016c5760003560e01c80638129fc1c11620000dd578063a1a227fa116200008b578063bbd79e15116200006e578063bbd79e1514620003c6578063e34fbfc814620003dd578063f2fde38b14620003f457600080fd5b8063a1a227fa14620003a1578063a52f433c14620003b557600080fd5b80638da5cb5b11620000c05780638da5cb5b14620003335780638fa0d053146200036457806398077e86146200037b57600080fd5b80638129fc1c146200028a5780638236a7ba146200029457600080fd5b8063440c953b116200013b5780636a30d26c116200011e5780636a30d26c146200026c578063715018a6146200027657806372810996146200028057600080fd5b8063440c953b146200023c57806359a90071146200025557600080fd5b806303e72e481462000171578063324ff866146200018a5780633e60a22f14620001ac57806343348b2f14620001fc575b600080fd5b620001886200018236600462001492565b6200040b565b005b620001946200051e565b604051620001a391906200153e565b60405180910390f35b620001e3620001bd366004620015a4565b80516020818301810180516004825292820191909301209152546001600160a01b031681565b6040516001600160a01b039091168152602001620001a3565b6200022b6200020d366004620015e5565b6001600160a01b031660009081526001602052604090205460ff1690565b6040519015158152602001620001a3565b6200024660065481565b604051908152602001620001a3565b620001886200026636600462001658565b62000601565b6200019462000687565b6200018862000761565b6200018862000779565b62000188620007fd565b620002ff620002a53660046200170b565b6040805160608082018352600080835260208084018290529284018190528481526007835283902083519182018452805480835260018201546001600160a01b031693830193909352600201549281019290925290911491565b60408051921515835281516020808501919091528201516001600160a01b03168382015201516060820152608001620001a3565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b0316620001e3565b620001886200037536600462001725565b620009d1565b620003926200038c3660046200170b565b62000a6c565b604051620001a39190620017b3565b600854620001e3906001600160a01b031681565b600554610100900460ff166200022b565b62000188620003d7366004620017c8565b62000b21565b62000188620003ee3660046200189d565b62000c89565b6200018862000405366004620015e5565b62000caa565b6200041562000d07565b60006001600160a01b0316600483604051620004329190620018e3565b908152604051908190036020019020546001600160a01b0316036200049157600380546001810182556000919091527fc2575a0e9e593c00f959f8c92f12db2869c3395a3b0502d05e2516446f71f85b016200048f83826200198f565b505b80600483604051620004a49190620018e3565b90815260405190819003602001812080546001600160a01b039390931673ffffffffffffffffffffffffffffffffffffffff19909316929092179091557f17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb59062000512908490849062001a5c565b60405180910390a15050565b60606002805480602002602001604051908101604052809291908181526020016000905b82821015620005f8578382906000526020600020018054620005649062001901565b80601f0160208091040260200160405190810160405280929190818152602001828054620005929062001901565b8015620005e35780601f10620005b757610100808354040283529160200191620005e3565b820191906000526020600020905b815481529060010190602001808311620005c557829003601f168201915b50505050508152602001906001019062000542565b50505050905090565b60055460ff16156200061257600080fd5b60058054600160ff1991821681179092556001600160a01b03881660009081526020839052604081208054909216831790915560028054928301815590527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace016200067e84826200198f565b50505050505050565b60606003805480602002602001604051908101604052809291908181526020016000905b82821015620005f8578382906000526020600020018054620006cd9062001901565b80601f0160208091040260200160405190810160405280929190818152602001828054620006fb9062001901565b80156200074c5780601f1062000720576101008083540402835291602001916200074c565b820191906000526020600020905b8154815290600101906020018083116200072e57829003601f168201915b505050505081526020019060010190620006ab565b6200076b62000d07565b62000777600062000d7e565b565b6200078362000d07565b6008546040517f36d2da900000000000000000000000000000000000000000000000000000000081523360048201526001600160a01b03909116906336d2da9090602401600060405180830381600087803b158015620007e257600080fd5b505af1158015620007f7573d6000803e3d6000fd5b50505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff16600081158015620008495750825b905060008267ffffffffffffffff166001148015620008675750303b155b90508115801562000876575080155b15620008ae576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff191660011785558315620008e357845468ff00000000000000001916680100000000000000001785555b620008ee3362000dfc565b60006006556040516200090190620013c3565b604051809103906000f0801580156200091e573d6000803e3d6000fd5b506008805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039290921691821790556040519081527fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf9060200160405180910390a18315620009ca57845468ff000000000000000019168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050565b60016000620009e76040870160208801620015e5565b6001600160a01b0316815260208101919091526040016000205460ff1662000a565760405162461bcd60e51b815260206004820152601760248201527f61676772656761746f72206e6f7420617474657374656400000000000000000060448201526064015b60405180910390fd5b62000a618462000e11565b620007f78162000e49565b6003818154811062000a7d57600080fd5b90600052602060002001600091509050805462000a9a9062001901565b80601f016020809104026020016040519081016040528092919081815260200182805462000ac89062001901565b801562000b195780601f1062000aed5761010080835404028352916020019162000b19565b820191906000526020600020905b81548152906001019060200180831162000afb57829003601f168201915b505050505081565b6001600160a01b03861660009081526001602052604090205460ff168062000b4857600080fd5b811562000c2157600062000b838888868860405160200162000b6e949392919062001a89565b60405160208183030381529060405262000f14565b9050600062000b93828862000f53565b9050886001600160a01b0316816001600160a01b03161462000c1e5760405162461bcd60e51b815260206004820152602c60248201527f63616c63756c61746564206164647265737320616e642061747465737465724960448201527f4420646f6e74206d617463680000000000000000000000000000000000000000606482015260840162000a4d565b50505b6001600160a01b03861660009081526001602081905260408220805460ff1916821790556002805491820181559091527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace0162000c7f84826200198f565b5050505050505050565b33600090815260208190526040902062000ca582848362001ae9565b505050565b62000cb462000d07565b6001600160a01b03811662000cf9576040517f1e4fbdf70000000000000000000000000000000000000000000000000000000081526000600482015260240162000a4d565b62000d048162000d7e565b50565b3362000d3a7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b03161462000777576040517f118cdaa700000000000000000000000000000000000000000000000000000000815233600482015260240162000a4d565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300805473ffffffffffffffffffffffffffffffffffffffff1981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b62000e0662000f81565b62000d048162000fe9565b80356000908152600760205260409020819062000e2f828262001bb6565b50506006546040820135111562000d045760400135600655565b600062000e57828062001c04565b9050905060005b8181101562000ca5576008546001600160a01b0316639730886d62000e84858062001c04565b8481811062000e975762000e9762001c51565b905060200281019062000eab919062001c67565b60016040518363ffffffff1660e01b815260040162000ecc92919062001d1c565b600060405180830381600087803b15801562000ee757600080fd5b505af115801562000efc573d6000803e3d6000fd5b505050508062000f0c9062001de0565b905062000e5e565b600062000f22825162000ff3565b8260405160200162000f3692919062001e08565b604051602081830303815290604052805190602001209050919050565b60008060008062000f6586866200109a565b92509250925062000f778282620010eb565b5090949350505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff1662000777576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b62000cb462000f81565b60606000620010028362001205565b600101905060008167ffffffffffffffff811115620010255762001025620013d1565b6040519080825280601f01601f19166020018201604052801562001050576020820181803683370190505b5090508181016020015b600019017f3031323334353637383961626364656600000000000000000000000000000000600a86061a8153600a85049450846200105a57509392505050565b60008060008351604103620010d85760208401516040850151606086015160001a620010c988828585620012ef565b955095509550505050620010e4565b50508151600091506002905b9250925092565b600082600381111562001102576200110262001e67565b036200110c575050565b600182600381111562001123576200112362001e67565b036200115b576040517ff645eedf00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600282600381111562001172576200117262001e67565b03620011ae576040517ffce698f70000000000000000000000000000000000000000000000000000000081526004810182905260240162000a4d565b6003826003811115620011c557620011c562001e67565b0362001201576040517fd78bce0c0000000000000000000000000000000000000000000000000000000081526004810182905260240162000a4d565b5050565b6000807a184f03e93ff9f4daa797ed6e38ed64bf6a1f01000000000000000083106200124f577a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000830492506040015b6d04ee2d6d415b85acef810000000083106200127c576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc1000083106200129b57662386f26fc10000830492506010015b6305f5e1008310620012b4576305f5e100830492506008015b6127108310620012c957612710830492506004015b60648310620012dc576064830492506002015b600a8310620012e9576001015b92915050565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156200132c5750600091506003905082620013b9565b604080516000808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa15801562001381573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b038116620013af57506000925060019150829050620013b9565b9250600091508190505b9450945094915050565b6110a78062001e7e83390190565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620013f957600080fd5b813567ffffffffffffffff80821115620014175762001417620013d1565b604051601f8301601f19908116603f01168101908282118183101715620014425762001442620013d1565b816040528381528660208588010111156200145c57600080fd5b836020870160208301376000602085830101528094505050505092915050565b6001600160a01b038116811462000d0457600080fd5b60008060408385031215620014a657600080fd5b823567ffffffffffffffff811115620014be57600080fd5b620014cc85828601620013e7565b9250506020830135620014df816200147c565b809150509250929050565b60005b8381101562001507578181015183820152602001620014ed565b50506000910152565b600081518084526200152a816020860160208601620014ea565b601f01601f19169290920160200192915050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200159757603f198886030184526200158485835162001510565b9450928501929085019060010162001565565b5092979650505050505050565b600060208284031215620015b757600080fd5b813567ffffffffffffffff811115620015cf57600080fd5b620015dd84828501620013e7565b949350505050565b600060208284031215620015f857600080fd5b813562001605816200147c565b9392505050565b60008083601f8401126200161f57600080fd5b50813567ffffffffffffffff8111156200163857600080fd5b6020830191508360208285010111156200165157600080fd5b9250929050565b600080600080600080608087890312156200167257600080fd5b86356200167f816200147c565b9550602087013567ffffffffffffffff808211156200169d57600080fd5b620016ab8a838b016200160c565b90975095506040890135915080821115620016c557600080fd5b620016d38a838b01620013e7565b94506060890135915080821115620016ea57600080fd5b50620016f989828a016200160c565b979a9699509497509295939492505050565b6000602082840312156200171e57600080fd5b5035919050565b60008060008084860360a08112156200173d57600080fd5b60608112156200174c57600080fd5b50849350606085013567ffffffffffffffff808211156200176c57600080fd5b6200177a888389016200160c565b909550935060808701359150808211156200179457600080fd5b50850160208188031215620017a857600080fd5b939692955090935050565b60208152600062001605602083018462001510565b60008060008060008060c08789031215620017e257600080fd5b8635620017ef816200147c565b9550602087013562001801816200147c565b9450604087013567ffffffffffffffff808211156200181f57600080fd5b6200182d8a838b01620013e7565b955060608901359150808211156200184457600080fd5b620018528a838b01620013e7565b945060808901359150808211156200186957600080fd5b506200187889828a01620013e7565b92505060a087013580151581146200188f57600080fd5b809150509295509295509295565b60008060208385031215620018b157600080fd5b823567ffffffffffffffff811115620018c957600080fd5b620018d7858286016200160c565b90969095509350505050565b60008251620018f7818460208701620014ea565b9190910192915050565b600181811c908216806200191657607f821691505b6020821081036200193757634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111562000ca557600081815260208120601f850160051c81016020861015620019665750805b601f850160051c820191505b81811015620019875782815560010162001972565b505050505050565b815167ffffffffffffffff811115620019ac57620019ac620013d1565b620019c481620019bd845462001901565b846200193d565b602080601f831160018114620019fc5760008415620019e35750858301515b600019600386901b1c1916600185901b17855562001987565b600085815260208120601f198616915b8281101562001a2d5788860151825594840194600190910190840162001a0c565b508582101562001a4c5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60408152600062001a71604083018562001510565b90506001600160a01b03831660208301529392505050565b60006bffffffffffffffffffffffff19808760601b168352808660601b16601484015250835162001ac2816028850160208801620014ea565b83519083019062001adb816028840160208801620014ea565b016028019695505050505050565b67ffffffffffffffff83111562001b045762001b04620013d1565b62001b1c8362001b15835462001901565b836200193d565b6000601f84116001811462001b53576000851562001b3a5750838201355b600019600387901b1c1916600186901b178355620009ca565b600083815260209020601f19861690835b8281101562001b86578685013582556020948501946001909201910162001b64565b508682101562001ba45760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b8135815560018101602083013562001bce816200147c565b6001600160a01b03811673ffffffffffffffffffffffffffffffffffffffff198354161782555050604082013560028201555050565b6000808335601e1984360301811262001c1c57600080fd5b83018035915067ffffffffffffffff82111562001c3857600080fd5b6020019150600581901b36038213156200165157600080fd5b634e487b7160e01b600052603260045260246000fd5b6000823560be19833603018112620018f757600080fd5b803563ffffffff8116811462001c9357600080fd5b919050565b6000808335601e1984360301811262001cb057600080fd5b830160208101925035905067ffffffffffffffff81111562001cd157600080fd5b8036038213156200165157600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b803560ff8116811462001c9357600080fd5b604081526000833562001d2f816200147c565b6001600160a01b03166040830152602084013567ffffffffffffffff811680821462001d5a57600080fd5b60608401525062001d6e6040850162001c7e565b63ffffffff16608083015262001d876060850162001c7e565b63ffffffff1660a083015262001da1608085018562001c98565b60c08085015262001db86101008501828462001ce1565b91505062001dc960a0860162001d0a565b60ff1660e084015260209092019290925292915050565b60006001820162001e0157634e487b7160e01b600052601160045260246000fd5b5060010190565b7f19457468657265756d205369676e6564204d6573736167653a0a00000000000081526000835162001e4281601a850160208801620014ea565b83519083019062001e5b81601a840160208801620014ea565b01601a01949350505050565b634e487b7160e01b600052602160045260246000fdfe608060405234801561001057600080fd5b50338061003757604051631e4fbdf760e01b81526000600482015260240160405180910390fd5b61004081610046565b50610096565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b611002806100a56000396000f3fe6080604052600436106100b55760003560e01c80638da5cb5b1161006957806399a3ad211161004e57806399a3ad2114610269578063b1454caa14610289578063f2fde38b146102c257610129565b80638da5cb5b146102215780639730886d1461024957610129565b8063346633fb1161009a578063346633fb146101d957806336d2da90146101ec578063715018a61461020c57610129565b80630fcfbd111461017657806333a88c72146101a957610129565b36610129576040517f346633fb0000000000000000000000000000000000000000000000000000000081523360048201523460248201819052309163346633fb91906044016000604051808303818588803b15801561011357600080fd5b505af1158015610127573d6000803e3d6000fd5b005b60405162461bcd60e51b815260206004820152600b60248201527f756e737570706f7274656400000000000000000000000000000000000000000060448201526064015b60405180910390fd5b34801561018257600080fd5b50610196610191366004610945565b6102e2565b6040519081526020015b60405180910390f35b3480156101b557600080fd5b506101c96101c4366004610945565b610398565b60405190151581526020016101a0565b6101276101e736600461098f565b6103eb565b3480156101f857600080fd5b506101276102073660046109bb565b6104b7565b34801561021857600080fd5b50610127610566565b34801561022d57600080fd5b506000546040516001600160a01b0390911681526020016101a0565b34801561025557600080fd5b506101276102643660046109d8565b61057a565b34801561027557600080fd5b5061012761028436600461098f565b6106cc565b34801561029557600080fd5b506102a96102a4366004610a4e565b61077c565b60405167ffffffffffffffff90911681526020016101a0565b3480156102ce57600080fd5b506101276102dd3660046109bb565b6107d5565b600080826040516020016102f69190610b3a565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150806103915760405162461bcd60e51b815260206004820152602160248201527f54686973206d65737361676520776173206e65766572207375626d697474656460448201527f2e00000000000000000000000000000000000000000000000000000000000000606482015260840161016d565b9392505050565b600080826040516020016103ac9190610b3a565b60408051601f1981840301815291815281516020928301206000818152600190935291205490915080158015906103e35750428111155b949350505050565b6000341180156103fa57508034145b61046c5760405162461bcd60e51b815260206004820152603060248201527f417474656d7074696e6720746f2073656e642076616c756520776974686f757460448201527f2070726f766964696e6720457468657200000000000000000000000000000000606482015260840161016d565b604080513381526001600160a01b0384166020820152348183015290517ff1365f826a788d6c1a955db0eed5ba8642674219c4771f8c65918617511a15609181900360600190a15050565b6104bf61082c565b6000816001600160a01b03164760405160006040518083038185875af1925050503d806000811461050c576040519150601f19603f3d011682016040523d82523d6000602084013e610511565b606091505b50509050806105625760405162461bcd60e51b815260206004820152601460248201527f6661696c65642073656e64696e672076616c7565000000000000000000000000604482015260640161016d565b5050565b61056e61082c565b6105786000610872565b565b61058261082c565b600061058e8242610c3b565b90506000836040516020016105a39190610b3a565b60408051601f198184030181529181528151602092830120600081815260019093529120549091501561063e5760405162461bcd60e51b815260206004820152602160248201527f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636560448201527f2100000000000000000000000000000000000000000000000000000000000000606482015260840161016d565b6000818152600160209081526040822084905560029190610661908701876109bb565b6001600160a01b03168152602081019190915260400160009081209061068d6080870160608801610c54565b63ffffffff168152602080820192909252604001600090812080546001810182559082529190208591600402016106c48282610e2a565b505050505050565b6106d461082c565b6000826001600160a01b03168260405160006040518083038185875af1925050503d8060008114610721576040519150601f19603f3d011682016040523d82523d6000602084013e610726565b606091505b50509050806107775760405162461bcd60e51b815260206004820152601460248201527f6661696c65642073656e64696e672076616c7565000000000000000000000000604482015260640161016d565b505050565b6000610787336108cf565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef775937338288888888886040516107c49796959493929190610f44565b60405180910390a195945050505050565b6107dd61082c565b6001600160a01b038116610820576040517f1e4fbdf70000000000000000000000000000000000000000000000000000000081526000600482015260240161016d565b61082981610872565b50565b6000546001600160a01b03163314610578576040517f118cdaa700000000000000000000000000000000000000000000000000000000815233600482015260240161016d565b600080546001600160a01b0383811673ffffffffffffffffffffffffffffffffffffffff19831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0381166000908152600360205260408120805467ffffffffffffffff1691600191906109028385610fa4565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b600060c0828403121561093f57600080fd5b50919050565b60006020828403121561095757600080fd5b813567ffffffffffffffff81111561096e57600080fd5b6103e38482850161092d565b6001600160a01b038116811461082957600080fd5b600080604083850312156109a257600080fd5b82356109ad8161097a565b946020939093013593505050565b6000602082840312156109cd57600080fd5b81356103918161097a565b600080604083850312156109eb57600080fd5b823567ffffffffffffffff811115610a0257600080fd5b610a0e8582860161092d565b95602094909401359450505050565b63ffffffff8116811461082957600080fd5b60ff8116811461082957600080fd5b8035610a4981610a2f565b919050565b600080600080600060808688031215610a6657600080fd5b8535610a7181610a1d565b94506020860135610a8181610a1d565b9350604086013567ffffffffffffffff80821115610a9e57600080fd5b818801915088601f830112610ab257600080fd5b813581811115610ac157600080fd5b896020828501011115610ad357600080fd5b6020830195508094505050506060860135610aed81610a2f565b809150509295509295909350565b67ffffffffffffffff8116811461082957600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b6020815260008235610b4b8161097a565b6001600160a01b0381166020840152506020830135610b6981610afb565b67ffffffffffffffff808216604085015260408501359150610b8a82610a1d565b63ffffffff808316606086015260608601359250610ba783610a1d565b80831660808601525060808501359150601e19853603018212610bc957600080fd5b6020918501918201913581811115610be057600080fd5b803603831315610bef57600080fd5b60c060a0860152610c0460e086018285610b11565b92505050610c1460a08501610a3e565b60ff811660c0850152509392505050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610c4e57610c4e610c25565b92915050565b600060208284031215610c6657600080fd5b813561039181610a1d565b60008135610c4e81610a1d565b6000808335601e19843603018112610c9557600080fd5b83018035915067ffffffffffffffff821115610cb057600080fd5b602001915036819003821315610cc557600080fd5b9250929050565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680610cf657607f821691505b60208210810361093f57634e487b7160e01b600052602260045260246000fd5b601f82111561077757600081815260208120601f850160051c81016020861015610d3d5750805b601f850160051c820191505b818110156106c457828155600101610d49565b67ffffffffffffffff831115610d7457610d74610ccc565b610d8883610d828354610ce2565b83610d16565b6000601f841160018114610dbc5760008515610da45750838201355b600019600387901b1c1916600186901b178355610e16565b600083815260209020601f19861690835b82811015610ded5786850135825560209485019460019092019101610dcd565b5086821015610e0a5760001960f88860031b161c19848701351681555b505060018560011b0183555b5050505050565b60008135610c4e81610a2f565b8135610e358161097a565b6001600160a01b038116905081548173ffffffffffffffffffffffffffffffffffffffff1982161783556020840135610e6d81610afb565b7bffffffffffffffff00000000000000000000000000000000000000008160a01b1690507fffffffff0000000000000000000000000000000000000000000000000000000081848285161717855560408601359250610ecb83610a1d565b921760e09190911b909116178155610f03610ee860608401610c71565b6001830163ffffffff821663ffffffff198254161781555050565b610f106080830183610c7e565b610f1e818360028601610d5c565b5050610562610f2f60a08401610e1d565b6003830160ff821660ff198254161781555050565b6001600160a01b038816815267ffffffffffffffff87166020820152600063ffffffff808816604084015280871660608401525060c06080830152610f8d60c083018587610b11565b905060ff831660a083015298975050505050505050565b67ffffffffffffffff818116838216019080821115610fc557610fc5610c25565b509291505056fea264697066735822122055bbe17521778f602b3eab0e1e16144809be200e76e1af574dfaadbe93774a6264736f6c63430008140033a264697066735822122033ae58caa103c8dbf279349eb0307fba75b6c19110c1bb838bdbbb61b2b22ef464736f6c63430008140033",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"_rollupData\",\"type\":\"string\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"crossChainRoots\",\"type\":\"bytes32[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"crossChainData\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"_rollupData\",\"type\":\"string\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"crossChainRoots\",\"type\":\"bytes32[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"crossChainData\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"blobHashes\",\"type\":\"bytes32[]\"}],\"name\":\"AddRollupWithBlobs\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"batchSequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"index\",\"type\":\"uint32\"}],\"internalType\":\"structStructs.ValueTransferMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"ExtractNativeValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetHostAddresses\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupBlobHashes\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_aggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_hostAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"IsCrossChainMessageIncluded\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"IsCrossChainRootPublished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"leaf\",\"type\":\"bytes32\"}],\"name\":\"IsValueTransferWithdrawn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"}],\"name\":\"SetChallengePeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"host\",\"type\":\"address\"}],\"name\":\"SetSequencerHost\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"available\",\"type\":\"bool\"}],\"name\":\"SetWithdrawalAvailable\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"challengePeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b50601633601a565b608a565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a3505050565b61393a806100975f395ff3fe608060405234801561000f575f5ffd5b50600436106101c6575f3560e01c806372810996116100fe578063a52f433c1161009e578063e34fbfc81161006e578063e34fbfc8146104bf578063f2fde38b146104d2578063f3f480d9146104e5578063fa7a67a1146104ee575f5ffd5b8063a52f433c14610467578063b3cde0ac14610477578063bbd79e151461048a578063c33a60451461049d575f5ffd5b80638da5cb5b116100d95780638da5cb5b146103e457806398077e86146104145780639cdf88f414610434578063a1a227fa14610454575f5ffd5b8063728109961461033a5780638129fc1c146103425780638236a7ba1461034a575f5ffd5b80635024621f116101695780635e2826ba116101445780635e2826ba146102f6578063654fa186146103175780636a30d26c1461032a578063715018a614610332575f5ffd5b80635024621f146102bd57806355905f5b146102d057806359a90071146102e3575f5ffd5b8063324ff866116101a4578063324ff8661461021a5780633e60a22f1461022f57806343348b2f1461027b578063440c953b146102a6575f5ffd5b806303e72e48146101ca57806305924121146101df57806331ed73cd14610207575b5f5ffd5b6101dd6101d8366004611cdb565b610501565b005b6101f26101ed366004611d72565b6105fa565b60405190151581526020015b60405180910390f35b6101dd610215366004611de6565b61064c565b610222610676565b6040516101fe9190611e2f565b61026361023d366004611e92565b80516020818301810180516004825292820191909301209152546001600160a01b031681565b6040516001600160a01b0390911681526020016101fe565b6101f2610289366004611de6565b6001600160a01b03165f9081526001602052604090205460ff1690565b6102af60065481565b6040519081526020016101fe565b6101dd6102cb366004611ecc565b61074a565b6101dd6102de366004611f47565b610757565b6101dd6102f1366004611fc2565b610878565b6101f2610304366004611ecc565b5f908152600c6020526040902054151590565b6101dd61032536600461206e565b61090b565b610222610bd1565b6101dd610c9c565b6101dd610caf565b6101dd610d24565b6103b1610358366004611ecc565b60408051606080820183525f80835260208084018290529284018190528481526007835283902083519182018452805480835260018201546001600160a01b031693830193909352600201549281019290925290911491565b60408051921515835281516020808501919091528201516001600160a01b031683820152015160608201526080016101fe565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b0316610263565b610427610422366004611ecc565b610ee1565b6040516101fe91906120cb565b610447610442366004611ecc565b610f87565b6040516101fe91906120dd565b600854610263906001600160a01b031681565b600554610100900460ff166101f2565b6101dd61048536600461211f565b610fe6565b6101dd6104983660046121cc565b611284565b6101f26104ab366004611ecc565b5f908152600d602052604090205460ff1690565b6101dd6104cd36600461228e565b6113db565b6101dd6104e0366004611de6565b6113f9565b6102af600b5481565b6101dd6104fc3660046122cd565b61144f565b610509611471565b5f6001600160a01b031660048360405161052391906122fd565b908152604051908190036020019020546001600160a01b03160361057e57600380546001810182555f919091527fc2575a0e9e593c00f959f8c92f12db2869c3395a3b0502d05e2516446f71f85b0161057c838261237e565b505b8060048360405161058f91906122fd565b90815260405190819003602001812080546001600160a01b03939093166001600160a01b0319909316929092179091557f17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5906105ee9084908490612439565b60405180910390a15050565b5f818152600c602052604081205415801590610643575061064384848488604051602001610628919061259c565b604051602081830303815290604052805190602001206114e5565b95945050505050565b610654611471565b600a80546001600160a01b0319166001600160a01b0392909216919091179055565b60606002805480602002602001604051908101604052809291908181526020015f905b82821015610741578382905f5260205f200180546106b690612308565b80601f01602080910402602001604051908101604052809291908181526020018280546106e290612308565b801561072d5780601f106107045761010080835404028352916020019161072d565b820191905f5260205f20905b81548152906001019060200180831161071057829003601f168201915b505050505081526020019060010190610699565b50505050905090565b610752611471565b600b55565b60015f61076a6040870160208801611de6565b6001600160a01b0316815260208101919091526040015f205460ff166107d75760405162461bcd60e51b815260206004820152601760248201527f61676772656761746f72206e6f7420617474657374656400000000000000000060448201526064015b60405180910390fd5b600a546001600160a01b031633146108575760405162461bcd60e51b815260206004820152602e60248201527f726f6c6c7570732063616e206f6e6c79206265207075626c697368656420627960448201527f207468652073657175656e63657200000000000000000000000000000000000060648201526084016107ce565b610860846114fc565b61086981611530565b610872816115dd565b50505050565b60055460ff1615610887575f5ffd5b60058054600160ff1991821681179092556001600160a01b0388165f9081526020839052604081208054909216831790915560028054928301815590527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace016108f0848261237e565b5050600a80546001600160a01b031916331790555050505050565b600554610100900460ff166109625760405162461bcd60e51b815260206004820152601d60248201527f7769746864726177616c7320617265206e6f7420617661696c61626c6500000060448201526064016107ce565b5f818152600c6020526040812054908190036109c05760405162461bcd60e51b815260206004820152601560248201527f726f6f74206973206e6f74207075626c6973686564000000000000000000000060448201526064016107ce565b80421015610a365760405162461bcd60e51b815260206004820152602560248201527f726f6f74206973207374696c6c20696e20697473206368616c6c656e6765207060448201527f6572696f6400000000000000000000000000000000000000000000000000000060648201526084016107ce565b5f85604051602001610a4891906125ae565b60408051601f1981840301815291815281516020928301205f818152600d90935291205490915060ff1615610abf5760405162461bcd60e51b815260206004820181905260248201527f76616c7565207472616e7366657220616c72656164792077697468647261776e60448201526064016107ce565b610acb858585846114e5565b610b175760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420696e636c7573696f6e2070726f6f6600000000000000000060448201526064016107ce565b5f818152600d6020908152604091829020805460ff191660011790556008546001600160a01b0316916399a3ad2191610b54918a01908a01611de6565b604080517fffffffff0000000000000000000000000000000000000000000000000000000060e085901b1681526001600160a01b03909216600483015289013560248201526044015f604051808303815f87803b158015610bb3575f5ffd5b505af1158015610bc5573d5f5f3e3d5ffd5b50505050505050505050565b60606003805480602002602001604051908101604052809291908181526020015f905b82821015610741578382905f5260205f20018054610c1190612308565b80601f0160208091040260200160405190810160405280929190818152602001828054610c3d90612308565b8015610c885780601f10610c5f57610100808354040283529160200191610c88565b820191905f5260205f20905b815481529060010190602001808311610c6b57829003601f168201915b505050505081526020019060010190610bf4565b610ca4611471565b610cad5f61165d565b565b610cb7611471565b6008546040517f36d2da900000000000000000000000000000000000000000000000000000000081523360048201526001600160a01b03909116906336d2da90906024015f604051808303815f87803b158015610d12575f5ffd5b505af1158015610872573d5f5f3e3d5ffd5b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff165f81158015610d6e5750825b90505f8267ffffffffffffffff166001148015610d8a5750303b155b905081158015610d98575080155b15610dcf576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff191660011785558315610e0357845468ff00000000000000001916680100000000000000001785555b610e0c336116cd565b5f60065562015180600b55604051610e2390611bb8565b604051809103905ff080158015610e3c573d5f5f3e3d5ffd5b50600880546001600160a01b0319166001600160a01b039290921691821790556040519081527fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf9060200160405180910390a18315610eda57845468ff000000000000000019168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050565b60038181548110610ef0575f80fd5b905f5260205f20015f915090508054610f0890612308565b80601f0160208091040260200160405190810160405280929190818152602001828054610f3490612308565b8015610f7f5780601f10610f5657610100808354040283529160200191610f7f565b820191905f5260205f20905b815481529060010190602001808311610f6257829003601f168201915b505050505081565b5f81815260096020908152604091829020805483518184028101840190945280845260609392830182828015610fda57602002820191905f5260205f20905b815481526020019060010190808311610fc6575b50505050509050919050565b60015f610ff96040890160208a01611de6565b6001600160a01b0316815260208101919091526040015f205460ff166110615760405162461bcd60e51b815260206004820152601760248201527f61676772656761746f72206e6f7420617474657374656400000000000000000060448201526064016107ce565b600a546001600160a01b031633146110e15760405162461bcd60e51b815260206004820152602e60248201527f726f6c6c7570732063616e206f6e6c79206265207075626c697368656420627960448201527f207468652073657175656e63657200000000000000000000000000000000000060648201526084016107ce565b8061112e5760405162461bcd60e51b815260206004820152600e60248201527f6e6f20626c6f622068617368657300000000000000000000000000000000000060448201526064016107ce565b805f5b818110156111cf5783838281811061114b5761114b612626565b905060200201358149146111c75760405162461bcd60e51b815260206004820152603560248201527f626c6f62206861736820646f6573206e6f74206d617463682074686520626c6f60448201527f6273206f6620746865207472616e73616374696f6e000000000000000000000060648201526084016107ce565b600101611131565b508049156112455760405162461bcd60e51b815260206004820152603960248201527f7472616e73616374696f6e206361727269657320626c6f62732074686174206160448201527f7265206e6f742070617274206f662074686520726f6c6c75700000000000000060648201526084016107ce565b61124e876114fc565b86355f908152600960205260409020611268908484611bc5565b5061127284611530565b61127b846115dd565b50505050505050565b6001600160a01b0386165f9081526001602052604090205460ff16806112a8575f5ffd5b8115611376575f6112dd888886886040516020016112c9949392919061263a565b6040516020818303038152906040526116de565b90505f6112ea8288611718565b9050886001600160a01b0316816001600160a01b0316146113735760405162461bcd60e51b815260206004820152602c60248201527f63616c63756c61746564206164647265737320616e642061747465737465724960448201527f4420646f6e74206d61746368000000000000000000000000000000000000000060648201526084016107ce565b50505b6001600160a01b0386165f9081526001602081905260408220805460ff1916821790556002805491820181559091527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace016113d1848261237e565b5050505050505050565b335f9081526020819052604090206113f4828483612688565b505050565b611401611471565b6001600160a01b038116611443576040517f1e4fbdf70000000000000000000000000000000000000000000000000000000081525f60048201526024016107ce565b61144c8161165d565b50565b611457611471565b600580549115156101000261ff0019909216919091179055565b336114a37f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b031614610cad576040517f118cdaa70000000000000000000000000000000000000000000000000000000081523360048201526024016107ce565b5f826114f2868685611742565b1495945050505050565b80355f90815260076020526040902081906115178282612742565b50506006546040820135111561144c5760400135600655565b5f61153b8280612781565b91505f90505b818110156113f4576008546001600160a01b0316639730886d6115648580612781565b8481811061157457611574612626565b905060200281019061158691906127c7565b60016040518363ffffffff1660e01b81526004016115a59291906127e5565b5f604051808303815f87803b1580156115bc575f5ffd5b505af11580156115ce573d5f5f3e3d5ffd5b50505050806001019050611541565b5f6115eb6020830183612781565b91505f90505b818110156113f4575f6116076020850185612781565b8381811061161757611617612626565b905060200201359050600c5f8281526020019081526020015f20545f0361165457600b546116459042612806565b5f828152600c60205260409020555b506001016115f1565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a3505050565b6116d5611783565b61144c816117ea565b5f6116e982516117f2565b826040516020016116fb929190612825565b604051602081830303815290604052805190602001209050919050565b5f5f5f5f6117268686611890565b92509250925061173682826118d9565b50909150505b92915050565b5f81815b8481101561177a576117708287878481811061176457611764612626565b905060200201356119e0565b9150600101611746565b50949350505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff16610cad576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b611401611783565b60605f6117fe83611a0f565b60010190505f8167ffffffffffffffff81111561181d5761181d611c22565b6040519080825280601f01601f191660200182016040528015611847576020820181803683370190505b5090508181016020015b5f19017f3031323334353637383961626364656600000000000000000000000000000000600a86061a8153600a8504945084611851575b509392505050565b5f5f5f83516041036118c7576020840151604085015160608601515f1a6118b988828585611af0565b9550955095505050506118d2565b505081515f91506002905b9250925092565b5f8260038111156118ec576118ec612859565b036118f5575050565b600182600381111561190957611909612859565b03611940576040517ff645eedf00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600282600381111561195457611954612859565b0361198e576040517ffce698f7000000000000000000000000000000000000000000000000000000008152600481018290526024016107ce565b60038260038111156119a2576119a2612859565b036119dc576040517fd78bce0c000000000000000000000000000000000000000000000000000000008152600481018290526024016107ce565b5050565b5f8183106119fa575f828152602084905260409020611a08565b5f8381526020839052604090205b9392505050565b5f807a184f03e93ff9f4daa797ed6e38ed64bf6a1f0100000000000000008310611a57577a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000830492506040015b6d04ee2d6d415b85acef81000000008310611a83576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc100008310611aa157662386f26fc10000830492506010015b6305f5e1008310611ab9576305f5e100830492506008015b6127108310611acd57612710830492506004015b60648310611adf576064830492506002015b600a831061173c5760010192915050565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0841115611b2957505f91506003905082611bae565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015611b7a573d5f5f3e3d5ffd5b5050604051601f1901519150506001600160a01b038116611ba557505f925060019150829050611bae565b92505f91508190505b9450945094915050565b6110978061286e83390190565b828054828255905f5260205f20908101928215611bfe579160200282015b82811115611bfe578235825591602001919060010190611be3565b50611c0a929150611c0e565b5090565b5b80821115611c0a575f8155600101611c0f565b634e487b7160e01b5f52604160045260245ffd5b5f82601f830112611c45575f5ffd5b8135602083015f5f67ffffffffffffffff841115611c6557611c65611c22565b50604051601f19601f85018116603f0116810181811067ffffffffffffffff82111715611c9457611c94611c22565b604052838152905080828401871015611cab575f5ffd5b838360208301375f602085830101528094505050505092915050565b6001600160a01b038116811461144c575f5ffd5b5f5f60408385031215611cec575f5ffd5b823567ffffffffffffffff811115611d02575f5ffd5b611d0e85828601611c36565b9250506020830135611d1f81611cc7565b809150509250929050565b5f5f83601f840112611d3a575f5ffd5b50813567ffffffffffffffff811115611d51575f5ffd5b6020830191508360208260051b8501011115611d6b575f5ffd5b9250929050565b5f5f5f5f60608587031215611d85575f5ffd5b843567ffffffffffffffff811115611d9b575f5ffd5b850160c08188031215611dac575f5ffd5b9350602085013567ffffffffffffffff811115611dc7575f5ffd5b611dd387828801611d2a565b9598909750949560400135949350505050565b5f60208284031215611df6575f5ffd5b8135611a0881611cc7565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611e8657603f19878603018452611e71858351611e01565b94506020938401939190910190600101611e55565b50929695505050505050565b5f60208284031215611ea2575f5ffd5b813567ffffffffffffffff811115611eb8575f5ffd5b611ec484828501611c36565b949350505050565b5f60208284031215611edc575f5ffd5b5035919050565b5f60608284031215611ef3575f5ffd5b50919050565b5f5f83601f840112611f09575f5ffd5b50813567ffffffffffffffff811115611f20575f5ffd5b602083019150836020828501011115611d6b575f5ffd5b5f60408284031215611ef3575f5ffd5b5f5f5f5f60a08587031215611f5a575f5ffd5b611f648686611ee3565b9350606085013567ffffffffffffffff811115611f7f575f5ffd5b611f8b87828801611ef9565b909450925050608085013567ffffffffffffffff811115611faa575f5ffd5b611fb687828801611f37565b91505092959194509250565b5f5f5f5f5f5f60808789031215611fd7575f5ffd5b8635611fe281611cc7565b9550602087013567ffffffffffffffff811115611ffd575f5ffd5b61200989828a01611ef9565b909650945050604087013567ffffffffffffffff811115612028575f5ffd5b61203489828a01611c36565b935050606087013567ffffffffffffffff811115612050575f5ffd5b61205c89828a01611ef9565b979a9699509497509295939492505050565b5f5f5f5f84860360e0811215612082575f5ffd5b60a081121561208f575f5ffd5b5084935060a085013567ffffffffffffffff8111156120ac575f5ffd5b6120b887828801611d2a565b9598909750949560c00135949350505050565b602081525f611a086020830184611e01565b602080825282518282018190525f918401906040840190835b818110156121145783518352602093840193909201916001016120f6565b509095945050505050565b5f5f5f5f5f5f60c08789031215612134575f5ffd5b61213e8888611ee3565b9550606087013567ffffffffffffffff811115612159575f5ffd5b61216589828a01611ef9565b909650945050608087013567ffffffffffffffff811115612184575f5ffd5b61219089828a01611f37565b93505060a087013567ffffffffffffffff8111156121ac575f5ffd5b61205c89828a01611d2a565b803580151581146121c7575f5ffd5b919050565b5f5f5f5f5f5f60c087890312156121e1575f5ffd5b86356121ec81611cc7565b955060208701356121fc81611cc7565b9450604087013567ffffffffffffffff811115612217575f5ffd5b61222389828a01611c36565b945050606087013567ffffffffffffffff81111561223f575f5ffd5b61224b89828a01611c36565b935050608087013567ffffffffffffffff811115612267575f5ffd5b61227389828a01611c36565b92505061228260a088016121b8565b90509295509295509295565b5f5f6020838503121561229f575f5ffd5b823567ffffffffffffffff8111156122b5575f5ffd5b6122c185828601611ef9565b90969095509350505050565b5f602082840312156122dd575f5ffd5b611a08826121b8565b5f81518060208401855e5f93019283525090919050565b5f611a0882846122e6565b600181811c9082168061231c57607f821691505b602082108103611ef357634e487b7160e01b5f52602260045260245ffd5b601f8211156113f457805f5260205f20601f840160051c8101602085101561235f5750805b601f840160051c820191505b81811015610eda575f815560010161236b565b815167ffffffffffffffff81111561239857612398611c22565b6123ac816123a68454612308565b8461233a565b6020601f8211600181146123de575f83156123c75750848201515b5f19600385901b1c1916600184901b178455610eda565b5f84815260208120601f198516915b8281101561240d57878501518255602094850194600190920191016123ed565b508482101561242a57868401515f19600387901b60f8161c191681555b50505050600190811b01905550565b604081525f61244b6040830185611e01565b90506001600160a01b03831660208301529392505050565b803567ffffffffffffffff811681146121c7575f5ffd5b803563ffffffff811681146121c7575f5ffd5b81835281816020850137505f828201602090810191909152601f909101601f19169091010190565b803560ff811681146121c7575f5ffd5b5f81356124d181611cc7565b6001600160a01b0316835267ffffffffffffffff6124f160208401612463565b16602084015263ffffffff6125086040840161247a565b16604084015263ffffffff61251f6060840161247a565b1660608401525f5f6080840135601e1985360301811261253d575f5ffd5b840160208101925035905067ffffffffffffffff81111561255c575f5ffd5b80360382131561256a575f5ffd5b60c0608086015261257f60c08601828461248d565b91505061258e60a084016124b5565b60ff811660a0860152611888565b602081525f611a0860208301846124c5565b60a0810182356125bd81611cc7565b6001600160a01b0316825260208301356125d681611cc7565b6001600160a01b031660208301526040838101359083015267ffffffffffffffff61260360608501612463565b16606083015263ffffffff61261a6080850161247a565b16608083015292915050565b634e487b7160e01b5f52603260045260245ffd5b6bffffffffffffffffffffffff198560601b1681526bffffffffffffffffffffffff198460601b1660148201525f61267e61267860288401866122e6565b846122e6565b9695505050505050565b67ffffffffffffffff8311156126a0576126a0611c22565b6126b4836126ae8354612308565b8361233a565b5f601f8411600181146126e5575f85156126ce5750838201355b5f19600387901b1c1916600186901b178355610eda565b5f83815260208120601f198716915b8281101561271457868501358255602094850194600190920191016126f4565b5086821015612730575f1960f88860031b161c19848701351681555b505060018560011b0183555050505050565b8135815560018101602083013561275881611cc7565b6001600160a01b0381166001600160a01b03198354161782555050604082013560028201555050565b5f5f8335601e19843603018112612796575f5ffd5b83018035915067ffffffffffffffff8211156127b0575f5ffd5b6020019150600581901b3603821315611d6b575f5ffd5b5f823560be198336030181126127db575f5ffd5b9190910192915050565b604081525f6127f760408301856124c5565b90508260208301529392505050565b8082018082111561173c57634e487b7160e01b5f52601160045260245ffd5b7f19457468657265756d205369676e6564204d6573736167653a0a00000000000081525f611ec4612678601a8401866122e6565b634e487b7160e01b5f52602160045260245ffdfe6080604052348015600e575f5ffd5b503380603357604051631e4fbdf760e01b81525f600482015260240160405180910390fd5b603a81603f565b50608e565b5f80546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b610ffc8061009b5f395ff3fe6080604052600436106100b4575f3560e01c80638da5cb5b1161006857806399a3ad211161004d57806399a3ad211461025d578063b1454caa1461027c578063f2fde38b146102b457610124565b80638da5cb5b146102185780639730886d1461023e57610124565b8063346633fb11610099578063346633fb146101d257806336d2da90146101e5578063715018a61461020457610124565b80630fcfbd111461017157806333a88c72146101a357610124565b36610124576040517f346633fb0000000000000000000000000000000000000000000000000000000081523360048201523460248201819052309163346633fb91906044015f604051808303818588803b158015610110575f5ffd5b505af1158015610122573d5f5f3e3d5ffd5b005b60405162461bcd60e51b815260206004820152600b60248201527f756e737570706f7274656400000000000000000000000000000000000000000060448201526064015b60405180910390fd5b34801561017c575f5ffd5b5061019061018b36600461091b565b6102d3565b6040519081526020015b60405180910390f35b3480156101ae575f5ffd5b506101c26101bd36600461091b565b610387565b604051901515815260200161019a565b6101226101e0366004610961565b6103d8565b3480156101f0575f5ffd5b506101226101ff36600461098b565b6104a3565b34801561020f575f5ffd5b5061012261054e565b348015610223575f5ffd5b505f546040516001600160a01b03909116815260200161019a565b348015610249575f5ffd5b506101226102583660046109a6565b610561565b348015610268575f5ffd5b50610122610277366004610961565b6106ad565b348015610287575f5ffd5b5061029b610296366004610a22565b610759565b60405167ffffffffffffffff909116815260200161019a565b3480156102bf575f5ffd5b506101226102ce36600461098b565b6107b1565b5f5f826040516020016102e69190610b4b565b60408051601f1981840301815291815281516020928301205f8181526001909352912054909150806103805760405162461bcd60e51b815260206004820152602160248201527f54686973206d65737361676520776173206e65766572207375626d697474656460448201527f2e000000000000000000000000000000000000000000000000000000000000006064820152608401610168565b9392505050565b5f5f8260405160200161039a9190610b4b565b60408051601f1981840301815291815281516020928301205f818152600190935291205490915080158015906103d05750428111155b949350505050565b5f341180156103e657508034145b6104585760405162461bcd60e51b815260206004820152603060248201527f417474656d7074696e6720746f2073656e642076616c756520776974686f757460448201527f2070726f766964696e67204574686572000000000000000000000000000000006064820152608401610168565b604080513381526001600160a01b0384166020820152348183015290517ff1365f826a788d6c1a955db0eed5ba8642674219c4771f8c65918617511a15609181900360600190a15050565b6104ab610807565b5f816001600160a01b0316476040515f6040518083038185875af1925050503d805f81146104f4576040519150601f19603f3d011682016040523d82523d5f602084013e6104f9565b606091505b505090508061054a5760405162461bcd60e51b815260206004820152601460248201527f6661696c65642073656e64696e672076616c75650000000000000000000000006044820152606401610168565b5050565b610556610807565b61055f5f61084c565b565b610569610807565b5f6105748242610c14565b90505f836040516020016105889190610b4b565b60408051601f1981840301815291815281516020928301205f8181526001909352912054909150156106225760405162461bcd60e51b815260206004820152602160248201527f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636560448201527f21000000000000000000000000000000000000000000000000000000000000006064820152608401610168565b5f8181526001602090815260408220849055600291906106449087018761098b565b6001600160a01b0316815260208101919091526040015f9081209061066f6080870160608801610c2d565b63ffffffff1681526020808201929092526040015f90812080546001810182559082529190208591600402016106a58282610dee565b505050505050565b6106b5610807565b5f826001600160a01b0316826040515f6040518083038185875af1925050503d805f81146106fe576040519150601f19603f3d011682016040523d82523d5f602084013e610703565b606091505b50509050806107545760405162461bcd60e51b815260206004820152601460248201527f6661696c65642073656e64696e672076616c75650000000000000000000000006044820152606401610168565b505050565b5f610763336108a8565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef775937338288888888886040516107a09796959493929190610f45565b60405180910390a195945050505050565b6107b9610807565b6001600160a01b0381166107fb576040517f1e4fbdf70000000000000000000000000000000000000000000000000000000081525f6004820152602401610168565b6108048161084c565b50565b5f546001600160a01b0316331461055f576040517f118cdaa7000000000000000000000000000000000000000000000000000000008152336004820152602401610168565b5f80546001600160a01b0383811673ffffffffffffffffffffffffffffffffffffffff19831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0381165f908152600360205260408120805467ffffffffffffffff1691600191906108da8385610fa6565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b5f60c08284031215610915575f5ffd5b50919050565b5f6020828403121561092b575f5ffd5b813567ffffffffffffffff811115610941575f5ffd5b6103d084828501610905565b6001600160a01b0381168114610804575f5ffd5b5f5f60408385031215610972575f5ffd5b823561097d8161094d565b946020939093013593505050565b5f6020828403121561099b575f5ffd5b81356103808161094d565b5f5f604083850312156109b7575f5ffd5b823567ffffffffffffffff8111156109cd575f5ffd5b6109d985828601610905565b95602094909401359450505050565b63ffffffff81168114610804575f5ffd5b8035610a04816109e8565b919050565b60ff81168114610804575f5ffd5b8035610a0481610a09565b5f5f5f5f5f60808688031215610a36575f5ffd5b8535610a41816109e8565b94506020860135610a51816109e8565b9350604086013567ffffffffffffffff811115610a6c575f5ffd5b8601601f81018813610a7c575f5ffd5b803567ffffffffffffffff811115610a92575f5ffd5b886020828401011115610aa3575f5ffd5b60209190910193509150610ab960608701610a17565b90509295509295909350565b67ffffffffffffffff81168114610804575f5ffd5b5f5f8335601e19843603018112610aef575f5ffd5b830160208101925035905067ffffffffffffffff811115610b0e575f5ffd5b803603821315610b1c575f5ffd5b9250929050565b81835281816020850137505f828201602090810191909152601f909101601f19169091010190565b602081525f8235610b5b8161094d565b6001600160a01b0381166020840152506020830135610b7981610ac5565b67ffffffffffffffff81166040840152506040830135610b98816109e8565b63ffffffff8116606084015250610bb1606084016109f9565b63ffffffff8116608084015250610bcb6080840184610ada565b60c060a0850152610be060e085018284610b23565b915050610bef60a08501610a17565b60ff811660c0850152509392505050565b634e487b7160e01b5f52601160045260245ffd5b80820180821115610c2757610c27610c00565b92915050565b5f60208284031215610c3d575f5ffd5b8135610380816109e8565b5f8135610c27816109e8565b5f5f8335601e19843603018112610c69575f5ffd5b83018035915067ffffffffffffffff821115610c83575f5ffd5b602001915036819003821315610b1c575f5ffd5b634e487b7160e01b5f52604160045260245ffd5b600181811c90821680610cbf57607f821691505b60208210810361091557634e487b7160e01b5f52602260045260245ffd5b601f82111561075457805f5260205f20601f840160051c81016020851015610d025750805b601f840160051c820191505b81811015610d21575f8155600101610d0e565b5050505050565b67ffffffffffffffff831115610d4057610d40610c97565b610d5483610d4e8354610cab565b83610cdd565b5f601f841160018114610d85575f8515610d6e5750838201355b5f19600387901b1c1916600186901b178355610d21565b5f83815260208120601f198716915b82811015610db45786850135825560209485019460019092019101610d94565b5086821015610dd0575f1960f88860031b161c19848701351681555b505060018560011b0183555050505050565b5f8135610c2781610a09565b8135610df98161094d565b6001600160a01b038116905081548173ffffffffffffffffffffffffffffffffffffffff1982161783556020840135610e3181610ac5565b7bffffffffffffffff00000000000000000000000000000000000000008160a01b16837fffffffff000000000000000000000000000000000000000000000000000000008416171784555050505f6040830135610e8d816109e8565b82547bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1660e082901b7fffffffff0000000000000000000000000000000000000000000000000000000016178355905050610f04610ee960608401610c48565b6001830163ffffffff821663ffffffff198254161781555050565b610f116080830183610c54565b610f1f818360028601610d28565b505061054a610f3060a08401610de2565b6003830160ff821660ff198254161781555050565b6001600160a01b038816815267ffffffffffffffff8716602082015263ffffffff8616604082015263ffffffff8516606082015260c060808201525f610f8f60c083018587610b23565b905060ff831660a083015298975050505050505050565b67ffffffffffffffff8181168382160190811115610c2757610c27610c0056fea26469706673582212205c9f0e754a3d5ec5bc3ac91058111d068d16d070b71fc61e9440dd85e2d4463264736f6c634300081e0033a264697066735822122097ed29b09955bc2cc6ef3730ea7dc3a0641eb15bc3c23bd66ccaa9e46632b90664736f6c634300081e0033",
 }
 
 // ManagementContractABI is the input ABI used to generate the binding from.
@@ -317,6 +327,37 @@ func (_ManagementContract *ManagementContractCallerSession) GetImportantContract
 	return _ManagementContract.Contract.GetImportantContractKeys(&_ManagementContract.CallOpts)
 }
 
+// GetRollupBlobHashes is a free data retrieval call binding the contract method 0x9cdf88f4.
+//
+// Solidity: function GetRollupBlobHashes(bytes32 rollupHash) view returns(bytes32[])
+func (_ManagementContract *ManagementContractCaller) GetRollupBlobHashes(opts *bind.CallOpts, rollupHash [32]byte) ([][32]byte, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "GetRollupBlobHashes", rollupHash)
+
+	if err != nil {
+		return *new([][32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][32]byte)).(*[][32]byte)
+
+	return out0, err
+
+}
+
+// GetRollupBlobHashes is a free data retrieval call binding the contract method 0x9cdf88f4.
+//
+// Solidity: function GetRollupBlobHashes(bytes32 rollupHash) view returns(bytes32[])
+func (_ManagementContract *ManagementContractSession) GetRollupBlobHashes(rollupHash [32]byte) ([][32]byte, error) {
+	return _ManagementContract.Contract.GetRollupBlobHashes(&_ManagementContract.CallOpts, rollupHash)
+}
+
+// GetRollupBlobHashes is a free data retrieval call binding the contract method 0x9cdf88f4.
+//
+// Solidity: function GetRollupBlobHashes(bytes32 rollupHash) view returns(bytes32[])
+func (_ManagementContract *ManagementContractCallerSession) GetRollupBlobHashes(rollupHash [32]byte) ([][32]byte, error) {
+	return _ManagementContract.Contract.GetRollupBlobHashes(&_ManagementContract.CallOpts, rollupHash)
+}
+
 // GetRollupByHash is a free data retrieval call binding the contract method 0x8236a7ba.
 //
 // Solidity: function GetRollupByHash(bytes32 rollupHash) view returns(bool, (bytes32,address,uint256))
@@ -349,6 +390,99 @@ func (_ManagementContract *ManagementContractCallerSession) GetRollupByHash(roll
 	return _ManagementContract.Contract.GetRollupByHash(&_ManagementContract.CallOpts, rollupHash)
 }
 
+// IsCrossChainMessageIncluded is a free data retrieval call binding the contract method 0x05924121.
+//
+// Solidity: function IsCrossChainMessageIncluded((address,uint64,uint32,uint32,bytes,uint8) _msg, bytes32[] proof, bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractCaller) IsCrossChainMessageIncluded(opts *bind.CallOpts, _msg StructsCrossChainMessage, proof [][32]byte, root [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "IsCrossChainMessageIncluded", _msg, proof, root)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsCrossChainMessageIncluded is a free data retrieval call binding the contract method 0x05924121.
+//
+// Solidity: function IsCrossChainMessageIncluded((address,uint64,uint32,uint32,bytes,uint8) _msg, bytes32[] proof, bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractSession) IsCrossChainMessageIncluded(_msg StructsCrossChainMessage, proof [][32]byte, root [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsCrossChainMessageIncluded(&_ManagementContract.CallOpts, _msg, proof, root)
+}
+
+// IsCrossChainMessageIncluded is a free data retrieval call binding the contract method 0x05924121.
+//
+// Solidity: function IsCrossChainMessageIncluded((address,uint64,uint32,uint32,bytes,uint8) _msg, bytes32[] proof, bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractCallerSession) IsCrossChainMessageIncluded(_msg StructsCrossChainMessage, proof [][32]byte, root [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsCrossChainMessageIncluded(&_ManagementContract.CallOpts, _msg, proof, root)
+}
+
+// IsCrossChainRootPublished is a free data retrieval call binding the contract method 0x5e2826ba.
+//
+// Solidity: function IsCrossChainRootPublished(bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractCaller) IsCrossChainRootPublished(opts *bind.CallOpts, root [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "IsCrossChainRootPublished", root)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsCrossChainRootPublished is a free data retrieval call binding the contract method 0x5e2826ba.
+//
+// Solidity: function IsCrossChainRootPublished(bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractSession) IsCrossChainRootPublished(root [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsCrossChainRootPublished(&_ManagementContract.CallOpts, root)
+}
+
+// IsCrossChainRootPublished is a free data retrieval call binding the contract method 0x5e2826ba.
+//
+// Solidity: function IsCrossChainRootPublished(bytes32 root) view returns(bool)
+func (_ManagementContract *ManagementContractCallerSession) IsCrossChainRootPublished(root [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsCrossChainRootPublished(&_ManagementContract.CallOpts, root)
+}
+
+// IsValueTransferWithdrawn is a free data retrieval call binding the contract method 0xc33a6045.
+//
+// Solidity: function IsValueTransferWithdrawn(bytes32 leaf) view returns(bool)
+func (_ManagementContract *ManagementContractCaller) IsValueTransferWithdrawn(opts *bind.CallOpts, leaf [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "IsValueTransferWithdrawn", leaf)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsValueTransferWithdrawn is a free data retrieval call binding the contract method 0xc33a6045.
+//
+// Solidity: function IsValueTransferWithdrawn(bytes32 leaf) view returns(bool)
+func (_ManagementContract *ManagementContractSession) IsValueTransferWithdrawn(leaf [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsValueTransferWithdrawn(&_ManagementContract.CallOpts, leaf)
+}
+
+// IsValueTransferWithdrawn is a free data retrieval call binding the contract method 0xc33a6045.
+//
+// Solidity: function IsValueTransferWithdrawn(bytes32 leaf) view returns(bool)
+func (_ManagementContract *ManagementContractCallerSession) IsValueTransferWithdrawn(leaf [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsValueTransferWithdrawn(&_ManagementContract.CallOpts, leaf)
+}
+
 // IsWithdrawalAvailable is a free data retrieval call binding the contract method 0xa52f433c.
 //
 // Solidity: function IsWithdrawalAvailable() view returns(bool)
@@ -380,6 +514,37 @@ func (_ManagementContract *ManagementContractCallerSession) IsWithdrawalAvailabl
 	return _ManagementContract.Contract.IsWithdrawalAvailable(&_ManagementContract.CallOpts)
 }
 
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_ManagementContract *ManagementContractCaller) ChallengePeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "challengePeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_ManagementContract *ManagementContractSession) ChallengePeriod() (*big.Int, error) {
+	return _ManagementContract.Contract.ChallengePeriod(&_ManagementContract.CallOpts)
+}
+
+// ChallengePeriod is a free data retrieval call binding the contract method 0xf3f480d9.
+//
+// Solidity: function challengePeriod() view returns(uint256)
+func (_ManagementContract *ManagementContractCallerSession) ChallengePeriod() (*big.Int, error) {
+	return _ManagementContract.Contract.ChallengePeriod(&_ManagementContract.CallOpts)
+}
+
 // ImportantContractAddresses is a free data retrieval call binding the contract method 0x3e60a22f.
 //
 // Solidity: function importantContractAddresses(string ) view returns(address)
@@ -535,27 +700,69 @@ func (_ManagementContract *ManagementContractCallerSession) Owner() (common.Addr
 	return _ManagementContract.Contract.Owner(&_ManagementContract.CallOpts)
 }
 
-// AddRollup is a paid mutator transaction binding the contract method 0x8fa0d053.
+// AddRollup is a paid mutator transaction binding the contract method 0x55905f5b.
 //
-// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[]) crossChainData) returns()
+// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData) returns()
 func (_ManagementContract *ManagementContractTransactor) AddRollup(opts *bind.TransactOpts, r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData) (*types.Transaction, error) {
 	return _ManagementContract.contract.Transact(opts, "AddRollup", r, _rollupData, crossChainData)
 }
 
-// AddRollup is a paid mutator transaction binding the contract method 0x8fa0d053.
+// AddRollup is a paid mutator transaction binding the contract method 0x55905f5b.
 //
-// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[]) crossChainData) returns()
+// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData) returns()
 func (_ManagementContract *ManagementContractSession) AddRollup(r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData) (*types.Transaction, error) {
 	return _ManagementContract.Contract.AddRollup(&_ManagementContract.TransactOpts, r, _rollupData, crossChainData)
 }
 
-// AddRollup is a paid mutator transaction binding the contract method 0x8fa0d053.
+// AddRollup is a paid mutator transaction binding the contract method 0x55905f5b.
 //
-// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[]) crossChainData) returns()
+// Solidity: function AddRollup((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData) returns()
 func (_ManagementContract *ManagementContractTransactorSession) AddRollup(r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData) (*types.Transaction, error) {
 	return _ManagementContract.Contract.AddRollup(&_ManagementContract.TransactOpts, r, _rollupData, crossChainData)
 }
 
+// AddRollupWithBlobs is a paid mutator transaction binding the contract method 0xb3cde0ac.
+//
+// Solidity: function AddRollupWithBlobs((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData, bytes32[] blobHashes) returns()
+func (_ManagementContract *ManagementContractTransactor) AddRollupWithBlobs(opts *bind.TransactOpts, r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData, blobHashes [][32]byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "AddRollupWithBlobs", r, _rollupData, crossChainData, blobHashes)
+}
+
+// AddRollupWithBlobs is a paid mutator transaction binding the contract method 0xb3cde0ac.
+//
+// Solidity: function AddRollupWithBlobs((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData, bytes32[] blobHashes) returns()
+func (_ManagementContract *ManagementContractSession) AddRollupWithBlobs(r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData, blobHashes [][32]byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.AddRollupWithBlobs(&_ManagementContract.TransactOpts, r, _rollupData, crossChainData, blobHashes)
+}
+
+// AddRollupWithBlobs is a paid mutator transaction binding the contract method 0xb3cde0ac.
+//
+// Solidity: function AddRollupWithBlobs((bytes32,address,uint256) r, string _rollupData, ((address,uint64,uint32,uint32,bytes,uint8)[],bytes32[]) crossChainData, bytes32[] blobHashes) returns()
+func (_ManagementContract *ManagementContractTransactorSession) AddRollupWithBlobs(r StructsMetaRollup, _rollupData string, crossChainData StructsHeaderCrossChainData, blobHashes [][32]byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.AddRollupWithBlobs(&_ManagementContract.TransactOpts, r, _rollupData, crossChainData, blobHashes)
+}
+
+// ExtractNativeValue is a paid mutator transaction binding the contract method 0x654fa186.
+//
+// Solidity: function ExtractNativeValue((address,address,uint256,uint64,uint32) _msg, bytes32[] proof, bytes32 root) returns()
+func (_ManagementContract *ManagementContractTransactor) ExtractNativeValue(opts *bind.TransactOpts, _msg StructsValueTransferMessage, proof [][32]byte, root [32]byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "ExtractNativeValue", _msg, proof, root)
+}
+
+// ExtractNativeValue is a paid mutator transaction binding the contract method 0x654fa186.
+//
+// Solidity: function ExtractNativeValue((address,address,uint256,uint64,uint32) _msg, bytes32[] proof, bytes32 root) returns()
+func (_ManagementContract *ManagementContractSession) ExtractNativeValue(_msg StructsValueTransferMessage, proof [][32]byte, root [32]byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.ExtractNativeValue(&_ManagementContract.TransactOpts, _msg, proof, root)
+}
+
+// ExtractNativeValue is a paid mutator transaction binding the contract method 0x654fa186.
+//
+// Solidity: function ExtractNativeValue((address,address,uint256,uint64,uint32) _msg, bytes32[] proof, bytes32 root) returns()
+func (_ManagementContract *ManagementContractTransactorSession) ExtractNativeValue(_msg StructsValueTransferMessage, proof [][32]byte, root [32]byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.ExtractNativeValue(&_ManagementContract.TransactOpts, _msg, proof, root)
+}
+
 // InitializeNetworkSecret is a paid mutator transaction binding the contract method 0x59a90071.
 //
 // Solidity: function InitializeNetworkSecret(address _aggregatorID, bytes _initSecret, string _hostAddress, string _genesisAttestation) returns()
@@ -640,6 +847,27 @@ func (_ManagementContract *ManagementContractTransactorSession) RetrieveAllBridg
 	return _ManagementContract.Contract.RetrieveAllBridgeFunds(&_ManagementContract.TransactOpts)
 }
 
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5024621f.
+//
+// Solidity: function SetChallengePeriod(uint256 period) returns()
+func (_ManagementContract *ManagementContractTransactor) SetChallengePeriod(opts *bind.TransactOpts, period *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "SetChallengePeriod", period)
+}
+
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5024621f.
+//
+// Solidity: function SetChallengePeriod(uint256 period) returns()
+func (_ManagementContract *ManagementContractSession) SetChallengePeriod(period *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetChallengePeriod(&_ManagementContract.TransactOpts, period)
+}
+
+// SetChallengePeriod is a paid mutator transaction binding the contract method 0x5024621f.
+//
+// Solidity: function SetChallengePeriod(uint256 period) returns()
+func (_ManagementContract *ManagementContractTransactorSession) SetChallengePeriod(period *big.Int) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetChallengePeriod(&_ManagementContract.TransactOpts, period)
+}
+
 // SetImportantContractAddress is a paid mutator transaction binding the contract method 0x03e72e48.
 //
 // Solidity: function SetImportantContractAddress(string key, address newAddress) returns()
@@ -661,6 +889,48 @@ func (_ManagementContract *ManagementContractTransactorSession) SetImportantCont
 	return _ManagementContract.Contract.SetImportantContractAddress(&_ManagementContract.TransactOpts, key, newAddress)
 }
 
+// SetSequencerHost is a paid mutator transaction binding the contract method 0x31ed73cd.
+//
+// Solidity: function SetSequencerHost(address host) returns()
+func (_ManagementContract *ManagementContractTransactor) SetSequencerHost(opts *bind.TransactOpts, host common.Address) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "SetSequencerHost", host)
+}
+
+// SetSequencerHost is a paid mutator transaction binding the contract method 0x31ed73cd.
+//
+// Solidity: function SetSequencerHost(address host) returns()
+func (_ManagementContract *ManagementContractSession) SetSequencerHost(host common.Address) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetSequencerHost(&_ManagementContract.TransactOpts, host)
+}
+
+// SetSequencerHost is a paid mutator transaction binding the contract method 0x31ed73cd.
+//
+// Solidity: function SetSequencerHost(address host) returns()
+func (_ManagementContract *ManagementContractTransactorSession) SetSequencerHost(host common.Address) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetSequencerHost(&_ManagementContract.TransactOpts, host)
+}
+
+// SetWithdrawalAvailable is a paid mutator transaction binding the contract method 0xfa7a67a1.
+//
+// Solidity: function SetWithdrawalAvailable(bool available) returns()
+func (_ManagementContract *ManagementContractTransactor) SetWithdrawalAvailable(opts *bind.TransactOpts, available bool) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "SetWithdrawalAvailable", available)
+}
+
+// SetWithdrawalAvailable is a paid mutator transaction binding the contract method 0xfa7a67a1.
+//
+// Solidity: function SetWithdrawalAvailable(bool available) returns()
+func (_ManagementContract *ManagementContractSession) SetWithdrawalAvailable(available bool) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetWithdrawalAvailable(&_ManagementContract.TransactOpts, available)
+}
+
+// SetWithdrawalAvailable is a paid mutator transaction binding the contract method 0xfa7a67a1.
+//
+// Solidity: function SetWithdrawalAvailable(bool available) returns()
+func (_ManagementContract *ManagementContractTransactorSession) SetWithdrawalAvailable(available bool) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SetWithdrawalAvailable(&_ManagementContract.TransactOpts, available)
+}
+
 // Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
 //
 // Solidity: function initialize() returns()
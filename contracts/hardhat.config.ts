@@ -29,6 +29,20 @@ const config: HardhatUserConfig = {
       },
       outputSelection: { "*": { "*": [ "*" ], "": [ "*" ] } }
     },
+    overrides: {
+      // the management contract checks the blobs of the rollups with blobhash, which needs the cancun EVM
+      "src/management/ManagementContract.sol": {
+        version: "0.8.30",
+        settings: {
+          evmVersion: "cancun",
+          optimizer: {
+            enabled: true,
+            runs: 1000,
+          },
+          outputSelection: { "*": { "*": [ "*" ], "": [ "*" ] } }
+        },
+      },
+    },
   },
   abiExporter : {
     path: abigen.abiExportPath,
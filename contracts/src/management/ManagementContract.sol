@@ -1,13 +1,16 @@
 // SPDX-License-Identifier: GPL-3.0
-pragma solidity >=0.7.0 <0.9.0;
+// blobhash requires solidity 0.8.24 and the cancun EVM
+pragma solidity >=0.8.24 <0.9.0;
 
 import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
 import "@openzeppelin/contracts-upgradeable/access/OwnableUpgradeable.sol";
 import "@openzeppelin/contracts/utils/cryptography/MessageHashUtils.sol";
+import "@openzeppelin/contracts/utils/cryptography/MerkleProof.sol";
 
 
-import "./Structs.sol";
+import {Structs} from "./Structs.sol";
 import * as MessageBus from "../messaging/MessageBus.sol";
+import * as MessageBusStructs from "../messaging/Structs.sol";
 
 contract ManagementContract is Initializable, OwnableUpgradeable {
 
@@ -45,9 +48,29 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     Structs.RollupStorage private rollups;
     //The messageBus where messages can be sent to Obscuro
     MessageBus.IMessageBus public messageBus;
+
+    // The contract is deployed behind a proxy, so the state variables below were added after the existing ones, to keep
+    // the storage layout of the deployed contracts when they are upgraded. New state variables must be added last.
+
+    // The versioned hashes of the blobs carrying the payloads of the rollups published with blobs (EIP-4844)
+    mapping(bytes32 => bytes32[]) private rollupBlobHashes;
+
+    // The L1 address the sequencer's host publishes the rollups from, which is the one that initialized the network secret
+    address private sequencerHost;
+
+    // The time after a rollup is published during which the value transfers of its batches can't be withdrawn yet
+    uint256 public challengePeriod;
+
+    // The roots of the cross chain trees of the rolled up batches (their TransfersTree), mapped to the timestamp from
+    // which value transfers can be withdrawn against them
+    mapping(bytes32 => uint256) private crossChainRoots;
+    // The leaves of the value transfers that have been withdrawn
+    mapping(bytes32 => bool) private withdrawnValueTransfers;
+
     function initialize() public initializer {
         __Ownable_init(msg.sender);
         lastBatchSeqNo = 0;
+        challengePeriod = 1 days;
         messageBus = new MessageBus.MessageBus();
         emit LogManagementContractCreated(address(messageBus));
     }
@@ -75,6 +98,17 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         }
     }
 
+    // the roots can be withdrawn against once the challenge period of the first rollup that published them is over
+    function pushCrossChainRoots(Structs.HeaderCrossChainData calldata crossChainData) internal {
+        uint256 rootsLength = crossChainData.crossChainRoots.length;
+        for (uint256 i = 0; i < rootsLength; ++i) {
+            bytes32 root = crossChainData.crossChainRoots[i];
+            if (crossChainRoots[root] == 0) {
+                crossChainRoots[root] = block.timestamp + challengePeriod;
+            }
+        }
+    }
+
     // solc-ignore-next-line unused-param
     function AddRollup(Structs.MetaRollup calldata r, string calldata  _rollupData, Structs.HeaderCrossChainData calldata crossChainData) public {
         // TODO How to ensure the sender without hashing the calldata ?
@@ -84,9 +118,36 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
 
         // revert if the AggregatorID is not attested
         require(attested[r.AggregatorID], "aggregator not attested");
+        // the cross chain data is trusted, so only the sequencer can publish rollups
+        require(msg.sender == sequencerHost, "rollups can only be published by the sequencer");
+
+        AppendRollup(r);
+        pushCrossChainMessages(crossChainData);
+        pushCrossChainRoots(crossChainData);
+    }
+
+    // AddRollupWithBlobs is the equivalent of AddRollup for rollups whose batch payloads are published as blobs.
+    // The versioned hashes must be the ones of the blobs carried by the transaction, in order. They are recorded, so the
+    // payloads can be matched against the rollup.
+    // solc-ignore-next-line unused-param
+    function AddRollupWithBlobs(Structs.MetaRollup calldata r, string calldata  _rollupData, Structs.HeaderCrossChainData calldata crossChainData, bytes32[] calldata blobHashes) public {
+        require(attested[r.AggregatorID], "aggregator not attested");
+        require(msg.sender == sequencerHost, "rollups can only be published by the sequencer");
+        require(blobHashes.length > 0, "no blob hashes");
+        uint256 blobHashesLength = blobHashes.length;
+        for (uint256 i = 0; i < blobHashesLength; ++i) {
+            require(blobhash(i) == blobHashes[i], "blob hash does not match the blobs of the transaction");
+        }
+        require(blobhash(blobHashesLength) == bytes32(0), "transaction carries blobs that are not part of the rollup");
 
         AppendRollup(r);
+        rollupBlobHashes[r.Hash] = blobHashes;
         pushCrossChainMessages(crossChainData);
+        pushCrossChainRoots(crossChainData);
+    }
+
+    function GetRollupBlobHashes(bytes32 rollupHash) view public returns(bytes32[] memory) {
+        return rollupBlobHashes[rollupHash];
     }
 
     // InitializeNetworkSecret kickstarts the network secret, can only be called once
@@ -100,6 +161,9 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         // aggregator is now on the list of attested aggregators and its host address is available
         attested[_aggregatorID] = true;
         hostAddresses.push(_hostAddress);
+
+        // the genesis aggregator is the sequencer, and publishes the rollups from the same address
+        sequencerHost = msg.sender;
     }
 
     // Aggregators can request the Network Secret given an attestation request report
@@ -145,6 +209,55 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         return isWithdrawalAvailable;
     }
 
+    // Owner function to enable or disable the withdrawals, which are disabled when the contract is initialized
+    function SetWithdrawalAvailable(bool available) public onlyOwner {
+        isWithdrawalAvailable = available;
+    }
+
+    // Owner function to set the address the sequencer's host publishes the rollups from. It is set when the network
+    // secret is initialized, so it only needs to be set on contracts upgraded after that, or to move the sequencer.
+    function SetSequencerHost(address host) public onlyOwner {
+        sequencerHost = host;
+    }
+
+    // Owner function to set the challenge period of the rollups published from now on
+    function SetChallengePeriod(uint256 period) public onlyOwner {
+        challengePeriod = period;
+    }
+
+    // ExtractNativeValue pays out a value transfer made to the L1 on the L2 message bus, given the proof of its inclusion
+    // in the cross chain tree of its batch, as returned by ten_getCrossChainProof. The transfer can be withdrawn once the
+    // challenge period of the rollup that published the root is over, and only once.
+    function ExtractNativeValue(MessageBusStructs.Structs.ValueTransferMessage calldata _msg, bytes32[] calldata proof, bytes32 root) public {
+        require(isWithdrawalAvailable, "withdrawals are not available");
+        uint256 withdrawableFrom = crossChainRoots[root];
+        require(withdrawableFrom != 0, "root is not published");
+        require(block.timestamp >= withdrawableFrom, "root is still in its challenge period");
+
+        bytes32 leaf = keccak256(abi.encode(_msg));
+        require(!withdrawnValueTransfers[leaf], "value transfer already withdrawn");
+        require(MerkleProof.verifyCalldata(proof, root, leaf), "invalid inclusion proof");
+
+        withdrawnValueTransfers[leaf] = true;
+        messageBus.receiveValueFromL2(_msg.receiver, _msg.amount);
+    }
+
+    // IsCrossChainMessageIncluded checks that a message was published on the L2, given the proof of its inclusion in the
+    // cross chain tree of its batch, as returned by ten_getCrossChainProof
+    function IsCrossChainMessageIncluded(MessageBusStructs.Structs.CrossChainMessage calldata _msg, bytes32[] calldata proof, bytes32 root) view public returns (bool) {
+        return crossChainRoots[root] != 0 && MerkleProof.verifyCalldata(proof, root, keccak256(abi.encode(_msg)));
+    }
+
+    // Accessor to check if the root of the cross chain tree of a batch has been published in a rollup
+    function IsCrossChainRootPublished(bytes32 root) view public returns (bool) {
+        return crossChainRoots[root] != 0;
+    }
+
+    // Accessor to check if a value transfer, identified by its leaf in the cross chain tree, has been withdrawn
+    function IsValueTransferWithdrawn(bytes32 leaf) view public returns (bool) {
+        return withdrawnValueTransfers[leaf];
+    }
+
     // Accessor that checks if an address is attested or not
     function Attested(address _addr) view public returns (bool) {
         return attested[_addr];
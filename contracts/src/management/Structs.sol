@@ -16,5 +16,6 @@ interface Structs {
 
     struct HeaderCrossChainData {
         MessageBusStructs.Structs.CrossChainMessage[] messages;
+        bytes32[] crossChainRoots; // The roots of the cross chain trees of the batches, the messages and value transfers can be proven against
     }
 }
\ No newline at end of file
@@ -10,4 +10,12 @@ interface Structs {
         bytes   payload; // The actual encoded message.
         uint8   consistencyLevel; //
     }
+
+    struct ValueTransferMessage {
+        address sender; // The address which sent the value to the L1 on the L2 message bus.
+        address receiver; // The address receiving the value on the L1.
+        uint256 amount;
+        uint64  batchSequence; // The sequence number of the L2 batch the value was sent in.
+        uint32  index; // The position of the transfer among the value transfers of the batch.
+    }
 }
\ No newline at end of file
@@ -7,7 +7,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -29,6 +28,14 @@ const (
 	Unavailable                      // the enclave is unavailable (no guarantee it will self-recover)
 )
 
+// BatchIdentifier represents how a batch to trace is identified in the request
+type BatchIdentifier int
+
+const (
+	BatchByNumber BatchIdentifier = iota // the batch is identified by its number
+	BatchByHash                          // the batch is identified by its hash
+)
+
 // Enclave represents the API of the service that runs inside the TEE.
 type Enclave interface {
 	EnclaveScan
@@ -54,7 +61,8 @@ type Enclave interface {
 	// It is the responsibility of the host to gossip the returned rollup
 	// For good functioning the caller should always submit blocks ordered by height
 	// submitting a block before receiving ancestors of it, will result in it being ignored
-	SubmitL1Block(block L1Block, receipts L1Receipts, isLatest bool) (*BlockSubmissionResponse, SystemError)
+	// The blobs are the ones carried by the obscuro-relevant blob transactions of the block (e.g. rollups published with blobs)
+	SubmitL1Block(block L1Block, receipts L1Receipts, blobs L1Blobs, isLatest bool) (*BlockSubmissionResponse, SystemError)
 
 	// SubmitTx - user transactions
 	SubmitTx(tx EncryptedTx) (*responses.RawTx, SystemError)
@@ -100,6 +108,10 @@ type Enclave interface {
 	// EstimateGas tries to estimate the gas needed to execute a specific transaction based on the pending state.
 	EstimateGas(encryptedParams EncryptedParamsEstimateGas) (*responses.Gas, SystemError)
 
+	// EstimateGasBreakdown is the same as EstimateGas, but returns the estimation split between the execution and the
+	// L1 publishing cost of the transaction.
+	EstimateGasBreakdown(encryptedParams EncryptedParamsEstimateGas) (*responses.GasBreakdown, SystemError)
+
 	// GetLogs returns all the logs matching the filter.
 	GetLogs(encryptedParams EncryptedParamsGetLogs) (*responses.Logs, SystemError)
 
@@ -112,6 +124,21 @@ type Enclave interface {
 	// GetBatchBySeqNo - retrieve batch by sequencer number if it's in the db.
 	GetBatchBySeqNo(seqNo uint64) (*ExtBatch, SystemError)
 
+	// GetBatchRewardPercentiles - returns, for each of the batches, the priority fees paid by its transactions at the
+	// requested percentiles of gas used. The transactions are only known to the enclave, so this is used by the host
+	// to compute the rewards of the eth_feeHistory.
+	GetBatchRewardPercentiles(batchHashes []L2BatchHash, percentiles []float64) ([][]*big.Int, SystemError)
+
+	// GetCrossChainProof - returns the inclusion proof of the outbound message or value transfer at the index of the
+	// cross chain tree of the batch. The value transfers are only known to the enclave, so the host can't compute it.
+	// The proof is public, like the events of the message bus the transfers and messages are extracted from. The errors
+	// that are not internal errors (e.g. an unknown batch or an index out of range) are meant for the user.
+	GetCrossChainProof(batchHash L2BatchHash, index uint64) (*CrossChainProof, SystemError)
+
+	// GetCrossChainMessageStatus - returns the lifecycle stages reached by the cross chain messages matching the query.
+	// Where the messages got to is worked out from the batches, rollups and blocks only the enclave has indexed.
+	GetCrossChainMessageStatus(query *CrossChainMessageQuery) ([]*CrossChainMessageStatus, SystemError)
+
 	// GetRollupData - retrieve the first batch sequence and start time for a given rollup.
 	GetRollupData(hash L2RollupHash) (*PublicRollupMetadata, SystemError)
 
@@ -123,8 +150,15 @@ type Enclave interface {
 	// or panic otherwise.
 	CreateRollup(fromSeqNo uint64) (*ExtRollup, SystemError)
 
-	// DebugTraceTransaction returns the trace of a transaction
-	DebugTraceTransaction(hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, SystemError)
+	// DebugTraceTransaction returns the trace of a transaction, encrypted with the viewing key of the transaction sender
+	DebugTraceTransaction(encryptedParams EncryptedParamsDebugTraceTx) (*responses.DebugTraceTx, SystemError)
+
+	// DebugTraceCall returns the trace of a call executed on top of the state of a batch, encrypted with the viewing key of the caller
+	DebugTraceCall(encryptedParams EncryptedParamsDebugTraceCall) (*responses.DebugTraceCall, SystemError)
+
+	// DebugTraceBatch returns the traces of the transactions in a batch that were sent by the viewing key account,
+	// encrypted with the viewing key of that account
+	DebugTraceBatch(encryptedParams EncryptedParamsDebugTraceBatch, batchID BatchIdentifier) (*responses.DebugTraceBatch, SystemError)
 
 	// StreamL2Updates - will stream any new batches as they are created/detected
 	// All will be queued in the channel that has been returned.
@@ -163,4 +197,5 @@ type ProducedSecretResponse struct {
 
 type EnclavePublicConfig struct {
 	L2MessageBusAddress gethcommon.Address
+	SequencerPublicKeys [][]byte // the compressed attested keys of the sequencer enclaves, empty if they are not known yet
 }
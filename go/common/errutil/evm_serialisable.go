@@ -1,5 +1,8 @@
 package errutil
 
+// RevertErrorCode is the JSON RPC error code of an EVM execution that was reverted
+const RevertErrorCode = 3
+
 // EVMSerialisableError is an API error that encompasses an EVM error with a code and a reason
 type EVMSerialisableError struct {
 	Err    string
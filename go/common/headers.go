@@ -43,7 +43,7 @@ type BatchHeader struct {
 	CrossChainMessages            []MessageBus.StructsCrossChainMessage `json:"crossChainMessages"`
 	LatestInboundCrossChainHash   common.Hash                           `json:"inboundCrossChainHash"`   // The block hash of the latest block that has been scanned for cross chain messages.
 	LatestInboundCrossChainHeight *big.Int                              `json:"inboundCrossChainHeight"` // The block height of the latest block that has been scanned for cross chain messages.
-	TransfersTree                 common.Hash                           `json:"transfersTree"`           // This is a merkle tree of all of the outbound value transfers for the MainNet
+	TransfersTree                 common.Hash                           `json:"transfersTree"`           // The root of the merkle tree of the outbound messages and value transfers, see merkle.CrossChainTree
 }
 
 type batchHeaderEncoding struct {
@@ -132,6 +132,7 @@ type RollupHeader struct {
 	CompressionL1Head L1BlockHash // the l1 block that the sequencer considers canonical at the time when this rollup is created
 
 	CrossChainMessages []MessageBus.StructsCrossChainMessage `json:"crossChainMessages"`
+	CrossChainRoots    []common.Hash                         `json:"crossChainRoots"` // the non-empty TransfersTree roots of the batches
 
 	PayloadHash common.Hash // The hash of the compressed batches. TODO
 	R, S        *big.Int    // signature values
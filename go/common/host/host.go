@@ -23,8 +23,12 @@ type Host interface {
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogs chan []byte) error
 	// Unsubscribe terminates a log subscription between the host and the enclave.
 	Unsubscribe(id rpc.ID)
+	// SubscribeNewHeads feeds the header of each new head batch to the channel, until the returned function is called.
+	SubscribeNewHeads(ch chan *common.BatchHeader) (unsubscribe func())
 	// Stop gracefully stops the host execution.
 	Stop() error
+	// Done returns a channel that is closed when the host is stopped.
+	Done() <-chan interface{}
 
 	// HealthCheck returns the health status of the host + enclave + db
 	HealthCheck() (*HealthCheck, error)
@@ -20,6 +20,7 @@ const (
 	L2BatchRepositoryName      = "l2-batch-repo"
 	EnclaveServiceName         = "enclaves"
 	LogSubscriptionServiceName = "log-subs"
+	NewHeadsServiceName        = "new-heads"
 )
 
 // The host has a number of services that encapsulate the various responsibilities of the host.
@@ -42,8 +43,9 @@ type P2P interface {
 	// SendTxToSequencer sends the encrypted transaction to the sequencer.
 	SendTxToSequencer(tx common.EncryptedTx) error
 
-	// RequestBatchesFromSequencer asynchronously requests batches from the sequencer, from the given sequence number
-	RequestBatchesFromSequencer(fromSeqNo *big.Int) error
+	// RequestBatchesFromPeers asynchronously requests batches from the given sequence number. They are requested from
+	// the sequencer, or from any peer when gossip is enabled
+	RequestBatchesFromPeers(fromSeqNo *big.Int) error
 	// RespondToBatchRequest sends the requested batches to the requesting peer
 	RespondToBatchRequest(requestID string, batches []*common.ExtBatch) error
 
@@ -87,6 +89,8 @@ type L1BlockRepository interface {
 	FetchNextBlock(prevBlock gethcommon.Hash) (*types.Block, bool, error)
 	// FetchObscuroReceipts returns the receipts for a given L1 block
 	FetchObscuroReceipts(block *common.L1Block) (types.Receipts, error)
+	// FetchObscuroBlobs returns the blobs of the obscuro-relevant blob transactions in a given L1 block
+	FetchObscuroBlobs(block *common.L1Block) (common.L1Blobs, error)
 }
 
 // L1BlockHandler is an interface for receiving new blocks from the repository as they arrive
@@ -103,13 +107,21 @@ type L1Publisher interface {
 	RequestSecret(report *common.AttestationReport) (gethcommon.Hash, error)
 	// ExtractObscuroRelevantTransactions will return all Obscuro relevant tx from an L1 block
 	ExtractObscuroRelevantTransactions(block *types.Block) ([]*ethadapter.L1RespondSecretTx, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx)
-	// PublishRollup will create and publish a rollup tx to the management contract - fire and forget we don't wait for receipt
-	// todo (#1624) - With a single sequencer, it is problematic if rollup publication fails; handle this case better
+	// PublishRollup will create and publish a rollup tx to the management contract. It doesn't wait for the receipt, the
+	// tx is tracked until the rollup is final and resubmitted with higher fees if it is not included
 	PublishRollup(producedRollup *common.ExtRollup)
+	// IsRollupPending returns true while a published rollup is not included in the canonical L1 chain, a new rollup
+	// should not be produced meanwhile because it would start from the same batch
+	IsRollupPending() bool
+	// RollupRepublishRequired returns true if a rollup could not be published since the last call, in which case a new
+	// rollup should be produced from the latest batch seq no on the L1
+	RollupRepublishRequired() bool
 	// PublishSecretResponse will create and publish a secret response tx to the management contract - fire and forget we don't wait for receipt
 	PublishSecretResponse(secretResponse *common.ProducedSecretResponse) error
 
 	FetchLatestPeersList() ([]string, error)
+	// IsHostAttested returns whether the host with the given ID has been attested by the management contract
+	IsHostAttested(hostID gethcommon.Address) (bool, error)
 
 	FetchLatestSeqNo() (*big.Int, error)
 
@@ -160,3 +172,11 @@ type LogSubscriptionManager interface {
 	Unsubscribe(id rpc.ID)
 	SendLogsToSubscribers(result *common.EncryptedSubscriptionLogs)
 }
+
+// NewHeadsManager provides an interface for the host to feed the new head batches to subscribers
+type NewHeadsManager interface {
+	// SubscribeNewHeads feeds the header of each new head batch to the channel, until the returned function is called
+	SubscribeNewHeads(ch chan *common.BatchHeader) (unsubscribe func())
+	// SendNewHead sends the header of a batch processed by an enclave to the subscribers, if it is a new head
+	SendNewHead(header *common.BatchHeader)
+}
@@ -44,6 +44,8 @@ const (
 	EthereumL1Cmp   = "l1_host"
 	TenscanCmp      = "tenscan"
 	CrossChainCmp   = "cross_chain"
+	RelayerCmp      = "relayer"
+	BridgeCLICmp    = "bridge_cli"
 )
 
 // Used when the logger has to write to Sys.out
@@ -15,6 +15,10 @@ type LogSubscription struct {
 
 	// A subscriber-defined filter to apply to the stream of logs.
 	Filter *filters.FilterCriteria
+
+	// PendingTxs - if true, the subscription is to the hashes of the pending transactions sent from or to the account
+	// of the viewing key, instead of to logs
+	PendingTxs bool `rlp:"optional"`
 }
 
 // IDAndEncLog pairs an encrypted log with the ID of the subscription that generated it.
@@ -29,6 +33,12 @@ type IDAndLog struct {
 	Log   *types.Log
 }
 
+// IDAndTxHash pairs the hash of a pending transaction with the ID of the subscription that generated it.
+type IDAndTxHash struct {
+	SubID  rpc.ID
+	TxHash common.Hash
+}
+
 // FilterCriteriaJSON is a structure that JSON-serialises to a format that can be successfully deserialised into a
 // filters.FilterCriteria object (round-tripping a filters.FilterCriteria to JSON and back doesn't work, due to a
 // custom serialiser implemented by filters.FilterCriteria).
@@ -0,0 +1,169 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+var (
+	messageArgs       = tupleArguments(messageComponents)
+	valueTransferArgs = tupleArguments(valueTransferComponents)
+
+	// must match the Structs.CrossChainMessage struct of the message bus
+	messageComponents = []abi.ArgumentMarshaling{
+		{Name: "sender", Type: "address"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "nonce", Type: "uint32"},
+		{Name: "topic", Type: "uint32"},
+		{Name: "payload", Type: "bytes"},
+		{Name: "consistencyLevel", Type: "uint8"},
+	}
+	// must match the Structs.ValueTransferMessage struct of the message bus
+	valueTransferComponents = []abi.ArgumentMarshaling{
+		{Name: "sender", Type: "address"},
+		{Name: "receiver", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+		{Name: "batchSequence", Type: "uint64"},
+		{Name: "index", Type: "uint32"},
+	}
+)
+
+// Tree is a binary merkle tree, where each node is the keccak256 hash of its two children sorted, the way OpenZeppelin's
+// MerkleProof library verifies it. A node left without a sibling at the end of a level is carried up to the next level.
+type Tree struct {
+	levels [][]gethcommon.Hash // from the leaves to the root
+}
+
+func NewTree(leaves []gethcommon.Hash) *Tree {
+	levels := [][]gethcommon.Hash{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([]gethcommon.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return &Tree{levels: levels}
+}
+
+// Root returns the root of the tree, or the empty root hash if the tree has no leaves
+func (t *Tree) Root() gethcommon.Hash {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return types.EmptyRootHash
+	}
+	return top[0]
+}
+
+// Leaf returns the leaf at the index
+func (t *Tree) Leaf(index int) (gethcommon.Hash, error) {
+	if index < 0 || index >= len(t.levels[0]) {
+		return gethcommon.Hash{}, fmt.Errorf("leaf %d out of range, the tree has %d leaves", index, len(t.levels[0]))
+	}
+	return t.levels[0][index], nil
+}
+
+// Proof returns the siblings of the path from the leaf at the index to the root
+func (t *Tree) Proof(index int) ([]gethcommon.Hash, error) {
+	if _, err := t.Leaf(index); err != nil {
+		return nil, err
+	}
+	proof := make([]gethcommon.Hash, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// Verify returns whether the proof shows the leaf is part of the tree with the root
+func Verify(root gethcommon.Hash, leaf gethcommon.Hash, proof []gethcommon.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashPair(computed, sibling)
+	}
+	return computed == root
+}
+
+// CrossChainTree returns the tree of the outbound messages followed by the outbound value transfers of a batch, whose
+// root is the TransfersTree of the batch header
+func CrossChainTree(messages common.CrossChainMessages, transfers []common.ValueTransferMessage) (*Tree, error) {
+	leaves := make([]gethcommon.Hash, 0, len(messages)+len(transfers))
+	for _, msg := range messages {
+		leaf, err := MessageLeaf(msg)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+	for _, transfer := range transfers {
+		leaf, err := ValueTransferLeaf(transfer)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+	return NewTree(leaves), nil
+}
+
+// ValueTransferMessages returns the value transfers of the batch with the sequence number as they are claimed on the L1
+func ValueTransferMessages(transfers common.ValueTransferEvents, batchSeqNo uint64) []common.ValueTransferMessage {
+	messages := make([]common.ValueTransferMessage, len(transfers))
+	for i, transfer := range transfers {
+		messages[i] = common.ValueTransferMessage{
+			Sender:        transfer.Sender,
+			Receiver:      transfer.Receiver,
+			Amount:        transfer.Amount,
+			BatchSequence: batchSeqNo,
+			Index:         uint32(i),
+		}
+	}
+	return messages
+}
+
+// MessageLeaf returns keccak256(abi.encode(message)), which is also the hash the message bus identifies the message by
+func MessageLeaf(msg common.CrossChainMessage) (gethcommon.Hash, error) {
+	encoded, err := messageArgs.Pack(msg)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not encode cross chain message. Cause: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// ValueTransferLeaf returns keccak256(abi.encode(transfer)). The leaves are longer than the 64 bytes hashed for the
+// inner nodes, so a node can't be passed off as a leaf.
+func ValueTransferLeaf(transfer common.ValueTransferMessage) (gethcommon.Hash, error) {
+	encoded, err := valueTransferArgs.Pack(transfer)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not encode value transfer. Cause: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func hashPair(a, b gethcommon.Hash) gethcommon.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
+
+func tupleArguments(components []abi.ArgumentMarshaling) abi.Arguments {
+	tuple, err := abi.NewType("tuple", "", components)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: tuple}}
+}
@@ -0,0 +1,67 @@
+package merkle
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestProofsVerifyForEveryLeaf(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		leaves := make([]gethcommon.Hash, size)
+		for i := range leaves {
+			leaves[i] = crypto.Keccak256Hash(big.NewInt(int64(i)).Bytes())
+		}
+		tree := NewTree(leaves)
+
+		for i, leaf := range leaves {
+			proof, err := tree.Proof(i)
+			require.NoError(t, err)
+			require.True(t, Verify(tree.Root(), leaf, proof), "leaf %d of %d", i, size)
+			require.False(t, Verify(tree.Root(), crypto.Keccak256Hash(leaf.Bytes()), proof), "leaf %d of %d", i, size)
+		}
+		_, err := tree.Proof(size)
+		require.Error(t, err)
+	}
+}
+
+func TestEmptyTreeHasEmptyRoot(t *testing.T) {
+	tree, err := CrossChainTree(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.EmptyRootHash, tree.Root())
+}
+
+func TestCrossChainTreeLeaves(t *testing.T) {
+	msg := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1"), Sequence: 2, Topic: 3, Payload: []byte("payload")}
+	transfers := ValueTransferMessages(common.ValueTransferEvents{
+		{Sender: gethcommon.HexToAddress("0x2"), Receiver: gethcommon.HexToAddress("0x3"), Amount: big.NewInt(100)},
+		{Sender: gethcommon.HexToAddress("0x2"), Receiver: gethcommon.HexToAddress("0x3"), Amount: big.NewInt(100)},
+	}, 7)
+	tree, err := CrossChainTree(common.CrossChainMessages{msg}, transfers)
+	require.NoError(t, err)
+
+	msgLeaf, err := MessageLeaf(msg)
+	require.NoError(t, err)
+	leaf, err := tree.Leaf(0)
+	require.NoError(t, err)
+	require.Equal(t, msgLeaf, leaf)
+
+	// identical transfers in the same batch still have distinct leaves
+	first, err := ValueTransferLeaf(transfers[0])
+	require.NoError(t, err)
+	second, err := ValueTransferLeaf(transfers[1])
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	// abi.encode of the static struct is the concatenation of its 32 bytes words
+	encoded, err := valueTransferArgs.Pack(transfers[1])
+	require.NoError(t, err)
+	require.Equal(t, 5*32, len(encoded))
+	require.Equal(t, gethcommon.LeftPadBytes(big.NewInt(7).Bytes(), 32), encoded[3*32:4*32])
+	require.Equal(t, gethcommon.LeftPadBytes(big.NewInt(1).Bytes(), 32), encoded[4*32:])
+}
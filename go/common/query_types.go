@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -93,3 +94,78 @@ type ObscuroNetworkInfo struct {
 	L2MessageBusAddress       common.Address
 	ImportantContracts        map[string]common.Address // map of contract name to address
 }
+
+// CrossChainMessageQuery - selects the cross chain messages published by a transaction on either layer, or the one with
+// a sequence number of a sender. It is passed either as the hash of the transaction, or as an object.
+type CrossChainMessageQuery struct {
+	TxHash   *common.Hash    `json:"txHash,omitempty"`
+	Sender   *common.Address `json:"sender,omitempty"`
+	Sequence *uint64         `json:"sequence,omitempty"`
+}
+
+func (q *CrossChainMessageQuery) UnmarshalJSON(data []byte) error {
+	var txHash common.Hash
+	if err := json.Unmarshal(data, &txHash); err == nil {
+		q.TxHash = &txHash
+		return nil
+	}
+
+	// Use a temporary type to avoid infinite unmarshalling loop
+	type Temp CrossChainMessageQuery
+	var temp Temp
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.TxHash == nil && (temp.Sender == nil || temp.Sequence == nil) {
+		return fmt.Errorf("either the txHash or the sender and sequence must be set")
+	}
+	*q = CrossChainMessageQuery(temp)
+	return nil
+}
+
+type CrossChainDirection string
+
+const (
+	CrossChainL1ToL2 CrossChainDirection = "L1ToL2"
+	CrossChainL2ToL1 CrossChainDirection = "L2ToL1"
+)
+
+type CrossChainStage string
+
+const (
+	CrossChainPublished CrossChainStage = "Published" // the message was published on the layer it was sent from
+	CrossChainRelayed   CrossChainStage = "Relayed"   // an L1 message was delivered to the L2 message bus by a synthetic transaction
+	CrossChainRolledUp  CrossChainStage = "RolledUp"  // the batch of an L2 message was published on the L1 in a rollup
+	CrossChainFinalised CrossChainStage = "Finalised" // the rollup of an L2 message is HeightCommittedBlocks deep on the L1
+)
+
+// CrossChainMessageStatus - the stages a cross chain message went through so far, with where each one happened
+type CrossChainMessageStatus struct {
+	Message     CrossChainMessage         `json:"message"`
+	MessageHash common.Hash               `json:"messageHash"` // keccak256(abi.encode(message)), as the message bus identifies it
+	Direction   CrossChainDirection       `json:"direction"`
+	Stage       CrossChainStage           `json:"stage"` // the latest stage reached
+	Stages      []*CrossChainMessageStage `json:"stages"`
+}
+
+type CrossChainMessageStage struct {
+	Stage         CrossChainStage `json:"stage"`
+	L1BlockHash   *common.Hash    `json:"l1BlockHash,omitempty"`
+	L1BlockNumber *uint64         `json:"l1BlockNumber,omitempty"`
+	L1TxHash      *common.Hash    `json:"l1TxHash,omitempty"`
+	L2TxHash      *common.Hash    `json:"l2TxHash,omitempty"`
+	BatchHash     *common.Hash    `json:"batchHash,omitempty"`
+	BatchSeqNo    *uint64         `json:"batchSeqNo,omitempty"`
+	RollupHash    *common.Hash    `json:"rollupHash,omitempty"`
+}
+
+// GasEstimateBreakdown - the gas limit estimated for a transaction, split between the gas its execution needs and the
+// gas charged upfront for publishing it on the L1. The gas limit is the one returned by eth_estimateGas.
+type GasEstimateBreakdown struct {
+	GasLimit      hexutil.Uint64 `json:"gasLimit"`
+	ExecutionGas  hexutil.Uint64 `json:"executionGas"`
+	PublishingGas hexutil.Uint64 `json:"publishingGas"` // the L1 cost converted to gas at the base fee, with a margin for L1 price rises
+	L1Cost        *hexutil.Big   `json:"l1Cost"`        // the estimated cost of publishing the transaction in a rollup, in wei
+	L1DataSize    hexutil.Uint64 `json:"l1DataSize"`    // the size of the signed transaction, as published in the rollup
+	BaseFee       *hexutil.Big   `json:"baseFee"`       // the L2 base fee the L1 cost is converted at
+}
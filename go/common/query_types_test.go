@@ -51,3 +51,35 @@ func TestPublicBlockListing_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestCrossChainMessageQuery_UnmarshalJSON(t *testing.T) {
+	txHash := common.HexToHash("0x1234")
+	sender := common.HexToAddress("0x5678")
+	sequence := uint64(3)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    CrossChainMessageQuery
+		wantErr bool
+	}{
+		{name: "Tx hash", input: `"` + txHash.Hex() + `"`, want: CrossChainMessageQuery{TxHash: &txHash}},
+		{name: "Tx hash object", input: `{"txHash":"` + txHash.Hex() + `"}`, want: CrossChainMessageQuery{TxHash: &txHash}},
+		{name: "Sender and sequence", input: `{"sender":"` + sender.Hex() + `","sequence":3}`, want: CrossChainMessageQuery{Sender: &sender, Sequence: &sequence}},
+		{name: "Sender without sequence", input: `{"sender":"` + sender.Hex() + `"}`, wantErr: true},
+		{name: "Not a hash", input: `"0x12"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query CrossChainMessageQuery
+			err := json.Unmarshal([]byte(tt.input), &query)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, query)
+		})
+	}
+}
@@ -225,8 +225,12 @@ func ToRollupHeaderMsg(header *common.RollupHeader) *generated.RollupHeaderMsg {
 		S:                  header.S.Bytes(),
 		Coinbase:           header.Coinbase.Bytes(),
 		CrossChainMessages: ToCrossChainMsgs(header.CrossChainMessages),
+		CrossChainRoots:    make([][]byte, len(header.CrossChainRoots)),
 		LastBatchSeqNo:     header.LastBatchSeqNo,
 	}
+	for i, root := range header.CrossChainRoots {
+		headerMsg.CrossChainRoots[i] = root.Bytes()
+	}
 
 	return &headerMsg
 }
@@ -252,12 +256,17 @@ func FromRollupHeaderMsg(header *generated.RollupHeaderMsg) *common.RollupHeader
 
 	r := &big.Int{}
 	s := &big.Int{}
+	crossChainRoots := make([]gethcommon.Hash, len(header.CrossChainRoots))
+	for i, root := range header.CrossChainRoots {
+		crossChainRoots[i] = gethcommon.BytesToHash(root)
+	}
 	return &common.RollupHeader{
 		CompressionL1Head:  gethcommon.BytesToHash(header.CompressionL1Head),
 		R:                  r.SetBytes(header.R),
 		S:                  s.SetBytes(header.S),
 		Coinbase:           gethcommon.BytesToAddress(header.Coinbase),
 		CrossChainMessages: FromCrossChainMsgs(header.CrossChainMessages),
+		CrossChainRoots:    crossChainRoots,
 		LastBatchSeqNo:     header.LastBatchSeqNo,
 	}
 }
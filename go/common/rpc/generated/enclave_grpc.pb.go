@@ -19,38 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EnclaveProto_Status_FullMethodName                   = "/generated.EnclaveProto/Status"
-	EnclaveProto_Attestation_FullMethodName              = "/generated.EnclaveProto/Attestation"
-	EnclaveProto_GenerateSecret_FullMethodName           = "/generated.EnclaveProto/GenerateSecret"
-	EnclaveProto_InitEnclave_FullMethodName              = "/generated.EnclaveProto/InitEnclave"
-	EnclaveProto_EnclaveID_FullMethodName                = "/generated.EnclaveProto/EnclaveID"
-	EnclaveProto_SubmitL1Block_FullMethodName            = "/generated.EnclaveProto/SubmitL1Block"
-	EnclaveProto_SubmitTx_FullMethodName                 = "/generated.EnclaveProto/SubmitTx"
-	EnclaveProto_SubmitBatch_FullMethodName              = "/generated.EnclaveProto/SubmitBatch"
-	EnclaveProto_ObsCall_FullMethodName                  = "/generated.EnclaveProto/ObsCall"
-	EnclaveProto_GetTransactionCount_FullMethodName      = "/generated.EnclaveProto/GetTransactionCount"
-	EnclaveProto_Stop_FullMethodName                     = "/generated.EnclaveProto/Stop"
-	EnclaveProto_GetTransaction_FullMethodName           = "/generated.EnclaveProto/GetTransaction"
-	EnclaveProto_GetTransactionReceipt_FullMethodName    = "/generated.EnclaveProto/GetTransactionReceipt"
-	EnclaveProto_GetBalance_FullMethodName               = "/generated.EnclaveProto/GetBalance"
-	EnclaveProto_GetCode_FullMethodName                  = "/generated.EnclaveProto/GetCode"
-	EnclaveProto_Subscribe_FullMethodName                = "/generated.EnclaveProto/Subscribe"
-	EnclaveProto_Unsubscribe_FullMethodName              = "/generated.EnclaveProto/Unsubscribe"
-	EnclaveProto_EstimateGas_FullMethodName              = "/generated.EnclaveProto/EstimateGas"
-	EnclaveProto_GetLogs_FullMethodName                  = "/generated.EnclaveProto/GetLogs"
-	EnclaveProto_HealthCheck_FullMethodName              = "/generated.EnclaveProto/HealthCheck"
-	EnclaveProto_GetBatch_FullMethodName                 = "/generated.EnclaveProto/GetBatch"
-	EnclaveProto_GetBatchBySeqNo_FullMethodName          = "/generated.EnclaveProto/GetBatchBySeqNo"
-	EnclaveProto_GetRollupData_FullMethodName            = "/generated.EnclaveProto/GetRollupData"
-	EnclaveProto_CreateBatch_FullMethodName              = "/generated.EnclaveProto/CreateBatch"
-	EnclaveProto_CreateRollup_FullMethodName             = "/generated.EnclaveProto/CreateRollup"
-	EnclaveProto_DebugTraceTransaction_FullMethodName    = "/generated.EnclaveProto/DebugTraceTransaction"
-	EnclaveProto_StreamL2Updates_FullMethodName          = "/generated.EnclaveProto/StreamL2Updates"
-	EnclaveProto_DebugEventLogRelevancy_FullMethodName   = "/generated.EnclaveProto/DebugEventLogRelevancy"
-	EnclaveProto_GetTotalContractCount_FullMethodName    = "/generated.EnclaveProto/GetTotalContractCount"
-	EnclaveProto_GetReceiptsByAddress_FullMethodName     = "/generated.EnclaveProto/GetReceiptsByAddress"
-	EnclaveProto_GetPublicTransactionData_FullMethodName = "/generated.EnclaveProto/GetPublicTransactionData"
-	EnclaveProto_EnclavePublicConfig_FullMethodName      = "/generated.EnclaveProto/EnclavePublicConfig"
+	EnclaveProto_Status_FullMethodName                     = "/generated.EnclaveProto/Status"
+	EnclaveProto_Attestation_FullMethodName                = "/generated.EnclaveProto/Attestation"
+	EnclaveProto_GenerateSecret_FullMethodName             = "/generated.EnclaveProto/GenerateSecret"
+	EnclaveProto_InitEnclave_FullMethodName                = "/generated.EnclaveProto/InitEnclave"
+	EnclaveProto_EnclaveID_FullMethodName                  = "/generated.EnclaveProto/EnclaveID"
+	EnclaveProto_SubmitL1Block_FullMethodName              = "/generated.EnclaveProto/SubmitL1Block"
+	EnclaveProto_SubmitTx_FullMethodName                   = "/generated.EnclaveProto/SubmitTx"
+	EnclaveProto_SubmitBatch_FullMethodName                = "/generated.EnclaveProto/SubmitBatch"
+	EnclaveProto_ObsCall_FullMethodName                    = "/generated.EnclaveProto/ObsCall"
+	EnclaveProto_GetTransactionCount_FullMethodName        = "/generated.EnclaveProto/GetTransactionCount"
+	EnclaveProto_Stop_FullMethodName                       = "/generated.EnclaveProto/Stop"
+	EnclaveProto_GetTransaction_FullMethodName             = "/generated.EnclaveProto/GetTransaction"
+	EnclaveProto_GetTransactionReceipt_FullMethodName      = "/generated.EnclaveProto/GetTransactionReceipt"
+	EnclaveProto_GetBalance_FullMethodName                 = "/generated.EnclaveProto/GetBalance"
+	EnclaveProto_GetCode_FullMethodName                    = "/generated.EnclaveProto/GetCode"
+	EnclaveProto_Subscribe_FullMethodName                  = "/generated.EnclaveProto/Subscribe"
+	EnclaveProto_Unsubscribe_FullMethodName                = "/generated.EnclaveProto/Unsubscribe"
+	EnclaveProto_EstimateGas_FullMethodName                = "/generated.EnclaveProto/EstimateGas"
+	EnclaveProto_EstimateGasBreakdown_FullMethodName       = "/generated.EnclaveProto/EstimateGasBreakdown"
+	EnclaveProto_GetLogs_FullMethodName                    = "/generated.EnclaveProto/GetLogs"
+	EnclaveProto_HealthCheck_FullMethodName                = "/generated.EnclaveProto/HealthCheck"
+	EnclaveProto_GetBatch_FullMethodName                   = "/generated.EnclaveProto/GetBatch"
+	EnclaveProto_GetBatchBySeqNo_FullMethodName            = "/generated.EnclaveProto/GetBatchBySeqNo"
+	EnclaveProto_GetRollupData_FullMethodName              = "/generated.EnclaveProto/GetRollupData"
+	EnclaveProto_CreateBatch_FullMethodName                = "/generated.EnclaveProto/CreateBatch"
+	EnclaveProto_CreateRollup_FullMethodName               = "/generated.EnclaveProto/CreateRollup"
+	EnclaveProto_DebugTraceTransaction_FullMethodName      = "/generated.EnclaveProto/DebugTraceTransaction"
+	EnclaveProto_DebugTraceCall_FullMethodName             = "/generated.EnclaveProto/DebugTraceCall"
+	EnclaveProto_DebugTraceBatch_FullMethodName            = "/generated.EnclaveProto/DebugTraceBatch"
+	EnclaveProto_StreamL2Updates_FullMethodName            = "/generated.EnclaveProto/StreamL2Updates"
+	EnclaveProto_DebugEventLogRelevancy_FullMethodName     = "/generated.EnclaveProto/DebugEventLogRelevancy"
+	EnclaveProto_GetTotalContractCount_FullMethodName      = "/generated.EnclaveProto/GetTotalContractCount"
+	EnclaveProto_GetReceiptsByAddress_FullMethodName       = "/generated.EnclaveProto/GetReceiptsByAddress"
+	EnclaveProto_GetPublicTransactionData_FullMethodName   = "/generated.EnclaveProto/GetPublicTransactionData"
+	EnclaveProto_GetBatchRewardPercentiles_FullMethodName  = "/generated.EnclaveProto/GetBatchRewardPercentiles"
+	EnclaveProto_GetCrossChainProof_FullMethodName         = "/generated.EnclaveProto/GetCrossChainProof"
+	EnclaveProto_GetCrossChainMessageStatus_FullMethodName = "/generated.EnclaveProto/GetCrossChainMessageStatus"
+	EnclaveProto_EnclavePublicConfig_FullMethodName        = "/generated.EnclaveProto/EnclavePublicConfig"
 )
 
 // EnclaveProtoClient is the client API for EnclaveProto service.
@@ -101,6 +107,8 @@ type EnclaveProtoClient interface {
 	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// EstimateGasBreakdown returns the gas estimation split between the execution and the L1 publishing cost
+	EstimateGasBreakdown(ctx context.Context, in *EstimateGasBreakdownRequest, opts ...grpc.CallOption) (*EstimateGasBreakdownResponse, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*HealthCheckResponse, error)
@@ -112,12 +120,23 @@ type EnclaveProtoClient interface {
 	GetRollupData(ctx context.Context, in *GetRollupDataRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error)
 	CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error)
 	CreateRollup(ctx context.Context, in *CreateRollupRequest, opts ...grpc.CallOption) (*CreateRollupResponse, error)
+	// DebugTraceTransaction returns the trace of a transaction, encrypted with the viewing key of the transaction sender
 	DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error)
+	// DebugTraceCall returns the trace of a call, encrypted with the viewing key of the caller
+	DebugTraceCall(ctx context.Context, in *DebugTraceCallRequest, opts ...grpc.CallOption) (*DebugTraceCallResponse, error)
+	// DebugTraceBatch returns the traces of the transactions in a batch sent by the viewing key account
+	DebugTraceBatch(ctx context.Context, in *DebugTraceBatchRequest, opts ...grpc.CallOption) (*DebugTraceBatchResponse, error)
 	StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error)
 	DebugEventLogRelevancy(ctx context.Context, in *DebugEventLogRelevancyRequest, opts ...grpc.CallOption) (*DebugEventLogRelevancyResponse, error)
 	GetTotalContractCount(ctx context.Context, in *GetTotalContractCountRequest, opts ...grpc.CallOption) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(ctx context.Context, in *GetReceiptsByAddressRequest, opts ...grpc.CallOption) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(ctx context.Context, in *GetPublicTransactionDataRequest, opts ...grpc.CallOption) (*GetPublicTransactionDataResponse, error)
+	// GetBatchRewardPercentiles returns the priority fees paid in each of the batches at the requested percentiles
+	GetBatchRewardPercentiles(ctx context.Context, in *GetBatchRewardPercentilesRequest, opts ...grpc.CallOption) (*GetBatchRewardPercentilesResponse, error)
+	// GetCrossChainProof returns the inclusion proof of an outbound message or value transfer in the cross chain tree of a batch
+	GetCrossChainProof(ctx context.Context, in *GetCrossChainProofRequest, opts ...grpc.CallOption) (*GetCrossChainProofResponse, error)
+	// GetCrossChainMessageStatus returns the lifecycle stages reached by the cross chain messages matching a query
+	GetCrossChainMessageStatus(ctx context.Context, in *GetCrossChainMessageStatusRequest, opts ...grpc.CallOption) (*GetCrossChainMessageStatusResponse, error)
 	// EnclavePublicConfig returns public network data that is known to the enclave but may not be known to the host
 	EnclavePublicConfig(ctx context.Context, in *EnclavePublicConfigRequest, opts ...grpc.CallOption) (*EnclavePublicConfigResponse, error)
 }
@@ -292,6 +311,15 @@ func (c *enclaveProtoClient) EstimateGas(ctx context.Context, in *EstimateGasReq
 	return out, nil
 }
 
+func (c *enclaveProtoClient) EstimateGasBreakdown(ctx context.Context, in *EstimateGasBreakdownRequest, opts ...grpc.CallOption) (*EstimateGasBreakdownResponse, error) {
+	out := new(EstimateGasBreakdownResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_EstimateGasBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
 	out := new(GetLogsResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_GetLogs_FullMethodName, in, out, opts...)
@@ -364,6 +392,24 @@ func (c *enclaveProtoClient) DebugTraceTransaction(ctx context.Context, in *Debu
 	return out, nil
 }
 
+func (c *enclaveProtoClient) DebugTraceCall(ctx context.Context, in *DebugTraceCallRequest, opts ...grpc.CallOption) (*DebugTraceCallResponse, error) {
+	out := new(DebugTraceCallResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_DebugTraceCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) DebugTraceBatch(ctx context.Context, in *DebugTraceBatchRequest, opts ...grpc.CallOption) (*DebugTraceBatchResponse, error) {
+	out := new(DebugTraceBatchResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_DebugTraceBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[0], EnclaveProto_StreamL2Updates_FullMethodName, opts...)
 	if err != nil {
@@ -432,6 +478,33 @@ func (c *enclaveProtoClient) GetPublicTransactionData(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetBatchRewardPercentiles(ctx context.Context, in *GetBatchRewardPercentilesRequest, opts ...grpc.CallOption) (*GetBatchRewardPercentilesResponse, error) {
+	out := new(GetBatchRewardPercentilesResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetBatchRewardPercentiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetCrossChainProof(ctx context.Context, in *GetCrossChainProofRequest, opts ...grpc.CallOption) (*GetCrossChainProofResponse, error) {
+	out := new(GetCrossChainProofResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetCrossChainProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetCrossChainMessageStatus(ctx context.Context, in *GetCrossChainMessageStatusRequest, opts ...grpc.CallOption) (*GetCrossChainMessageStatusResponse, error) {
+	out := new(GetCrossChainMessageStatusResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetCrossChainMessageStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) EnclavePublicConfig(ctx context.Context, in *EnclavePublicConfigRequest, opts ...grpc.CallOption) (*EnclavePublicConfigResponse, error) {
 	out := new(EnclavePublicConfigResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_EnclavePublicConfig_FullMethodName, in, out, opts...)
@@ -489,6 +562,8 @@ type EnclaveProtoServer interface {
 	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+	// EstimateGasBreakdown returns the gas estimation split between the execution and the L1 publishing cost
+	EstimateGasBreakdown(context.Context, *EstimateGasBreakdownRequest) (*EstimateGasBreakdownResponse, error)
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(context.Context, *EmptyArgs) (*HealthCheckResponse, error)
@@ -500,12 +575,23 @@ type EnclaveProtoServer interface {
 	GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error)
 	CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error)
 	CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error)
+	// DebugTraceTransaction returns the trace of a transaction, encrypted with the viewing key of the transaction sender
 	DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error)
+	// DebugTraceCall returns the trace of a call, encrypted with the viewing key of the caller
+	DebugTraceCall(context.Context, *DebugTraceCallRequest) (*DebugTraceCallResponse, error)
+	// DebugTraceBatch returns the traces of the transactions in a batch sent by the viewing key account
+	DebugTraceBatch(context.Context, *DebugTraceBatchRequest) (*DebugTraceBatchResponse, error)
 	StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error
 	DebugEventLogRelevancy(context.Context, *DebugEventLogRelevancyRequest) (*DebugEventLogRelevancyResponse, error)
 	GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(context.Context, *GetReceiptsByAddressRequest) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(context.Context, *GetPublicTransactionDataRequest) (*GetPublicTransactionDataResponse, error)
+	// GetBatchRewardPercentiles returns the priority fees paid in each of the batches at the requested percentiles
+	GetBatchRewardPercentiles(context.Context, *GetBatchRewardPercentilesRequest) (*GetBatchRewardPercentilesResponse, error)
+	// GetCrossChainProof returns the inclusion proof of an outbound message or value transfer in the cross chain tree of a batch
+	GetCrossChainProof(context.Context, *GetCrossChainProofRequest) (*GetCrossChainProofResponse, error)
+	// GetCrossChainMessageStatus returns the lifecycle stages reached by the cross chain messages matching a query
+	GetCrossChainMessageStatus(context.Context, *GetCrossChainMessageStatusRequest) (*GetCrossChainMessageStatusResponse, error)
 	// EnclavePublicConfig returns public network data that is known to the enclave but may not be known to the host
 	EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error)
 	mustEmbedUnimplementedEnclaveProtoServer()
@@ -569,6 +655,9 @@ func (UnimplementedEnclaveProtoServer) Unsubscribe(context.Context, *Unsubscribe
 func (UnimplementedEnclaveProtoServer) EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedEnclaveProtoServer) EstimateGasBreakdown(context.Context, *EstimateGasBreakdownRequest) (*EstimateGasBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasBreakdown not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
@@ -593,6 +682,12 @@ func (UnimplementedEnclaveProtoServer) CreateRollup(context.Context, *CreateRoll
 func (UnimplementedEnclaveProtoServer) DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceTransaction not implemented")
 }
+func (UnimplementedEnclaveProtoServer) DebugTraceCall(context.Context, *DebugTraceCallRequest) (*DebugTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceCall not implemented")
+}
+func (UnimplementedEnclaveProtoServer) DebugTraceBatch(context.Context, *DebugTraceBatchRequest) (*DebugTraceBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceBatch not implemented")
+}
 func (UnimplementedEnclaveProtoServer) StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamL2Updates not implemented")
 }
@@ -608,6 +703,15 @@ func (UnimplementedEnclaveProtoServer) GetReceiptsByAddress(context.Context, *Ge
 func (UnimplementedEnclaveProtoServer) GetPublicTransactionData(context.Context, *GetPublicTransactionDataRequest) (*GetPublicTransactionDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicTransactionData not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetBatchRewardPercentiles(context.Context, *GetBatchRewardPercentilesRequest) (*GetBatchRewardPercentilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchRewardPercentiles not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetCrossChainProof(context.Context, *GetCrossChainProofRequest) (*GetCrossChainProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainProof not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetCrossChainMessageStatus(context.Context, *GetCrossChainMessageStatusRequest) (*GetCrossChainMessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainMessageStatus not implemented")
+}
 func (UnimplementedEnclaveProtoServer) EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnclavePublicConfig not implemented")
 }
@@ -948,6 +1052,24 @@ func _EnclaveProto_EstimateGas_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_EstimateGasBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).EstimateGasBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_EstimateGasBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).EstimateGasBreakdown(ctx, req.(*EstimateGasBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLogsRequest)
 	if err := dec(in); err != nil {
@@ -1092,6 +1214,42 @@ func _EnclaveProto_DebugTraceTransaction_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_DebugTraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).DebugTraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_DebugTraceCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).DebugTraceCall(ctx, req.(*DebugTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_DebugTraceBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugTraceBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).DebugTraceBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_DebugTraceBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).DebugTraceBatch(ctx, req.(*DebugTraceBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_StreamL2Updates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamL2UpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1185,6 +1343,60 @@ func _EnclaveProto_GetPublicTransactionData_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetBatchRewardPercentiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRewardPercentilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchRewardPercentiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetBatchRewardPercentiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchRewardPercentiles(ctx, req.(*GetBatchRewardPercentilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetCrossChainProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossChainProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetCrossChainProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetCrossChainProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetCrossChainProof(ctx, req.(*GetCrossChainProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetCrossChainMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossChainMessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetCrossChainMessageStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageStatus(ctx, req.(*GetCrossChainMessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_EnclavePublicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnclavePublicConfigRequest)
 	if err := dec(in); err != nil {
@@ -1282,6 +1494,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _EnclaveProto_EstimateGas_Handler,
 		},
+		{
+			MethodName: "EstimateGasBreakdown",
+			Handler:    _EnclaveProto_EstimateGasBreakdown_Handler,
+		},
 		{
 			MethodName: "GetLogs",
 			Handler:    _EnclaveProto_GetLogs_Handler,
@@ -1314,6 +1530,14 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DebugTraceTransaction",
 			Handler:    _EnclaveProto_DebugTraceTransaction_Handler,
 		},
+		{
+			MethodName: "DebugTraceCall",
+			Handler:    _EnclaveProto_DebugTraceCall_Handler,
+		},
+		{
+			MethodName: "DebugTraceBatch",
+			Handler:    _EnclaveProto_DebugTraceBatch_Handler,
+		},
 		{
 			MethodName: "DebugEventLogRelevancy",
 			Handler:    _EnclaveProto_DebugEventLogRelevancy_Handler,
@@ -1330,6 +1554,18 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicTransactionData",
 			Handler:    _EnclaveProto_GetPublicTransactionData_Handler,
 		},
+		{
+			MethodName: "GetBatchRewardPercentiles",
+			Handler:    _EnclaveProto_GetBatchRewardPercentiles_Handler,
+		},
+		{
+			MethodName: "GetCrossChainProof",
+			Handler:    _EnclaveProto_GetCrossChainProof_Handler,
+		},
+		{
+			MethodName: "GetCrossChainMessageStatus",
+			Handler:    _EnclaveProto_GetCrossChainMessageStatus_Handler,
+		},
 		{
 			MethodName: "EnclavePublicConfig",
 			Handler:    _EnclaveProto_EnclavePublicConfig_Handler,
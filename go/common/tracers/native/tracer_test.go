@@ -0,0 +1,89 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+)
+
+// returns 42 to any call: PUSH1 0x2a PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+var returnFortyTwo = hexutil.MustDecode("0x602a60005260206000f3")
+
+func TestCallTracerTracesKnownTransaction(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	contract := gethcommon.HexToAddress("0xc0ffee")
+
+	statedb, err := gethstate.New(types.EmptyRootHash, gethstate.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	statedb.SetBalance(sender, big.NewInt(params.Ether))
+	statedb.SetCode(contract, returnFortyTwo)
+
+	signer := types.LatestSigner(params.AllEthashProtocolChanges)
+	tx, err := types.SignNewTx(key, signer, &types.LegacyTx{
+		Nonce:    0,
+		To:       &contract,
+		Gas:      50_000,
+		GasPrice: big.NewInt(params.GWei),
+		Data:     []byte{0x01, 0x02, 0x03, 0x04},
+	})
+	require.NoError(t, err)
+	msg, err := gethcore.TransactionToMessage(tx, signer, nil)
+	require.NoError(t, err)
+
+	tracer, err := tracers.New("callTracer", &tracers.Context{TxHash: tx.Hash()}, nil)
+	require.NoError(t, err)
+
+	blockContext := vm.BlockContext{
+		CanTransfer: gethcore.CanTransfer,
+		Transfer:    gethcore.Transfer,
+		BlockNumber: big.NewInt(1),
+		Time:        1,
+		Difficulty:  big.NewInt(0),
+		GasLimit:    params.MaxGasLimit,
+		BaseFee:     big.NewInt(0),
+	}
+	env := vm.NewEVM(blockContext, gethcore.NewEVMTxContext(msg), statedb, params.AllEthashProtocolChanges, vm.Config{Tracer: tracer})
+	_, err = gethcore.ApplyMessage(env, msg, new(gethcore.GasPool).AddGas(tx.Gas()))
+	require.NoError(t, err)
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	var frame callFrame
+	require.NoError(t, json.Unmarshal(result, &frame))
+	var frameType struct{ Type string }
+	require.NoError(t, json.Unmarshal(result, &frameType))
+
+	require.Equal(t, vm.CALL.String(), frameType.Type)
+	require.Equal(t, sender, frame.From)
+	require.Equal(t, &contract, frame.To)
+	require.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, frame.Input)
+	require.Equal(t, gethcommon.LeftPadBytes([]byte{42}, 32), frame.Output)
+	require.Equal(t, uint64(50_000), frame.Gas)
+	// the intrinsic gas, plus 3 PUSH1, 1 MSTORE with a memory expansion, 2 PUSH1 and a RETURN
+	require.Equal(t, params.TxGas+params.TxDataNonZeroGasEIP2028*4+18, frame.GasUsed)
+	require.Empty(t, frame.Error)
+}
+
+func TestNewReturnsTheErrorsOfTheTracer(t *testing.T) {
+	_, err := tracers.New("unknownTracer", &tracers.Context{}, nil)
+	require.ErrorIs(t, err, tracers.ErrTracerNotFound)
+
+	_, err = tracers.New("callTracer", &tracers.Context{}, json.RawMessage(`{"onlyTopCall": "yes"}`))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, tracers.ErrTracerNotFound)
+}
@@ -7,6 +7,7 @@ package tracers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/core/vm"
 
@@ -25,6 +26,13 @@ type TraceConfig struct {
 	TracerConfig json.RawMessage
 }
 
+// TxTraceResult is the result of a single transaction trace during a batch trace.
+type TxTraceResult struct {
+	TxHash gethcommon.Hash `json:"txHash"`           // transaction hash
+	Result interface{}     `json:"result,omitempty"` // Trace results produced by the tracer
+	Error  string          `json:"error,omitempty"`  // Trace failure produced by the tracer
+}
+
 // Context contains some contextual infos for a transaction execution that is not
 // available from within the EVM object.
 type Context struct {
@@ -42,7 +50,10 @@ type Tracer interface {
 	Stop(err error)
 }
 
-type lookupFunc func(string, *Context) (Tracer, error)
+// ErrTracerNotFound - returned by the lookups that don't know the requested tracer
+var ErrTracerNotFound = errors.New("tracer not found")
+
+type lookupFunc func(string, *Context, json.RawMessage) (Tracer, error)
 
 var lookups []lookupFunc
 
@@ -59,12 +70,39 @@ func RegisterLookup(wildcard bool, lookup lookupFunc) {
 }
 
 // New returns a new instance of a tracer, by iterating through the
-// registered lookups.
-func New(code string, ctx *Context) (Tracer, error) {
+// registered lookups. The cfg is handed to the tracer constructor untouched, and the errors of the constructor
+// (e.g. an invalid config) are returned as they are.
+func New(code string, ctx *Context, cfg json.RawMessage) (Tracer, error) {
 	for _, lookup := range lookups {
-		if tracer, err := lookup(code, ctx); err == nil {
-			return tracer, nil
+		tracer, err := lookup(code, ctx, cfg)
+		if errors.Is(err, ErrTracerNotFound) {
+			continue
 		}
+		return tracer, err
+	}
+	return nil, ErrTracerNotFound
+}
+
+const (
+	memoryPadLimit = 1024 * 1024
+)
+
+// GetMemoryCopyPadded returns offset + size as a new slice.
+// It zero-pads the slice if it extends beyond memory bounds.
+func GetMemoryCopyPadded(m *vm.Memory, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, errors.New("offset or size must not be negative")
+	}
+	if int(offset+size) < m.Len() { // slice fully inside memory
+		return m.GetCopy(offset, size), nil
+	}
+	paddingNeeded := int(offset+size) - m.Len()
+	if paddingNeeded > memoryPadLimit {
+		return nil, fmt.Errorf("reached limit for padding memory slice: %d", paddingNeeded)
+	}
+	cpy := make([]byte, size)
+	if overlap := int64(m.Len()) - offset; overlap > 0 {
+		copy(cpy, m.GetPtr(offset, overlap))
 	}
-	return nil, errors.New("tracer not found")
+	return cpy, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 )
@@ -33,6 +34,7 @@ type (
 	L1Transaction = types.Transaction
 	L1Receipt     = types.Receipt
 	L1Receipts    = types.Receipts
+	L1Blobs       = []*kzg4844.Blob
 
 	// Local Obscuro aliases
 	L2BatchHash    = common.Hash
@@ -52,6 +54,15 @@ type (
 
 	CrossChainMessage  = MessageBus.StructsCrossChainMessage
 	CrossChainMessages = []CrossChainMessage
+	// ValueTransferMessage is an outbound value transfer as it is claimed on the L1. The sequence number of its batch and
+	// its position among the transfers of the batch tell apart otherwise identical transfers.
+	ValueTransferMessage struct {
+		Sender        common.Address `json:"sender"`
+		Receiver      common.Address `json:"receiver"`
+		Amount        *big.Int       `json:"amount"`
+		BatchSequence uint64         `json:"batchSequence"`
+		Index         uint32         `json:"index"`
+	}
 	ValueTransferEvent struct {
 		Sender   common.Address
 		Receiver common.Address
@@ -71,6 +82,9 @@ type (
 	EncryptedParamsEstimateGas     []byte // As above, but for an RPC estimateGas request.
 	EncryptedParamsGetLogs         []byte // As above, but for an RPC getLogs request.
 	EncryptedParamsGetStorageAt    []byte
+	EncryptedParamsDebugTraceTx    []byte // As above, but for an RPC debug_traceTransaction request.
+	EncryptedParamsDebugTraceCall  []byte // As above, but for an RPC debug_traceCall request.
+	EncryptedParamsDebugTraceBatch []byte // As above, but for an RPC debug_traceBatchByNumber or debug_traceBatchByHash request.
 
 	Nonce               = uint64
 	EncodedRollup       []byte
@@ -125,12 +139,13 @@ type BlockAndReceipts struct {
 	Block                  *types.Block
 	ReceiptsMap            map[int]*types.Receipt // sparse map with obscuro-relevant receipts in it
 	Receipts               *types.Receipts
+	Blobs                  L1Blobs // the blobs of the obscuro-relevant blob transactions, in any order
 	successfulTransactions *types.Transactions
 }
 
 // ParseBlockAndReceipts - will create a container struct that has preprocessed the receipts
 // and verified if they indeed match the receipt root hash in the block.
-func ParseBlockAndReceipts(block *L1Block, receipts *L1Receipts) (*BlockAndReceipts, error) {
+func ParseBlockAndReceipts(block *L1Block, receipts *L1Receipts, blobs L1Blobs) (*BlockAndReceipts, error) {
 	if len(block.Transactions()) != len(*receipts) {
 		// the receipts list is currently a *sparse* list of relevant receipts, it needs to have the same length as the
 		// transactions list even though some of the entries may be nil
@@ -140,6 +155,7 @@ func ParseBlockAndReceipts(block *L1Block, receipts *L1Receipts) (*BlockAndRecei
 	br := BlockAndReceipts{
 		Block:                  block,
 		Receipts:               receipts,
+		Blobs:                  blobs,
 		ReceiptsMap:            make(map[int]*types.Receipt, len(block.Transactions())),
 		successfulTransactions: nil,
 	}
@@ -192,3 +208,14 @@ func (cf *ChainFork) String() string {
 	return fmt.Sprintf("ChainFork{NewCanonical: %s, OldCanonical: %s, CommonAncestor: %s, CanonicalPath: %s, NonCanonicalPath: %s}",
 		cf.NewCanonical.Hash(), cf.OldCanonical.Hash(), cf.CommonAncestor.Hash(), cf.CanonicalPath, cf.NonCanonicalPath)
 }
+
+// CrossChainProof - the inclusion proof of an outbound message or value transfer in the cross chain tree of a batch (the
+// TransfersTree of its header), which is what the management contract checks to relay it on the L1
+type CrossChainProof struct {
+	BatchHash     L2BatchHash           `json:"batchHash"`
+	Root          common.Hash           `json:"root"`
+	Leaf          common.Hash           `json:"leaf"`
+	Proof         []common.Hash         `json:"proof"`
+	Message       *CrossChainMessage    `json:"message,omitempty"`       // set for a message
+	ValueTransfer *ValueTransferMessage `json:"valueTransfer,omitempty"` // set for a value transfer
+}
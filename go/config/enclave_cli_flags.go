@@ -34,6 +34,7 @@ const (
 	L2CoinbaseFlag                = "l2Coinbase"
 	GasBatchExecutionLimit        = "gasBatchExecutionLimit"
 	GasLocalExecutionCapFlag      = "gasLocalExecutionCap"
+	CrossChainTreeSeqNoFlag       = "crossChainTreeSeqNo"
 )
 
 // EnclaveFlags are the flags that the enclave can receive
@@ -64,6 +65,7 @@ var EnclaveFlags = map[string]*flag.TenFlag{
 	ProfilerEnabledFlag:           flag.NewBoolFlag(ProfilerEnabledFlag, false, "Runs a profiler instance (Defaults to false)"),
 	DebugNamespaceEnabledFlag:     flag.NewBoolFlag(DebugNamespaceEnabledFlag, false, "Whether the debug namespace is enabled"),
 	GasLocalExecutionCapFlag:      flag.NewUint64Flag(GasLocalExecutionCapFlag, 4_000_000_000, "Max gas usage when executing local transactions"),
+	CrossChainTreeSeqNoFlag:       flag.NewUint64Flag(CrossChainTreeSeqNoFlag, 0, "The sequence number of the first batch whose transfers tree is the root of its cross chain tree. Networks with earlier batches must set it above their latest batch"),
 }
 
 // enclaveRestrictedFlags are the flags that the enclave can receive ONLY over the Ego signed enclave.json
@@ -69,6 +69,9 @@ type EnclaveConfig struct {
 	BaseFee                  *big.Int
 	GasBatchExecutionLimit   uint64
 	GasLocalExecutionCapFlag uint64
+	// The sequence number of the first batch whose TransfersTree is the root of its cross chain tree, the earlier
+	// batches commit to the hash of their value transfers and can't be withdrawn against
+	CrossChainTreeSeqNo uint64
 }
 
 func NewConfigFromFlags(cliFlags map[string]*flag.TenFlag) (*EnclaveConfig, error) {
@@ -187,6 +190,7 @@ func newConfig(flags map[string]*flag.TenFlag) (*EnclaveConfig, error) {
 	cfg.GasPaymentAddress = gethcommon.HexToAddress(flags[L2CoinbaseFlag].String())
 	cfg.GasBatchExecutionLimit = flags[GasBatchExecutionLimit].Uint64()
 	cfg.GasLocalExecutionCapFlag = flags[GasLocalExecutionCapFlag].Uint64()
+	cfg.CrossChainTreeSeqNo = flags[CrossChainTreeSeqNoFlag].Uint64()
 
 	return cfg, nil
 }
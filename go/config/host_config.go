@@ -33,18 +33,28 @@ type HostInputConfig struct {
 	ClientRPCHost string
 	// Address on which to connect to the enclave
 	EnclaveRPCAddress string
+	// EnclaveRPCAddresses are the addresses of all the enclaves of the host, which fail over to each other. If empty,
+	// the host only uses the enclave at EnclaveRPCAddress
+	EnclaveRPCAddresses []string
 	// P2PBindAddress is the address where the P2P server is bound to
 	P2PBindAddress string
 	// P2PPublicAddress is the advertised P2P server address
 	P2PPublicAddress string
 	// L1WebsocketURL is the RPC address for interactions with the L1
 	L1WebsocketURL string
+	// L1BeaconURL is the HTTP address of the L1 beacon node API, used to fetch the blobs of the rollups published with blobs
+	L1BeaconURL string
 	// Timeout duration for RPC requests to the enclave service
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
+	// IsP2PGossipEnabled specifies whether validators relay live batches to their peers, instead of every validator
+	// receiving them from the sequencer
+	IsP2PGossipEnabled bool
+	// P2PGossipFanout is the number of peers each live batch is sent or relayed to when gossip is enabled
+	P2PGossipFanout int
 	// The rollup contract address on the L1 network
 	ManagementContractAddress gethcommon.Address
 	// The message bus contract address on the L1 network
@@ -99,10 +109,17 @@ type HostInputConfig struct {
 
 	// MaxRollupSize specifies the threshold size which the sequencer-host publishes a rollup
 	MaxRollupSize uint64
+
+	// UseBlobsForRollups specifies whether the sequencer-host publishes the rollup payloads as blobs (EIP-4844) instead of calldata
+	UseBlobsForRollups bool
 }
 
 // ToHostConfig returns a HostConfig given a HostInputConfig
 func (p HostInputConfig) ToHostConfig() *HostConfig {
+	enclaveRPCAddresses := p.EnclaveRPCAddresses
+	if len(enclaveRPCAddresses) == 0 {
+		enclaveRPCAddresses = []string{p.EnclaveRPCAddress}
+	}
 	return &HostConfig{
 		IsGenesis:                 p.IsGenesis,
 		NodeType:                  p.NodeType,
@@ -112,12 +129,16 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		ClientRPCPortWS:           p.ClientRPCPortWS,
 		ClientRPCHost:             p.ClientRPCHost,
 		EnclaveRPCAddress:         p.EnclaveRPCAddress,
+		EnclaveRPCAddresses:       enclaveRPCAddresses,
 		P2PBindAddress:            p.P2PBindAddress,
 		P2PPublicAddress:          p.P2PPublicAddress,
 		L1WebsocketURL:            p.L1WebsocketURL,
+		L1BeaconURL:               p.L1BeaconURL,
 		EnclaveRPCTimeout:         p.EnclaveRPCTimeout,
 		L1RPCTimeout:              p.L1RPCTimeout,
 		P2PConnectionTimeout:      p.P2PConnectionTimeout,
+		IsP2PGossipEnabled:        p.IsP2PGossipEnabled,
+		P2PGossipFanout:           p.P2PGossipFanout,
 		ManagementContractAddress: p.ManagementContractAddress,
 		MessageBusAddress:         p.MessageBusAddress,
 		LogLevel:                  p.LogLevel,
@@ -140,6 +161,7 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		L1BlockTime:               p.L1BlockTime,
 		IsInboundP2PDisabled:      p.IsInboundP2PDisabled,
 		MaxRollupSize:             p.MaxRollupSize,
+		UseBlobsForRollups:        p.UseBlobsForRollups,
 	}
 }
 
@@ -170,6 +192,8 @@ type HostConfig struct {
 	RollupInterval time.Duration
 	// MaxRollupSize is the max size of the rollup
 	MaxRollupSize uint64
+	// UseBlobsForRollups specifies whether the rollup payloads are published as blobs (EIP-4844) instead of calldata
+	UseBlobsForRollups bool
 	// The expected time between blocks on the L1 network
 	L1BlockTime time.Duration
 
@@ -210,18 +234,28 @@ type HostConfig struct {
 	ClientRPCHost string
 	// Address on which to connect to the enclave
 	EnclaveRPCAddress string
+	// EnclaveRPCAddresses are the addresses of all the enclaves of the host, which fail over to each other. If empty,
+	// the host only uses the enclave at EnclaveRPCAddress
+	EnclaveRPCAddresses []string
 	// P2PBindAddress is the address where the P2P server is bound to
 	P2PBindAddress string
 	// P2PPublicAddress is the advertised P2P server address
 	P2PPublicAddress string
 	// L1WebsocketURL is the RPC address for interactions with the L1
 	L1WebsocketURL string
+	// L1BeaconURL is the HTTP address of the L1 beacon node API, used to fetch the blobs of the rollups published with blobs
+	L1BeaconURL string
 	// Timeout duration for RPC requests to the enclave service
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
+	// IsP2PGossipEnabled specifies whether validators relay live batches to their peers, instead of every validator
+	// receiving them from the sequencer
+	IsP2PGossipEnabled bool
+	// P2PGossipFanout is the number of peers each live batch is sent or relayed to when gossip is enabled
+	P2PGossipFanout int
 	// ProfilerEnabled starts a profiler instance
 	ProfilerEnabled bool
 	// MetricsEnabled defines whether the metrics are enabled or not
@@ -251,6 +285,8 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		EnclaveRPCTimeout:         time.Duration(defaultRPCTimeoutSecs) * time.Second,
 		L1RPCTimeout:              time.Duration(defaultL1RPCTimeoutSecs) * time.Second,
 		P2PConnectionTimeout:      time.Duration(defaultP2PTimeoutSecs) * time.Second,
+		IsP2PGossipEnabled:        false,
+		P2PGossipFanout:           3,
 		ManagementContractAddress: gethcommon.BytesToAddress([]byte("")),
 		MessageBusAddress:         gethcommon.BytesToAddress([]byte("")),
 		LogLevel:                  int(log.LvlInfo),
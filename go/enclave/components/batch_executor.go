@@ -14,17 +14,18 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/evm"
@@ -46,7 +47,8 @@ type batchExecutor struct {
 	// stateDBMutex - used to protect calls to stateDB.Commit as it is not safe for async access.
 	stateDBMutex sync.Mutex
 
-	batchGasLimit uint64 // max execution gas allowed in a batch
+	batchGasLimit       uint64 // max execution gas allowed in a batch
+	crossChainTreeSeqNo uint64 // the seq no of the first batch whose TransfersTree is the root of its cross chain tree
 }
 
 func NewBatchExecutor(
@@ -57,6 +59,7 @@ func NewBatchExecutor(
 	gasOracle gas.Oracle,
 	chainConfig *params.ChainConfig,
 	batchGasLimit uint64,
+	crossChainTreeSeqNo uint64,
 	logger gethlog.Logger,
 ) BatchExecutor {
 	return &batchExecutor{
@@ -69,6 +72,7 @@ func NewBatchExecutor(
 		gasOracle:            gasOracle,
 		stateDBMutex:         sync.Mutex{},
 		batchGasLimit:        batchGasLimit,
+		crossChainTreeSeqNo:  crossChainTreeSeqNo,
 	}
 }
 
@@ -183,14 +187,14 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 	syntheticTransactions := append(xchainTxs, freeTransactions...)
 
 	// fromTxIndex - Here we start from the 0 index. This will be the same for a validator.
-	successfulTxs, excludedTxs, txReceipts, err := executor.processTransactions(batch, 0, transactionsToProcess, stateDB, context.ChainConfig, false)
+	successfulTxs, excludedTxs, txReceipts, err := executor.processTransactions(batch, 0, transactionsToProcess, stateDB, context.ChainConfig, false, context.TracerFn)
 	if err != nil {
 		return nil, fmt.Errorf("could not process transactions. Cause: %w", err)
 	}
 
 	// fromTxIndex - Here we start from the len of the successful transactions; As long as we have the exact same successful transactions in a batch,
 	// we will start from the same place.
-	ccSuccessfulTxs, _, ccReceipts, err := executor.processTransactions(batch, len(successfulTxs), syntheticTransactions, stateDB, context.ChainConfig, true)
+	ccSuccessfulTxs, _, ccReceipts, err := executor.processTransactions(batch, len(successfulTxs), syntheticTransactions, stateDB, context.ChainConfig, true, context.TracerFn)
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +258,30 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts, error) {
 	defer core.LogMethodDuration(executor.logger, measure.NewStopwatch(), "Executed batch", log.BatchHashKey, batch.Hash())
 
+	cb, err := executor.recomputeBatch(batch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cb.Commit(true); err != nil {
+		return nil, fmt.Errorf("cannot commit stateDB for incoming valid batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	return cb.Receipts, nil
+}
+
+func (executor *batchExecutor) TraceBatch(batch *core.Batch, tracerFn evm.TracerFn) (types.Receipts, error) {
+	defer core.LogMethodDuration(executor.logger, measure.NewStopwatch(), "Traced batch", log.BatchHashKey, batch.Hash())
+
+	cb, err := executor.recomputeBatch(batch, tracerFn)
+	if err != nil {
+		return nil, err
+	}
+	return cb.Receipts, nil
+}
+
+// recomputeBatch - executes the batch on top of the state of its parent and checks the result against the batch
+func (executor *batchExecutor) recomputeBatch(batch *core.Batch, tracerFn evm.TracerFn) (*ComputedBatch, error) {
 	// Validators recompute the entire batch using the same batch context
 	// if they have all necessary prerequisites like having the l1 block processed
 	// and the parent hash. This recomputed batch is then checked against the incoming batch.
@@ -268,6 +296,7 @@ func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts,
 		SequencerNo:  batch.Header.SequencerOrderNo,
 		Creator:      batch.Header.Coinbase,
 		BaseFee:      batch.Header.BaseFee,
+		TracerFn:     tracerFn,
 	}, false) // this execution is not used when first producing a batch, we never want to fail for empty batches
 	if err != nil {
 		return nil, fmt.Errorf("failed computing batch %s. Cause: %w", batch.Hash(), err)
@@ -279,13 +308,11 @@ func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts,
 		return nil, fmt.Errorf("batch is in invalid state. Incoming hash: %s  Computed hash: %s", batch.Hash(), cb.Batch.Hash())
 	}
 
-	if _, err := cb.Commit(true); err != nil {
-		return nil, fmt.Errorf("cannot commit stateDB for incoming valid batch %s. Cause: %w", batch.Hash(), err)
-	}
-
-	return cb.Receipts, nil
+	return cb, nil
 }
 
+// ValueTransfers - the value transfers of a batch, whose hash was the TransfersTree of the batches produced before
+// the cross chain tree was enabled
 type ValueTransfers []common.ValueTransferEvent
 
 func (vt ValueTransfers) Len() int {
@@ -355,10 +382,19 @@ func (executor *batchExecutor) populateOutboundCrossChainData(batch *core.Batch,
 		return fmt.Errorf("could not extract cross chain value transfers. Cause: %w", err)
 	}
 
-	transfersHash := types.DeriveSha(ValueTransfers(valueTransferMessages), &trie.StackTrie{})
-
 	batch.Header.CrossChainMessages = crossChainMessages
-	batch.Header.TransfersTree = transfersHash
+	if batch.SeqNo().Uint64() < executor.crossChainTreeSeqNo {
+		// the batches before the cross chain tree was enabled keep their hash of the value transfers, so they are
+		// recomputed with the hash they were produced with
+		batch.Header.TransfersTree = types.DeriveSha(ValueTransfers(valueTransferMessages), &trie.StackTrie{})
+	} else {
+		// the tree commits to the messages and the value transfers, so they can be relayed on the L1 with an inclusion proof
+		tree, err := merkle.CrossChainTree(crossChainMessages, merkle.ValueTransferMessages(valueTransferMessages, batch.SeqNo().Uint64()))
+		if err != nil {
+			return fmt.Errorf("could not compute the cross chain tree. Cause: %w", err)
+		}
+		batch.Header.TransfersTree = tree.Root()
+	}
 
 	executor.logger.Trace(fmt.Sprintf("Added %d cross chain messages to batch.",
 		len(batch.Header.CrossChainMessages)), log.CmpKey, log.CrossChainCmp)
@@ -404,6 +440,7 @@ func (executor *batchExecutor) processTransactions(
 	stateDB *state.StateDB,
 	cc *params.ChainConfig,
 	noBaseFee bool,
+	tracerFn evm.TracerFn,
 ) ([]*common.L2Tx, []*common.L2Tx, []*types.Receipt, error) {
 	var executedTransactions []*common.L2Tx
 	var excludedTransactions []*common.L2Tx
@@ -418,6 +455,7 @@ func (executor *batchExecutor) processTransactions(
 		tCount,
 		noBaseFee,
 		executor.batchGasLimit,
+		tracerFn,
 		executor.logger,
 	)
 	for _, tx := range txs {
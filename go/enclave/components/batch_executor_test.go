@@ -0,0 +1,238 @@
+package components_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	enclavecrypto "github.com/ten-protocol/go-ten/go/enclave/crypto"
+
+	// register the native tracers
+	_ "github.com/ten-protocol/go-ten/go/common/tracers/native"
+)
+
+const testChainID = 443
+
+func TestTraceBatchRecomputesTheBatchWithItsDeposits(t *testing.T) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	db := storage.NewStorage(backingDB, nil, logger)
+	require.NoError(t, db.StoreSecret(enclavecrypto.SharedEnclaveSecret{}))
+
+	chainConfig := ethchainadapter.ChainParams(big.NewInt(testChainID))
+	encoding := gethencoding.NewGethEncodingService(db, logger)
+	executor := components.NewBatchExecutor(db, encoding, crosschain.New(nil, db, big.NewInt(testChainID), logger), &genesis.TestnetGenesis, gas.NewGasOracle(db, logger), chainConfig, params.MaxGasLimit, 0, logger)
+
+	genesisBlock := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	depositBlock := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), ParentHash: genesisBlock.Hash()})
+	require.NoError(t, db.StoreBlock(genesisBlock, nil))
+	require.NoError(t, db.StoreBlock(depositBlock, nil))
+
+	// the account only has funds thanks to a deposit made in the second L1 block
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	depositor := crypto.PubkeyToAddress(key.PublicKey)
+	deposit := common.ValueTransferEvent{Sender: depositor, Receiver: depositor, Amount: big.NewInt(params.Ether)}
+	require.NoError(t, db.StoreValueTransfers(depositBlock.Hash(), common.ValueTransferEvents{deposit}))
+
+	// the genesis batch, followed by the batch deploying the message bus, as produced by the sequencer
+	genesisBatch, msgBusTx, err := executor.CreateGenesisState(genesisBlock.Hash(), 1, gethcommon.Address{}, big.NewInt(1))
+	require.NoError(t, err)
+	storeBatch(t, db, encoding, genesisBatch, nil)
+	msgBusBatch := computeBatch(t, db, encoding, executor, &components.BatchExecutionContext{
+		BlockPtr:     genesisBlock.Hash(),
+		ParentPtr:    genesisBatch.Hash(),
+		Transactions: common.L2Transactions{msgBusTx},
+		AtTime:       2,
+		ChainConfig:  chainConfig,
+		SequencerNo:  big.NewInt(2),
+		BaseFee:      big.NewInt(1),
+	})
+
+	to := gethcommon.HexToAddress("0x42")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainConfig.ChainID), &types.LegacyTx{
+		To:       &to,
+		Value:    big.NewInt(params.Ether / 2),
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	batch := computeBatch(t, db, encoding, executor, &components.BatchExecutionContext{
+		BlockPtr:     depositBlock.Hash(),
+		ParentPtr:    msgBusBatch.Hash(),
+		Transactions: common.L2Transactions{tx},
+		AtTime:       3,
+		ChainConfig:  chainConfig,
+		SequencerNo:  big.NewInt(3),
+		BaseFee:      big.NewInt(1),
+	})
+	require.Len(t, batch.Transactions, 1)
+
+	// the trace sees the deposit, so the transaction succeeds exactly like it did when the batch was produced
+	tracer, err := tracers.New("callTracer", &tracers.Context{}, nil)
+	require.NoError(t, err)
+	var traced []gethcommon.Hash
+	receipts, err := executor.TraceBatch(batch, func(tx *common.L2Tx, _ int) vm.EVMLogger {
+		traced = append(traced, tx.Hash())
+		return tracer
+	})
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipts[0].Status)
+	require.Equal(t, []gethcommon.Hash{tx.Hash()}, traced)
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	var frame struct {
+		From  gethcommon.Address
+		To    gethcommon.Address
+		Value string
+	}
+	require.NoError(t, json.Unmarshal(result, &frame))
+	require.Equal(t, depositor, frame.From)
+	require.Equal(t, to, frame.To)
+	require.Equal(t, "0x6f05b59d3b20000", frame.Value)
+
+	// a batch that does not match the result of its execution can't be traced
+	tampered := &core.Batch{Header: batch.Header, Transactions: batch.Transactions}
+	tamperedHeader := *batch.Header
+	tamperedHeader.Root = gethcommon.HexToHash("0x1")
+	tampered.Header = &tamperedHeader
+	_, err = executor.TraceBatch(tampered, nil)
+	require.ErrorContains(t, err, "batch is in invalid state")
+}
+
+func TestBatchesBeforeTheCrossChainTreeAreReplayedWithTheHashOfTheirValueTransfers(t *testing.T) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	chainConfig := ethchainadapter.ChainParams(big.NewInt(testChainID))
+	db := storage.NewStorage(backingDB, chainConfig, logger)
+	require.NoError(t, db.StoreSecret(enclavecrypto.SharedEnclaveSecret{}))
+
+	// the cross chain tree is enabled from the fourth batch, like on a network upgraded after producing three batches
+	const crossChainTreeSeqNo = 4
+	encoding := gethencoding.NewGethEncodingService(db, logger)
+	crossChainProcessors := crosschain.New(nil, db, big.NewInt(testChainID), logger)
+	newExecutor := func(crossChainTreeSeqNo uint64) components.BatchExecutor {
+		return components.NewBatchExecutor(db, encoding, crossChainProcessors, &genesis.TestnetGenesis, gas.NewGasOracle(db, logger), chainConfig, params.MaxGasLimit, crossChainTreeSeqNo, logger)
+	}
+	executor := newExecutor(crossChainTreeSeqNo)
+
+	genesisBlock := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	depositBlock := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), ParentHash: genesisBlock.Hash()})
+	require.NoError(t, db.StoreBlock(genesisBlock, nil))
+	require.NoError(t, db.StoreBlock(depositBlock, nil))
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	deposit := common.ValueTransferEvent{Sender: sender, Receiver: sender, Amount: big.NewInt(params.Ether)}
+	require.NoError(t, db.StoreValueTransfers(depositBlock.Hash(), common.ValueTransferEvents{deposit}))
+
+	genesisBatch, msgBusTx, err := executor.CreateGenesisState(genesisBlock.Hash(), 1, gethcommon.Address{}, big.NewInt(1))
+	require.NoError(t, err)
+	storeBatch(t, db, encoding, genesisBatch, nil)
+	parent := computeBatch(t, db, encoding, executor, &components.BatchExecutionContext{
+		BlockPtr:     genesisBlock.Hash(),
+		ParentPtr:    genesisBatch.Hash(),
+		Transactions: common.L2Transactions{msgBusTx},
+		AtTime:       2,
+		ChainConfig:  chainConfig,
+		SequencerNo:  big.NewInt(2),
+		BaseFee:      big.NewInt(1),
+	})
+
+	// a batch before the cross chain tree and a batch after it, each with a value transfer to the L1
+	messageBusABI, err := MessageBus.MessageBusMetaData.GetAbi()
+	require.NoError(t, err)
+	var batches []*core.Batch
+	for nonce, seqNo := range []int64{crossChainTreeSeqNo - 1, crossChainTreeSeqNo} {
+		amount := big.NewInt(1000 + seqNo)
+		data, err := messageBusABI.Pack("sendValueToL2", sender, amount)
+		require.NoError(t, err)
+		tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainConfig.ChainID), &types.LegacyTx{
+			Nonce:    uint64(nonce),
+			To:       crossChainProcessors.Local.GetBusAddress(),
+			Value:    amount,
+			Gas:      100_000,
+			GasPrice: big.NewInt(1),
+			Data:     data,
+		})
+		require.NoError(t, err)
+		parent = computeBatch(t, db, encoding, executor, &components.BatchExecutionContext{
+			BlockPtr:     depositBlock.Hash(),
+			ParentPtr:    parent.Hash(),
+			Transactions: common.L2Transactions{tx},
+			AtTime:       uint64(seqNo),
+			ChainConfig:  chainConfig,
+			SequencerNo:  big.NewInt(seqNo),
+			BaseFee:      big.NewInt(1),
+		})
+		batches = append(batches, parent)
+	}
+
+	transfersOf := func(batch *core.Batch) common.ValueTransferEvents {
+		receipts, err := db.GetReceiptsByBatchHash(batch.Hash())
+		require.NoError(t, err)
+		transfers, err := crossChainProcessors.Local.ExtractOutboundTransfers(receipts)
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		return transfers
+	}
+	legacyBatch, treeBatch := batches[0], batches[1]
+	require.Equal(t, types.DeriveSha(components.ValueTransfers(transfersOf(legacyBatch)), &trie.StackTrie{}), legacyBatch.Header.TransfersTree)
+	tree, err := merkle.CrossChainTree(nil, merkle.ValueTransferMessages(transfersOf(treeBatch), crossChainTreeSeqNo))
+	require.NoError(t, err)
+	require.Equal(t, tree.Root(), treeBatch.Header.TransfersTree)
+
+	// both batches are replayed to the same hash by a node configured the same way
+	replayer := newExecutor(crossChainTreeSeqNo)
+	for _, batch := range batches {
+		_, err = replayer.ExecuteBatch(batch)
+		require.NoError(t, err)
+	}
+
+	// but the batch produced before the cross chain tree does not match it
+	_, err = newExecutor(0).ExecuteBatch(legacyBatch)
+	require.ErrorContains(t, err, "batch is in invalid state")
+}
+
+func computeBatch(t *testing.T, db storage.Storage, encoding gethencoding.EncodingService, executor components.BatchExecutor, context *components.BatchExecutionContext) *core.Batch {
+	cb, err := executor.ComputeBatch(context, false)
+	require.NoError(t, err)
+	_, err = cb.Commit(true)
+	require.NoError(t, err)
+	storeBatch(t, db, encoding, cb.Batch, cb.Receipts)
+	return cb.Batch
+}
+
+func storeBatch(t *testing.T, db storage.Storage, encoding gethencoding.EncodingService, batch *core.Batch, receipts types.Receipts) {
+	convertedHeader, err := encoding.CreateEthHeaderForBatch(batch.Header)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreBatch(batch, convertedHeader.Hash()))
+	require.NoError(t, db.StoreExecutedBatch(batch, receipts))
+}
@@ -77,8 +77,10 @@ func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestio
 		}
 	}
 
-	// todo @siliev - not sure if this is the best way to update the price, will pick up random stale blocks from forks?
-	bp.gasOracle.ProcessL1Block(br.Block)
+	// the oracle state is kept per block, so blocks from forks don't affect the price on the canonical chain
+	if err = bp.gasOracle.ProcessL1Block(br.Block); err != nil {
+		return nil, fmt.Errorf("failed to update the gas oracle. Cause: %w", err)
+	}
 
 	h := br.Block.Hash()
 	bp.currentL1Head = &h
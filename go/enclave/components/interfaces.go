@@ -11,6 +11,7 @@ import (
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
 	"github.com/ten-protocol/go-ten/go/enclave/limiters"
 )
 
@@ -50,6 +51,7 @@ type BatchExecutionContext struct {
 	ChainConfig  *params.ChainConfig
 	SequencerNo  *big.Int
 	BaseFee      *big.Int
+	TracerFn     evm.TracerFn // optional - used to trace the execution of the transactions
 }
 
 // ComputedBatch - a structure representing the result of a batch
@@ -74,6 +76,10 @@ type BatchExecutor interface {
 	// ExecuteBatch - executes the transactions and xchain messages, returns the receipts, and updates the stateDB
 	ExecuteBatch(*core.Batch) (types.Receipts, error)
 
+	// TraceBatch - same as ExecuteBatch, but the transactions are executed with the tracers returned by tracerFn,
+	// and the resulting stateDB is not committed
+	TraceBatch(*core.Batch, evm.TracerFn) (types.Receipts, error)
+
 	// CreateGenesisState - will create and commit the genesis state in the stateDB for the given block hash,
 	// and uint64 timestamp representing the time now. In this genesis state is where one can
 	// find preallocated funds for faucet. TODO - make this an option
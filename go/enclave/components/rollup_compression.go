@@ -23,6 +23,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 )
 
@@ -51,6 +52,7 @@ type RollupCompression struct {
 	dataCompressionService compression.DataCompressionService
 	batchRegistry          BatchRegistry
 	batchExecutor          BatchExecutor
+	gasOracle              gas.Oracle
 	gethEncodingService    gethencoding.EncodingService
 	storage                storage.Storage
 	chainConfig            *params.ChainConfig
@@ -60,6 +62,7 @@ type RollupCompression struct {
 func NewRollupCompression(
 	batchRegistry BatchRegistry,
 	batchExecutor BatchExecutor,
+	gasOracle gas.Oracle,
 	dataEncryptionService crypto.DataEncryptionService,
 	dataCompressionService compression.DataCompressionService,
 	storage storage.Storage,
@@ -70,6 +73,7 @@ func NewRollupCompression(
 	return &RollupCompression{
 		batchRegistry:          batchRegistry,
 		batchExecutor:          batchExecutor,
+		gasOracle:              gasOracle,
 		dataEncryptionService:  dataEncryptionService,
 		dataCompressionService: dataCompressionService,
 		storage:                storage,
@@ -122,15 +126,17 @@ func (rc *RollupCompression) CreateExtRollup(r *core.Rollup) (*common.ExtRollup,
 }
 
 // ProcessExtRollup - given an External rollup, responsible with checking and saving all batches found inside
-func (rc *RollupCompression) ProcessExtRollup(rollup *common.ExtRollup) (*common.CalldataRollupHeader, error) {
+// block - the L1 block in which the rollup was published
+// withBlobs - whether the payloads of the rollup were published with blobs
+func (rc *RollupCompression) ProcessExtRollup(rollup *common.ExtRollup, block *types.Block, withBlobs bool) (*common.CalldataRollupHeader, error) {
 	transactionsPerBatch := make([][]*common.L2Tx, 0)
-	err := rc.decryptDecompressAndDeserialise(rollup.BatchPayloads, &transactionsPerBatch)
+	payloadsSize, err := rc.decryptDecompressAndDeserialise(rollup.BatchPayloads, &transactionsPerBatch)
 	if err != nil {
 		return nil, err
 	}
 
 	calldataRollupHeader := new(common.CalldataRollupHeader)
-	err = rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader)
+	headerSize, err := rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +155,13 @@ func (rc *RollupCompression) ProcessExtRollup(rollup *common.ExtRollup) (*common
 		return nil, err
 	}
 
+	// the gas oracle uses the measured ratio to price the l1 publishing cost of future transactions
+	publishedSize := uint64(len(rollup.BatchPayloads) + len(rollup.CalldataRollupHeader))
+	err = rc.gasOracle.ProcessRollupCompression(block, publishedSize, uint64(payloadsSize+headerSize), withBlobs)
+	if err != nil {
+		return nil, err
+	}
+
 	return calldataRollupHeader, nil
 }
 
@@ -536,20 +549,21 @@ func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any) ([]byte, error
 	return encrypted, nil
 }
 
-func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any) error {
+// decryptDecompressAndDeserialise - returns the size of the serialised object
+func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any) (int, error) {
 	plaintextBlob, err := rc.dataEncryptionService.Decrypt(blob)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	serialisedBlob, err := rc.dataCompressionService.Decompress(plaintextBlob)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	err = rlp.DecodeBytes(serialisedBlob, obj)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return len(serialisedBlob), nil
 }
 
 func (rc *RollupCompression) computeBatch(
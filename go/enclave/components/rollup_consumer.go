@@ -9,6 +9,8 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/measure"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -49,12 +51,15 @@ func NewRollupConsumer(
 func (rc *rollupConsumerImpl) ProcessRollupsInBlock(b *common.BlockAndReceipts) error {
 	defer core.LogMethodDuration(rc.logger, measure.NewStopwatch(), "Rollup consumer processed block", log.BlockHashKey, b.Block.Hash())
 
-	rollups := rc.extractRollups(b)
+	rollups, withBlobs, err := rc.extractRollups(b)
+	if err != nil {
+		return err
+	}
 	if len(rollups) == 0 {
 		return nil
 	}
 
-	rollups, err := rc.getSignedRollup(rollups)
+	rollups, err = rc.getSignedRollup(rollups)
 	if err != nil {
 		return err
 	}
@@ -74,13 +79,13 @@ func (rc *rollupConsumerImpl) ProcessRollupsInBlock(b *common.BlockAndReceipts)
 			continue
 		}
 		// read batch data from rollup, verify and store it
-		internalHeader, err := rc.rollupCompression.ProcessExtRollup(rollup)
+		internalHeader, err := rc.rollupCompression.ProcessExtRollup(rollup, b.Block, withBlobs[rollup.Hash()])
 		if err != nil {
 			rc.logger.Error("Failed processing rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
 			// todo - issue challenge as a validator
 			return err
 		}
-		if err := rc.storage.StoreRollup(rollup, internalHeader); err != nil {
+		if err := rc.storage.StoreRollup(rollup, internalHeader, b.Block.Hash()); err != nil {
 			rc.logger.Error("Failed storing rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
 			return err
 		}
@@ -104,10 +109,14 @@ func (rc *rollupConsumerImpl) getSignedRollup(rollups []*common.ExtRollup) ([]*c
 }
 
 // todo - when processing the rollup, instead of looking up batches one by one, compare the last sequence number from the db with the ones in the rollup
-// extractRollups - returns a list of the rollups published in this block
-func (rc *rollupConsumerImpl) extractRollups(br *common.BlockAndReceipts) []*common.ExtRollup {
+// extractRollups - returns a list of the rollups published in this block, and the hashes of the ones published with blobs.
+// The payloads of the rollups published with blobs are recovered from the blobs submitted by the host with the block,
+// after checking them against the versioned hashes of the transaction.
+func (rc *rollupConsumerImpl) extractRollups(br *common.BlockAndReceipts) ([]*common.ExtRollup, map[common.L2RollupHash]bool, error) {
 	rollups := make([]*common.ExtRollup, 0)
+	withBlobs := make(map[common.L2RollupHash]bool)
 	b := br.Block
+	var blobsByHash map[gethcommon.Hash]*kzg4844.Blob
 
 	for _, tx := range *br.SuccessfulTransactions() {
 		// go through all rollup transactions
@@ -124,11 +133,32 @@ func (rc *rollupConsumerImpl) extractRollups(br *common.BlockAndReceipts) []*com
 		r, err := common.DecodeRollup(rolTx.Rollup)
 		if err != nil {
 			rc.logger.Crit("could not decode rollup.", log.ErrKey, err)
-			return nil
+			return nil, nil, err
+		}
+
+		if len(rolTx.BlobHashes) > 0 {
+			if blobsByHash == nil {
+				blobsByHash, err = ethadapter.IndexBlobs(br.Blobs)
+				if err != nil {
+					return nil, nil, fmt.Errorf("could not index the blobs of block %s. Cause: %w", b.Hash(), err)
+				}
+			}
+			blobs := make([]*kzg4844.Blob, len(rolTx.BlobHashes))
+			for i, blobHash := range rolTx.BlobHashes {
+				blob, found := blobsByHash[blobHash]
+				if !found {
+					return nil, nil, fmt.Errorf("blob %s of rollup %s was not submitted with block %s", blobHash, r.Hash(), b.Hash())
+				}
+				blobs[i] = blob
+			}
+			if err := ethadapter.ReconstructRollupFromBlobs(r, blobs); err != nil {
+				return nil, nil, fmt.Errorf("could not read the blobs of rollup %s. Cause: %w", r.Hash(), err)
+			}
+			withBlobs[r.Hash()] = true
 		}
 
 		rollups = append(rollups, r)
 		rc.logger.Info("Extracted rollup from block", log.RollupHashKey, r.Hash(), log.BlockHashKey, b.Hash())
 	}
-	return rollups
+	return rollups, withBlobs, nil
 }
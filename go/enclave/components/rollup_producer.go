@@ -20,18 +20,20 @@ import (
 )
 
 type rollupProducerImpl struct {
-	sequencerID   gethcommon.Address
-	storage       storage.Storage
-	batchRegistry BatchRegistry
-	logger        gethlog.Logger
+	sequencerID         gethcommon.Address
+	storage             storage.Storage
+	batchRegistry       BatchRegistry
+	crossChainTreeSeqNo uint64
+	logger              gethlog.Logger
 }
 
-func NewRollupProducer(sequencerID gethcommon.Address, storage storage.Storage, batchRegistry BatchRegistry, logger gethlog.Logger) RollupProducer {
+func NewRollupProducer(sequencerID gethcommon.Address, storage storage.Storage, batchRegistry BatchRegistry, crossChainTreeSeqNo uint64, logger gethlog.Logger) RollupProducer {
 	return &rollupProducerImpl{
-		sequencerID:   sequencerID,
-		logger:        logger,
-		batchRegistry: batchRegistry,
-		storage:       storage,
+		sequencerID:         sequencerID,
+		logger:              logger,
+		batchRegistry:       batchRegistry,
+		storage:             storage,
+		crossChainTreeSeqNo: crossChainTreeSeqNo,
 	}
 }
 
@@ -57,8 +59,13 @@ func (re *rollupProducerImpl) CreateInternalRollup(fromBatchNo uint64, upToL1Hei
 	rh.Coinbase = re.sequencerID
 
 	rh.CrossChainMessages = make([]MessageBus.StructsCrossChainMessage, 0)
+	rh.CrossChainRoots = make([]gethcommon.Hash, 0)
 	for _, b := range batches {
 		rh.CrossChainMessages = append(rh.CrossChainMessages, b.Header.CrossChainMessages...)
+		// the TransfersTree of the batches before the cross chain tree was enabled can't be withdrawn against
+		if b.Header.TransfersTree != types.EmptyRootHash && b.SeqNo().Uint64() >= re.crossChainTreeSeqNo {
+			rh.CrossChainRoots = append(rh.CrossChainRoots, b.Header.TransfersTree)
+		}
 	}
 
 	lastBatch := batches[len(batches)-1]
@@ -4,43 +4,92 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
+// the minimum time between two refreshes of the attested keys, so badly signed batches can't make the enclave fetch them
+// on every check
+const keyRefreshInterval = time.Second
+
+// AttestedKeyFetcher returns the attested keys of the enclaves registered under the given ID
+type AttestedKeyFetcher func(id gethcommon.Address) ([]*ecdsa.PublicKey, error)
+
+// SignatureValidator checks that batches and rollups were signed by the sequencer. A sequencer host can have several
+// enclaves, each attested under the sequencer ID, and any of them can be the one producing after a failover.
 type SignatureValidator struct {
-	SequencerID gethcommon.Address
-	attestedKey *ecdsa.PublicKey
-	storage     storage.Storage
+	SequencerID  gethcommon.Address
+	attestedKeys []*ecdsa.PublicKey
+	lastRefresh  time.Time
+	fetchKeys    AttestedKeyFetcher
+	keyLock      sync.Mutex
 }
 
 func NewSignatureValidator(seqID gethcommon.Address, storage storage.Storage) (*SignatureValidator, error) {
 	// todo (#718) - sequencer identities should be retrieved from the L1 management contract
+	return NewSignatureValidatorWithKeyFetcher(seqID, storage.FetchAttestedKeys), nil
+}
+
+// NewSignatureValidatorWithKeyFetcher - creates a validator that retrieves the attested keys of the sequencer with the
+// given function, for components that don't have access to the enclave storage (e.g. the host)
+func NewSignatureValidatorWithKeyFetcher(seqID gethcommon.Address, fetchKeys AttestedKeyFetcher) *SignatureValidator {
 	return &SignatureValidator{
-		SequencerID: seqID,
-		storage:     storage,
-		attestedKey: nil,
-	}, nil
+		SequencerID:  seqID,
+		fetchKeys:    fetchKeys,
+		attestedKeys: nil,
+	}
 }
 
-// CheckSequencerSignature - verifies the signature against the registered sequencer
+// CheckSequencerSignature - verifies the signature against the attested enclaves of the sequencer
 func (sigChecker *SignatureValidator) CheckSequencerSignature(headerHash gethcommon.Hash, sigR *big.Int, sigS *big.Int) error {
 	if sigR == nil || sigS == nil {
 		return fmt.Errorf("missing signature on batch")
 	}
 
-	if sigChecker.attestedKey == nil {
-		attestedKey, err := sigChecker.storage.FetchAttestedKey(sigChecker.SequencerID)
-		if err != nil {
-			return fmt.Errorf("could not retrieve attested key for aggregator %s. Cause: %w", sigChecker.SequencerID, err)
-		}
-		sigChecker.attestedKey = attestedKey
+	attestedKeys, err := sigChecker.sequencerKeys(false)
+	if err != nil {
+		return err
+	}
+	if verifyWithAny(attestedKeys, headerHash, sigR, sigS) {
+		return nil
 	}
 
-	if !ecdsa.Verify(sigChecker.attestedKey, headerHash.Bytes(), sigR, sigS) {
+	// the signing enclave may have been attested since the keys were cached, but they are refreshed at most once per
+	// interval
+	attestedKeys, err = sigChecker.sequencerKeys(true)
+	if err != nil {
+		return err
+	}
+	if !verifyWithAny(attestedKeys, headerHash, sigR, sigS) {
 		return fmt.Errorf("could not verify ECDSA signature")
 	}
 	return nil
 }
+
+func (sigChecker *SignatureValidator) sequencerKeys(refresh bool) ([]*ecdsa.PublicKey, error) {
+	sigChecker.keyLock.Lock()
+	defer sigChecker.keyLock.Unlock()
+
+	if sigChecker.attestedKeys == nil || (refresh && time.Since(sigChecker.lastRefresh) >= keyRefreshInterval) {
+		attestedKeys, err := sigChecker.fetchKeys(sigChecker.SequencerID)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve attested keys for aggregator %s. Cause: %w", sigChecker.SequencerID, err)
+		}
+		sigChecker.attestedKeys = attestedKeys
+		sigChecker.lastRefresh = time.Now()
+	}
+	return sigChecker.attestedKeys, nil
+}
+
+func verifyWithAny(keys []*ecdsa.PublicKey, hash gethcommon.Hash, sigR *big.Int, sigS *big.Int) bool {
+	for _, key := range keys {
+		if ecdsa.Verify(key, hash.Bytes(), sigR, sigS) {
+			return true
+		}
+	}
+	return false
+}
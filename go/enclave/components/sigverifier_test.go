@@ -0,0 +1,61 @@
+package components
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSignaturesOfEveryAttestedSequencerEnclaveAreAccepted(t *testing.T) {
+	activeKey, _ := gethcrypto.GenerateKey()
+	standbyKey, _ := gethcrypto.GenerateKey()
+	unknownKey, _ := gethcrypto.GenerateKey()
+
+	attestedKeys := []*ecdsa.PublicKey{&activeKey.PublicKey}
+	fetches := 0
+	sigValidator := NewSignatureValidatorWithKeyFetcher(gethcommon.Address{}, func(gethcommon.Address) ([]*ecdsa.PublicKey, error) {
+		fetches++
+		return attestedKeys, nil
+	})
+
+	hash := gethcommon.HexToHash("0x1234")
+	checkSignedBy := func(key *ecdsa.PrivateKey) error {
+		r, s, err := ecdsa.Sign(rand.Reader, key, hash.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sigValidator.CheckSequencerSignature(hash, r, s)
+	}
+
+	if err := checkSignedBy(activeKey); err != nil {
+		t.Errorf("expected the signature of the active enclave to be accepted, got %s", err)
+	}
+	if err := checkSignedBy(activeKey); err != nil || fetches != 1 {
+		t.Errorf("expected the attested keys to be cached, got %d fetches and error %v", fetches, err)
+	}
+
+	// the standby enclave is attested after the keys were cached, e.g. once it took over, and its signatures are
+	// accepted once the keys can be refreshed
+	attestedKeys = append(attestedKeys, &standbyKey.PublicKey)
+	if err := checkSignedBy(standbyKey); err == nil || fetches != 1 {
+		t.Errorf("expected the keys not to be refreshed within the interval, got %d fetches and error %v", fetches, err)
+	}
+	sigValidator.lastRefresh = time.Now().Add(-keyRefreshInterval)
+	if err := checkSignedBy(standbyKey); err != nil {
+		t.Errorf("expected the signature of the standby enclave to be accepted, got %s", err)
+	}
+
+	// badly signed batches don't make the keys be fetched on every check
+	for i := 0; i < 10; i++ {
+		if err := checkSignedBy(unknownKey); err == nil {
+			t.Error("expected the signature of an enclave that was not attested to be rejected")
+		}
+	}
+	if fetches != 2 {
+		t.Errorf("expected the keys to be refreshed at most once per interval, got %d fetches", fetches)
+	}
+}
@@ -83,7 +83,7 @@ func (m *blockMessageExtractor) StoreCrossChainMessages(block *common.L1Block, r
 	}
 
 	lazilyLogReceiptChecksum(block, receipts, m.logger)
-	messages, err := m.getCrossChainMessages(block, receipts)
+	messages, txHashes, err := m.getCrossChainMessages(block, receipts)
 	if err != nil {
 		m.logger.Error("Converting receipts to messages failed.", log.ErrKey, err)
 		return err
@@ -91,7 +91,7 @@ func (m *blockMessageExtractor) StoreCrossChainMessages(block *common.L1Block, r
 
 	if len(messages) > 0 {
 		m.logger.Info(fmt.Sprintf("Storing %d messages for block", len(messages)), log.BlockHashKey, block.Hash())
-		err = m.storage.StoreL1Messages(block.Hash(), messages)
+		err = m.storage.StoreL1Messages(block.Hash(), messages, txHashes)
 		if err != nil {
 			m.logger.Crit("Unable to store the messages", log.ErrKey, err)
 			return err
@@ -106,29 +106,36 @@ func (m *blockMessageExtractor) GetBusAddress() *common.L1Address {
 	return m.busAddress
 }
 
-// getCrossChainMessages - Converts the relevant logs from the appropriate message bus address to synthetic transactions and returns them
-func (m *blockMessageExtractor) getCrossChainMessages(block *common.L1Block, receipts common.L1Receipts) (common.CrossChainMessages, error) {
+// getCrossChainMessages - Converts the relevant logs from the appropriate message bus address to synthetic transactions and returns them,
+// together with the hashes of the transactions that published them
+func (m *blockMessageExtractor) getCrossChainMessages(block *common.L1Block, receipts common.L1Receipts) (common.CrossChainMessages, []gethcommon.Hash, error) {
 	if len(receipts) == 0 {
-		return make(common.CrossChainMessages, 0), nil
+		return make(common.CrossChainMessages, 0), nil, nil
 	}
 
 	// Retrieves the relevant logs from the message bus.
 	logs, err := filterLogsFromReceipts(receipts, m.GetBusAddress(), &CrossChainEventID)
 	if err != nil {
 		m.logger.Error("Error encountered when filtering receipt logs.", log.ErrKey, err)
-		return make(common.CrossChainMessages, 0), err
+		return make(common.CrossChainMessages, 0), nil, err
 	}
 	m.logger.Trace("Extracted cross chain logs from receipts", "logCount", len(logs))
 
 	messages, err := convertLogsToMessages(logs, CrossChainEventName, MessageBusABI)
 	if err != nil {
 		m.logger.Error("Error encountered converting the extracted relevant logs to messages", log.ErrKey, err)
-		return make(common.CrossChainMessages, 0), err
+		return make(common.CrossChainMessages, 0), nil, err
+	}
+
+	// the messages are in the order of the logs they were converted from
+	txHashes := make([]gethcommon.Hash, len(logs))
+	for i, l := range logs {
+		txHashes[i] = l.TxHash
 	}
 
 	m.logger.Trace(fmt.Sprintf("Found %d cross chain messages that will be submitted to L2!", len(messages)), log.BlockHashKey, block.Hash())
 
-	return messages, nil
+	return messages, txHashes, nil
 }
 
 func (m *blockMessageExtractor) getValueTransferMessages(receipts common.L1Receipts) (common.ValueTransferEvents, error) {
@@ -0,0 +1,178 @@
+package crosschain
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// MessageStatusReader - works out which stages of their lifecycle the indexed cross chain messages went through.
+// L1 messages are published in a block, then relayed to the L2 by the synthetic transactions of a batch.
+// L2 messages are published in a batch, then rolled up to the L1, where the rollup eventually becomes final.
+type MessageStatusReader struct {
+	local   Manager
+	storage storage.Storage
+	logger  gethlog.Logger
+}
+
+func NewMessageStatusReader(local Manager, storage storage.Storage, logger gethlog.Logger) *MessageStatusReader {
+	return &MessageStatusReader{
+		local:   local,
+		storage: storage,
+		logger:  logger.New(log.CmpKey, log.CrossChainCmp),
+	}
+}
+
+// GetStatus - returns the status of the messages matching the query. A transaction hash matches both the messages published
+// by an L1 transaction and the ones published by an L2 transaction.
+func (r *MessageStatusReader) GetStatus(query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error) {
+	statuses, err := r.storage.FetchCrossChainMessages(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch cross chain messages. Cause: %w", err)
+	}
+	if query.TxHash != nil {
+		outbound, err := r.outboundMessagesOfTx(*query.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, outbound...)
+	}
+
+	head, err := r.storage.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the head block. Cause: %w", err)
+	}
+	for _, status := range statuses {
+		if status.MessageHash, err = merkle.MessageLeaf(status.Message); err != nil {
+			return nil, err
+		}
+		if status.Direction == common.CrossChainL1ToL2 {
+			err = r.addInboundStages(status)
+		} else {
+			err = r.addOutboundStages(status, head.NumberU64())
+		}
+		if err != nil {
+			return nil, err
+		}
+		status.Stage = status.Stages[len(status.Stages)-1].Stage
+	}
+	return statuses, nil
+}
+
+// outboundMessagesOfTx - returns the indexed messages published by the L2 transaction, if there is one with the hash
+func (r *MessageStatusReader) outboundMessagesOfTx(txHash gethcommon.Hash) ([]*common.CrossChainMessageStatus, error) {
+	receipt, err := r.storage.GetTransactionReceipt(txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not fetch the receipt of tx %s. Cause: %w", txHash, err)
+	}
+	messages, err := r.local.ExtractOutboundMessages(types.Receipts{receipt})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*common.CrossChainMessageStatus, 0, len(messages))
+	for _, msg := range messages {
+		sender, sequence := msg.Sender, msg.Sequence
+		found, err := r.storage.FetchCrossChainMessages(&common.CrossChainMessageQuery{Sender: &sender, Sequence: &sequence})
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch cross chain messages. Cause: %w", err)
+		}
+		for _, status := range found {
+			if status.Direction != common.CrossChainL2ToL1 {
+				continue
+			}
+			status.Stages[0].L2TxHash = &txHash
+			result = append(result, status)
+		}
+	}
+	return result, nil
+}
+
+func (r *MessageStatusReader) addInboundStages(status *common.CrossChainMessageStatus) error {
+	batch, err := r.storage.FetchRelayingBatch(*status.Stages[0].L1BlockNumber)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch the batch relaying the message. Cause: %w", err)
+	}
+	batchHash := batch.Hash()
+	seqNo := batch.SequencerOrderNo.Uint64()
+	status.Stages = append(status.Stages, &common.CrossChainMessageStage{
+		Stage:      common.CrossChainRelayed,
+		BatchHash:  &batchHash,
+		BatchSeqNo: &seqNo,
+	})
+	return nil
+}
+
+func (r *MessageStatusReader) addOutboundStages(status *common.CrossChainMessageStatus, headHeight uint64) error {
+	published := status.Stages[0]
+	if published.L2TxHash == nil {
+		txHash, err := r.publishingTx(status.Message, *published.BatchHash)
+		if err != nil {
+			return err
+		}
+		published.L2TxHash = txHash
+	}
+
+	rollup, block, err := r.storage.FetchRollupForBatch(*published.BatchSeqNo)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch the rollup of the batch. Cause: %w", err)
+	}
+	rollupHash := rollup.Hash()
+	blockHash := block.Hash()
+	blockNumber := block.Number.Uint64()
+	status.Stages = append(status.Stages, &common.CrossChainMessageStage{
+		Stage:         common.CrossChainRolledUp,
+		L1BlockHash:   &blockHash,
+		L1BlockNumber: &blockNumber,
+		RollupHash:    &rollupHash,
+	})
+
+	if blockNumber+common.HeightCommittedBlocks <= headHeight {
+		status.Stages = append(status.Stages, &common.CrossChainMessageStage{
+			Stage:         common.CrossChainFinalised,
+			L1BlockHash:   &blockHash,
+			L1BlockNumber: &blockNumber,
+			RollupHash:    &rollupHash,
+		})
+	}
+	return nil
+}
+
+// publishingTx - returns the hash of the transaction of the batch that published the message
+func (r *MessageStatusReader) publishingTx(msg common.CrossChainMessage, batchHash common.L2BatchHash) (*gethcommon.Hash, error) {
+	receipts, err := r.storage.GetReceiptsByBatchHash(batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the receipts of batch %s. Cause: %w", batchHash, err)
+	}
+	for _, receipt := range receipts {
+		messages, err := r.local.ExtractOutboundMessages(types.Receipts{receipt})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range messages {
+			if m.Sender == msg.Sender && m.Sequence == msg.Sequence {
+				txHash := receipt.TxHash
+				return &txHash, nil
+			}
+		}
+	}
+	r.logger.Warn("Could not find the transaction that published the message", log.BatchHashKey, batchHash, "sender", msg.Sender, "sequence", msg.Sequence)
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+func TestMessageStatusFollowsTheLifecycle(t *testing.T) {
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", gethlog.New())
+	require.NoError(t, err)
+	db := storage.NewStorage(backingDB, nil, gethlog.New())
+	reader := NewMessageStatusReader(NewObscuroMessageBusManager(db, big.NewInt(443), gethlog.New()), db, gethlog.New())
+
+	blocks := []*types.Block{types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})}
+	addBlocks := func(count int) {
+		for i := 0; i < count; i++ {
+			parent := blocks[len(blocks)-1]
+			block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0).Add(parent.Number(), big.NewInt(1)), ParentHash: parent.Hash()})
+			require.NoError(t, db.StoreBlock(block, nil))
+			blocks = append(blocks, block)
+		}
+	}
+	require.NoError(t, db.StoreBlock(blocks[0], nil))
+	addBlocks(2)
+
+	// an L1 message is published in the second block
+	inbound := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1"), Sequence: 7, Payload: []byte("in")}
+	l1TxHash := gethcommon.HexToHash("0x11")
+	require.NoError(t, db.StoreL1Messages(blocks[1].Hash(), common.CrossChainMessages{inbound}, []gethcommon.Hash{l1TxHash}))
+
+	statuses, err := reader.GetStatus(&common.CrossChainMessageQuery{TxHash: &l1TxHash})
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, common.CrossChainL1ToL2, statuses[0].Direction)
+	require.Equal(t, common.CrossChainPublished, statuses[0].Stage)
+	require.Equal(t, blocks[1].Hash(), *statuses[0].Stages[0].L1BlockHash)
+
+	// the first batch on top of the third block relays it, and publishes an L2 message
+	outbound := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x2"), Sequence: 0, Payload: []byte("out")}
+	batch := &core.Batch{Header: &common.BatchHeader{
+		Number:             big.NewInt(1),
+		SequencerOrderNo:   big.NewInt(1),
+		L1Proof:            blocks[2].Hash(),
+		CrossChainMessages: common.CrossChainMessages{outbound},
+	}}
+	require.NoError(t, db.StoreBatch(batch, batch.Hash()))
+	require.NoError(t, db.StoreExecutedBatch(batch, nil))
+
+	statuses, err = reader.GetStatus(&common.CrossChainMessageQuery{TxHash: &l1TxHash})
+	require.NoError(t, err)
+	require.Equal(t, common.CrossChainRelayed, statuses[0].Stage)
+	require.Equal(t, batch.Hash(), *statuses[0].Stages[1].BatchHash)
+
+	outboundQuery := &common.CrossChainMessageQuery{Sender: &outbound.Sender, Sequence: &outbound.Sequence}
+	statuses, err = reader.GetStatus(outboundQuery)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, common.CrossChainL2ToL1, statuses[0].Direction)
+	require.Equal(t, common.CrossChainPublished, statuses[0].Stage)
+	require.Equal(t, batch.Hash(), *statuses[0].Stages[0].BatchHash)
+
+	// the batch is rolled up in the fourth block, which becomes final once enough blocks are built on top of it
+	addBlocks(1)
+	rollup := &common.ExtRollup{Header: &common.RollupHeader{CompressionL1Head: blocks[2].Hash(), LastBatchSeqNo: 1}}
+	require.NoError(t, db.StoreRollup(rollup, &common.CalldataRollupHeader{FirstBatchSequence: big.NewInt(1)}, blocks[3].Hash()))
+
+	statuses, err = reader.GetStatus(outboundQuery)
+	require.NoError(t, err)
+	require.Equal(t, common.CrossChainRolledUp, statuses[0].Stage)
+	require.Equal(t, rollup.Hash(), *statuses[0].Stages[1].RollupHash)
+	require.Equal(t, blocks[3].Hash(), *statuses[0].Stages[1].L1BlockHash)
+
+	addBlocks(common.HeightCommittedBlocks)
+	statuses, err = reader.GetStatus(outboundQuery)
+	require.NoError(t, err)
+	require.Equal(t, common.CrossChainFinalised, statuses[0].Stage)
+}
@@ -16,6 +16,7 @@ import (
 type Processors struct {
 	Local  Manager
 	Remote BlockMessageExtractor
+	Status *MessageStatusReader
 }
 
 func New(
@@ -27,6 +28,7 @@ func New(
 	processors := Processors{}
 	processors.Local = NewObscuroMessageBusManager(storage, chainID, logger)
 	processors.Remote = NewBlockMessageExtractor(l1BusAddress, storage, logger)
+	processors.Status = NewMessageStatusReader(processors.Local, storage, logger)
 	return &processors
 }
 
@@ -0,0 +1,84 @@
+package debugger
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// evmCanceller - cancels the EVM executions it is attached to once the trace times out.
+// The EVM of each transaction is only created by the batch executor, so the canceller is attached to it through the
+// tracer, which receives the EVM when the execution starts.
+type evmCanceller struct {
+	noop tracers.Tracer // attached to the transactions that are executed, but not traced
+
+	lock      sync.Mutex
+	env       *vm.EVM
+	cancelled bool
+}
+
+func newEVMCanceller() (*evmCanceller, error) {
+	noop, err := tracers.New("noopTracer", &tracers.Context{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &evmCanceller{noop: noop}, nil
+}
+
+// cancel - cancels the current execution, and all the executions started afterwards
+func (c *evmCanceller) cancel() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cancelled = true
+	if c.env != nil {
+		c.env.Cancel()
+	}
+}
+
+func (c *evmCanceller) timedOut() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.cancelled
+}
+
+func (c *evmCanceller) attach(env *vm.EVM) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.env = env
+	if c.cancelled {
+		env.Cancel()
+	}
+}
+
+// tracerFn - returns the tracers of the transactions, wrapped so the canceller is attached to the execution of every
+// transaction of the batch, including the ones that are not traced.
+func (c *evmCanceller) tracerFn(tracerFor func(tx *common.L2Tx) vm.EVMLogger) evm.TracerFn {
+	return func(tx *common.L2Tx, _ int) vm.EVMLogger {
+		tracer := tracerFor(tx)
+		if tracer == nil {
+			tracer = c.noop
+		}
+		return &cancellableTracer{EVMLogger: tracer, canceller: c}
+	}
+}
+
+// wrap - wraps a single tracer, so the canceller is attached to the execution it traces
+func (c *evmCanceller) wrap(tracer vm.EVMLogger) vm.EVMLogger {
+	return &cancellableTracer{EVMLogger: tracer, canceller: c}
+}
+
+type cancellableTracer struct {
+	vm.EVMLogger
+	canceller *evmCanceller
+}
+
+func (t *cancellableTracer) CaptureStart(env *vm.EVM, from gethcommon.Address, to gethcommon.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.canceller.attach(env)
+	t.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
@@ -4,37 +4,55 @@ package debugger
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	// register the native tracers (callTracer, prestateTracer, 4byteTracer, noopTracer)
+	_ "github.com/ten-protocol/go-ten/go/common/tracers/native"
 )
 
 const (
-// defaultTraceTimeout is the amount of time a single transaction can execute
-// by default before being forcefully aborted.
-// defaultTraceTimeout = 5 * time.Second
-
-// defaultTraceReexec is the number of blocks the tracer is willing to go back
-// and reexecute to produce missing historical state necessary to run a specific
-// trace.
-// defaultTraceReexec = uint64(128)
+	// defaultTraceTimeout is the amount of time a single transaction can execute
+	// by default before being forcefully aborted.
+	defaultTraceTimeout = 5 * time.Second
+
+	// defaultTraceReexec is the number of batches the tracer is willing to go back
+	// and reexecute to produce missing historical state necessary to run a specific
+	// trace.
+	defaultTraceReexec = uint64(128)
 )
 
+var errExecutionTimeout = errors.New("execution timeout")
+
 type Debugger struct {
-	chain       l2chain.ObscuroChain
-	storage     storage.Storage
-	chainConfig *params.ChainConfig
+	chain         l2chain.ObscuroChain
+	batchExecutor components.BatchExecutor
+	storage       storage.Storage
+	chainConfig   *params.ChainConfig
 }
 
-func New(chain l2chain.ObscuroChain, storage storage.Storage, config *params.ChainConfig) *Debugger {
+func New(chain l2chain.ObscuroChain, batchExecutor components.BatchExecutor, storage storage.Storage, config *params.ChainConfig) *Debugger {
 	return &Debugger{
-		chain:       chain,
-		chainConfig: config,
-		storage:     storage,
+		chain:         chain,
+		batchExecutor: batchExecutor,
+		chainConfig:   config,
+		storage:       storage,
 	}
 }
 
@@ -52,111 +70,254 @@ func (d *Debugger) DebugEventLogRelevancy(txHash gethcommon.Hash) (json.RawMessa
 	return jsonRaw, nil
 }
 
-func (d *Debugger) DebugTraceTransaction(context.Context, gethcommon.Hash, *tracers.TraceConfig) (json.RawMessage, error) {
-	return nil, nil
-	//_, blockHash, blockNumber, index, err := d.storage.GetTransaction(txHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// It shouldn't happen in practice.
-	//if blockNumber == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//batch, err := d.storage.FetchBatch(blockHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-
-	//msg, vmctx, statedb, err := d.chain.GetChainStateAtTransaction(batch, int(index), reexec)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//txctx := &gethtracers.Context{
-	//	BlockHash: blockHash,
-	//	TxIndex:   int(index),
-	//	TxHash:    txHash,
-	//}
-	//return d.traceTx(ctx, msg, txctx, vmctx, statedb, config)
+// DebugTraceTransaction re-executes the batch containing the transaction on top of the state of its parent, and traces
+// the transaction with the tracer requested in the config (the struct logger by default).
+// The whole batch is re-executed by the batch executor, so the transaction sees exactly the state it was executed on,
+// including the effects of the value transfers and of the synthetic cross chain transactions of the batch.
+// Note - the caller is responsible for making sure the requester is allowed to see the trace.
+func (d *Debugger) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, error) {
+	_, batchHash, batchNumber, index, err := d.storage.GetTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+	// It shouldn't happen in practice.
+	if batchNumber == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	if config == nil {
+		config = &tracers.TraceConfig{}
+	}
+	batch, err := d.storage.FetchBatch(batchHash)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.regenerateParentState(batch, traceReexec(config)); err != nil {
+		return nil, err
+	}
+
+	tracer, err := newTracer(&tracers.Context{BlockHash: batchHash, TxIndex: int(index), TxHash: txHash}, config)
+	if err != nil {
+		return nil, err
+	}
+	canceller, err := newEVMCanceller()
+	if err != nil {
+		return nil, err
+	}
+	cancel, err := stopOnTimeout(ctx, config, canceller, tracer)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	receipts, err := d.batchExecutor.TraceBatch(batch, canceller.tracerFn(func(tx *common.L2Tx) vm.EVMLogger {
+		if tx.Hash() == txHash {
+			return tracer
+		}
+		return nil
+	}))
+	if canceller.timedOut() {
+		return nil, errExecutionTimeout
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	if !executedTransactions(receipts)[txHash] {
+		return nil, errors.New("transaction was not executed")
+	}
+	return tracer.GetResult()
+}
+
+// DebugTraceCall executes the call on top of the state of the requested batch and traces it with the tracer
+// requested in the config (the struct logger by default). Nothing is committed.
+// Note - the caller is responsible for making sure the requester is allowed to see the trace.
+func (d *Debugger) DebugTraceCall(ctx context.Context, args *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, config *tracers.TraceConfig) (json.RawMessage, error) {
+	if config == nil {
+		config = &tracers.TraceConfig{}
+	}
+	tracer, err := newTracer(&tracers.Context{}, config)
+	if err != nil {
+		return nil, err
+	}
+
+	canceller, err := newEVMCanceller()
+	if err != nil {
+		return nil, err
+	}
+	cancel, err := stopOnTimeout(ctx, config, canceller, tracer)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	_, err = d.chain.TraceCallAtBlock(args, blockNumber, canceller.wrap(tracer))
+	if canceller.timedOut() {
+		return nil, errExecutionTimeout
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	return tracer.GetResult()
+}
+
+// DebugTraceBatch re-executes the batch on top of the state of its parent and traces the transactions sent by
+// the owner with the tracer requested in the config (the struct logger by default).
+// The transactions of other accounts are executed, but not traced. Nothing is committed.
+func (d *Debugger) DebugTraceBatch(ctx context.Context, batch *core.Batch, config *tracers.TraceConfig, owner gethcommon.Address) ([]*tracers.TxTraceResult, error) {
+	// It shouldn't happen in practice.
+	if batch.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	if config == nil {
+		config = &tracers.TraceConfig{}
+	}
+	if err := d.regenerateParentState(batch, traceReexec(config)); err != nil {
+		return nil, err
+	}
+
+	// create a tracer for each transaction of the owner
+	batchTracers := make(map[gethcommon.Hash]tracers.Tracer)
+	var ownTxs []*common.L2Tx
+	for i, tx := range batch.Transactions {
+		sender, err := core.GetTxSigner(tx)
+		if err != nil {
+			return nil, err
+		}
+		if sender != owner {
+			continue
+		}
+		tracer, err := newTracer(&tracers.Context{BlockHash: batch.Hash(), TxIndex: i, TxHash: tx.Hash()}, config)
+		if err != nil {
+			return nil, err
+		}
+		batchTracers[tx.Hash()] = tracer
+		ownTxs = append(ownTxs, tx)
+	}
+	if len(ownTxs) == 0 {
+		return []*tracers.TxTraceResult{}, nil
+	}
+
+	allTracers := make([]tracers.Tracer, 0, len(batchTracers))
+	for _, tracer := range batchTracers {
+		allTracers = append(allTracers, tracer)
+	}
+	canceller, err := newEVMCanceller()
+	if err != nil {
+		return nil, err
+	}
+	cancel, err := stopOnTimeout(ctx, config, canceller, allTracers...)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	// the transactions of the other accounts are executed with a tracer that records nothing, so the timeout cancels
+	// their execution as well
+	receipts, err := d.batchExecutor.TraceBatch(batch, canceller.tracerFn(func(tx *common.L2Tx) vm.EVMLogger {
+		if tracer, found := batchTracers[tx.Hash()]; found {
+			return tracer
+		}
+		return nil
+	}))
+	if canceller.timedOut() {
+		return nil, errExecutionTimeout
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+
+	executed := executedTransactions(receipts)
+	results := make([]*tracers.TxTraceResult, len(ownTxs))
+	for i, tx := range ownTxs {
+		results[i] = &tracers.TxTraceResult{TxHash: tx.Hash()}
+		if !executed[tx.Hash()] {
+			results[i].Error = "transaction was not executed"
+			continue
+		}
+		res, err := batchTracers[tx.Hash()].GetResult()
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Result = res
+	}
+	return results, nil
+}
+
+// regenerateParentState makes sure the state the batch was executed on top of is available. If it is not, it walks back
+// at most `reexec` batches to the closest ancestor with available state, and re-executes the batches in between with the
+// batch executor, the same way validators execute the batches they receive.
+func (d *Debugger) regenerateParentState(batch *core.Batch, reexec uint64) error {
+	var toExecute []*core.Batch
+	current := batch
+	for {
+		parent, err := d.storage.FetchBatch(current.Header.ParentHash)
+		if err != nil {
+			return fmt.Errorf("unable to fetch parent batch - %w", err)
+		}
+		_, err = d.storage.CreateStateDB(parent.Hash())
+		if err == nil {
+			break
+		}
+		if uint64(len(toExecute)) >= reexec || parent.NumberU64() == 0 {
+			return fmt.Errorf("required historical state unavailable (reexec=%d) - %w", reexec, err)
+		}
+		toExecute = append(toExecute, parent)
+		current = parent
+	}
+
+	for i := len(toExecute) - 1; i >= 0; i-- {
+		if _, err := d.batchExecutor.ExecuteBatch(toExecute[i]); err != nil {
+			return fmt.Errorf("unable to regenerate state for batch %s - %w", toExecute[i].Hash(), err)
+		}
+	}
+	return nil
 }
 
-// traceTx configures a new tracer according to the provided configuration, and
-// executes the given message in the provided environment. The return value will
-// be tracer dependent.
-//
-//func (d *Debugger) traceTx(ctx context.Context, message *core.Message, txctx *gethtracers.Context, vmctx vm.BlockContext, statedb *state.StateDB, config *tracers.TraceConfig) (json.RawMessage, error) {
-//	// Assemble the structured logger or the JavaScript tracer
-//	var (
-//		tracer    vm.EVMLogger
-//		err       error
-//		txContext = core.NewEVMTxContext(message)
-//	)
-//	switch {
-//	case config == nil:
-//		tracer = logger.NewStructLogger(nil)
-//	case config.Tracer != nil:
-//		// Define a meaningful timeout of a single transaction trace
-//		timeout := defaultTraceTimeout
-//		if config.Timeout != nil {
-//			if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-//				return nil, err
-//			}
-//		}
-//		//if t, err := tracers.New(*config.Tracer, (*tracers.Context)(txctx)); err != nil {
-//		//	return nil, err
-//		//} else {
-//		//	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-//		//	go func() {
-//		//		<-deadlineCtx.Done()
-//		//		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-//		//			t.Stop(errors.New("execution timeout"))
-//		//		}
-//		//	}()
-//		//	defer cancel()
-//		//	tracer = t
-//		//}
-//	default:
-//		tracer = logger.NewStructLogger(config.Config)
-//	}
-//	// Run the transaction with tracing enabled.
-//	vmenv := vm.NewEVM(vmctx, txContext, statedb, d.chainConfig, vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
-//
-//	// Call Prepare to clear out the statedb access list
-//	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
-//
-//	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
-//	if err != nil {
-//		return nil, fmt.Errorf("tracing failed: %w", err)
-//	}
-//
-//	// Depending on the tracer type, format and return the output.
-//	switch tracer := tracer.(type) {
-//	case *logger.StructLogger:
-//		// If the result contains a revert reason, return it.
-//		returnVal := fmt.Sprintf("%x", result.Return())
-//		if len(result.Revert()) > 0 {
-//			returnVal = fmt.Sprintf("%x", result.Revert())
-//		}
-//		exec := &gethapi.ExecutionResult{
-//			Gas:         result.UsedGas,
-//			Failed:      result.Failed(),
-//			ReturnValue: returnVal,
-//			StructLogs:  gethapi.FormatLogs(tracer.StructLogs()),
-//		}
-//		jsonRaw, err := json.Marshal(exec)
-//		if err != nil {
-//			return nil, err
-//		}
-//		return jsonRaw, nil
-//
-//	case tracers.Tracer:
-//		return tracer.GetResult()
-//
-//	default:
-//		panic(fmt.Sprintf("bad tracer type %T", tracer))
-//	}
-//}
+// newTracer returns the tracer requested in the config, or the struct logger if none was requested.
+func newTracer(txctx *tracers.Context, config *tracers.TraceConfig) (tracers.Tracer, error) {
+	if config.Tracer != nil {
+		return tracers.New(*config.Tracer, txctx, config.TracerConfig)
+	}
+	return gethlogger.NewStructLogger(config.Config), nil
+}
+
+// stopOnTimeout stops the tracers once the timeout from the config (or the default one) expires, and cancels the
+// executions the canceller is attached to, so the timeout bounds the cost of the execution and not only the tracing.
+func stopOnTimeout(ctx context.Context, config *tracers.TraceConfig, canceller *evmCanceller, toStop ...tracers.Tracer) (context.CancelFunc, error) {
+	timeout := defaultTraceTimeout
+	if config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, err
+		}
+	}
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			for _, tracer := range toStop {
+				tracer.Stop(errExecutionTimeout)
+			}
+			// Stop evm execution. Note cancellation is not necessarily immediate.
+			canceller.cancel()
+		}
+	}()
+	return cancel, nil
+}
+
+// traceReexec returns the number of batches the tracer can re-execute to regenerate missing state
+func traceReexec(config *tracers.TraceConfig) uint64 {
+	if config.Reexec != nil {
+		return *config.Reexec
+	}
+	return defaultTraceReexec
+}
+
+// executedTransactions returns the hashes of the transactions that produced the receipts
+func executedTransactions(receipts types.Receipts) map[gethcommon.Hash]bool {
+	executed := make(map[gethcommon.Hash]bool, len(receipts))
+	for _, receipt := range receipts {
+		executed[receipt.TxHash] = true
+	}
+	return executed
+}
@@ -0,0 +1,428 @@
+package debugger
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// returns 42 to any call: PUSH1 0x2a PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	returnFortyTwo = hexutil.MustDecode("0x602a60005260206000f3")
+	// loops forever: JUMPDEST PUSH1 0 JUMP
+	infiniteLoop = hexutil.MustDecode("0x5b600056")
+
+	returnFortyTwoAddress = gethcommon.HexToAddress("0x42")
+	infiniteLoopAddress   = gethcommon.HexToAddress("0x100")
+)
+
+func TestDebugTraceTransactionTracesTheTransactionInItsBatch(t *testing.T) {
+	chain := newTestChain(t)
+	owner, other := chain.newAccount(t), chain.newAccount(t)
+	otherTx := chain.newTx(t, other, returnFortyTwoAddress, 0)
+	ownTx := chain.newTx(t, owner, returnFortyTwoAddress, 0)
+	batch := chain.addBatch(otherTx, ownTx)
+
+	result, err := chain.debugger().DebugTraceTransaction(context.Background(), ownTx.Hash(), callTracerConfig(nil))
+	require.NoError(t, err)
+
+	var frame struct {
+		From   gethcommon.Address
+		Output hexutil.Bytes
+	}
+	require.NoError(t, json.Unmarshal(result, &frame))
+	require.Equal(t, crypto.PubkeyToAddress(owner.PublicKey), frame.From)
+	require.Equal(t, gethcommon.LeftPadBytes([]byte{42}, 32), []byte(frame.Output))
+
+	// the whole batch was re-executed by the batch executor, but only the requested transaction was traced
+	require.Equal(t, []gethcommon.Hash{batch.Hash()}, chain.executor.traced)
+	require.Equal(t, []gethcommon.Hash{ownTx.Hash()}, chain.executor.tracedTxs)
+}
+
+func TestDebugTraceTransactionRegeneratesMissingState(t *testing.T) {
+	chain := newTestChain(t)
+	sender := chain.newAccount(t)
+	first := chain.addBatch(chain.newTx(t, sender, returnFortyTwoAddress, 0))
+	second := chain.addBatch(chain.newTx(t, sender, returnFortyTwoAddress, 1))
+	tx := chain.newTx(t, sender, returnFortyTwoAddress, 2)
+	chain.addBatch(tx)
+
+	// the state of the last two batches is no longer available
+	delete(chain.storage.withState, first.Hash())
+	delete(chain.storage.withState, second.Hash())
+
+	reexec := uint64(1)
+	_, err := chain.debugger().DebugTraceTransaction(context.Background(), tx.Hash(), callTracerConfig(&reexec))
+	require.ErrorContains(t, err, "required historical state unavailable")
+	require.Empty(t, chain.executor.executed)
+
+	reexec = 2
+	_, err = chain.debugger().DebugTraceTransaction(context.Background(), tx.Hash(), callTracerConfig(&reexec))
+	require.NoError(t, err)
+	require.Equal(t, []gethcommon.Hash{first.Hash(), second.Hash()}, chain.executor.executed)
+}
+
+func TestDebugTraceTransactionCancelsTheExecutionOnTimeout(t *testing.T) {
+	chain := newTestChain(t)
+	tx := chain.newTx(t, chain.newAccount(t), infiniteLoopAddress, 0)
+	chain.addBatch(tx)
+
+	timeout := "10ms"
+	config := callTracerConfig(nil)
+	config.Timeout = &timeout
+	_, err := chain.debugger().DebugTraceTransaction(context.Background(), tx.Hash(), config)
+	require.ErrorIs(t, err, errExecutionTimeout)
+
+	// the execution was aborted, instead of running until it ran out of gas
+	require.Len(t, chain.executor.gasUsed, 1)
+	require.Less(t, chain.executor.gasUsed[0], tx.Gas())
+}
+
+func TestDebugTraceBatchTracesOnlyTheTransactionsOfTheOwner(t *testing.T) {
+	chain := newTestChain(t)
+	owner, other := chain.newAccount(t), chain.newAccount(t)
+	firstOwnTx := chain.newTx(t, owner, returnFortyTwoAddress, 0)
+	otherTx := chain.newTx(t, other, returnFortyTwoAddress, 0)
+	secondOwnTx := chain.newTx(t, owner, returnFortyTwoAddress, 1)
+	batch := chain.addBatch(firstOwnTx, otherTx, secondOwnTx)
+
+	results, err := chain.debugger().DebugTraceBatch(context.Background(), batch, callTracerConfig(nil), crypto.PubkeyToAddress(owner.PublicKey))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, firstOwnTx.Hash(), results[0].TxHash)
+	require.Equal(t, secondOwnTx.Hash(), results[1].TxHash)
+	for _, result := range results {
+		require.Empty(t, result.Error)
+		var frame struct{ From gethcommon.Address }
+		require.NoError(t, json.Unmarshal(result.Result.(json.RawMessage), &frame))
+		require.Equal(t, crypto.PubkeyToAddress(owner.PublicKey), frame.From)
+	}
+
+	// all the transactions were executed, but the transaction of the other account was not traced
+	require.Len(t, chain.executor.gasUsed, 3)
+	require.Equal(t, []gethcommon.Hash{firstOwnTx.Hash(), secondOwnTx.Hash()}, chain.executor.tracedTxs)
+}
+
+func TestDebugTraceBatchCancelsTheExecutionOfOtherTransactionsOnTimeout(t *testing.T) {
+	chain := newTestChain(t)
+	owner, other := chain.newAccount(t), chain.newAccount(t)
+	// the transaction of the other account is not traced, but it must not run unbounded
+	otherTx := chain.newTx(t, other, infiniteLoopAddress, 0)
+	batch := chain.addBatch(otherTx, chain.newTx(t, owner, returnFortyTwoAddress, 0))
+
+	timeout := "10ms"
+	config := callTracerConfig(nil)
+	config.Timeout = &timeout
+	_, err := chain.debugger().DebugTraceBatch(context.Background(), batch, config, crypto.PubkeyToAddress(owner.PublicKey))
+	require.ErrorIs(t, err, errExecutionTimeout)
+	require.NotEmpty(t, chain.executor.gasUsed)
+	require.Less(t, chain.executor.gasUsed[0], otherTx.Gas())
+}
+
+func TestDebugTraceCallTracesTheCall(t *testing.T) {
+	chain := newTestChain(t)
+	from := crypto.PubkeyToAddress(chain.newAccount(t).PublicKey)
+	to := returnFortyTwoAddress
+
+	result, err := chain.debugger().DebugTraceCall(context.Background(), &gethapi.TransactionArgs{From: &from, To: &to}, nil, callTracerConfig(nil))
+	require.NoError(t, err)
+	var frame struct {
+		From   gethcommon.Address
+		To     gethcommon.Address
+		Output hexutil.Bytes
+	}
+	require.NoError(t, json.Unmarshal(result, &frame))
+	require.Equal(t, from, frame.From)
+	require.Equal(t, to, frame.To)
+	require.Equal(t, gethcommon.LeftPadBytes([]byte{42}, 32), []byte(frame.Output))
+}
+
+func TestDebugTraceCallCancelsTheExecutionOnTimeout(t *testing.T) {
+	chain := newTestChain(t)
+	from := crypto.PubkeyToAddress(chain.newAccount(t).PublicKey)
+	to := infiniteLoopAddress
+
+	timeout := "10ms"
+	config := callTracerConfig(nil)
+	config.Timeout = &timeout
+	_, err := chain.debugger().DebugTraceCall(context.Background(), &gethapi.TransactionArgs{From: &from, To: &to}, nil, config)
+	require.ErrorIs(t, err, errExecutionTimeout)
+	require.Less(t, chain.l2Chain.gasUsed, uint64(callGas))
+}
+
+func callTracerConfig(reexec *uint64) *tracers.TraceConfig {
+	callTracer := "callTracer"
+	return &tracers.TraceConfig{Tracer: &callTracer, Reexec: reexec}
+}
+
+// testChain - a chain of batches executed by a simplified batch executor, on top of an in-memory state
+type testChain struct {
+	storage  *testStorage
+	executor *testBatchExecutor
+	l2Chain  *testObscuroChain
+	signer   types.Signer
+	accounts []gethcommon.Address
+	head     *core.Batch
+}
+
+func newTestChain(t *testing.T) *testChain {
+	genesis := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(0), BaseFee: big.NewInt(0)}}
+	chain := &testChain{
+		storage: &testStorage{
+			batches:   map[gethcommon.Hash]*core.Batch{genesis.Hash(): genesis},
+			txs:       map[gethcommon.Hash]*core.Batch{},
+			withState: map[gethcommon.Hash]bool{genesis.Hash(): true},
+		},
+		signer: types.LatestSigner(params.AllEthashProtocolChanges),
+		head:   genesis,
+	}
+	chain.executor = &testBatchExecutor{chain: chain}
+	chain.l2Chain = &testObscuroChain{chain: chain}
+	return chain
+}
+
+func (c *testChain) debugger() *Debugger {
+	return New(c.l2Chain, c.executor, c.storage, params.AllEthashProtocolChanges)
+}
+
+func (c *testChain) newAccount(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	c.accounts = append(c.accounts, crypto.PubkeyToAddress(key.PublicKey))
+	return key
+}
+
+func (c *testChain) newTx(t *testing.T, key *ecdsa.PrivateKey, to gethcommon.Address, nonce uint64) *common.L2Tx {
+	tx, err := types.SignNewTx(key, c.signer, &types.LegacyTx{Nonce: nonce, To: &to, Gas: 10_000_000, GasPrice: big.NewInt(1)})
+	require.NoError(t, err)
+	return tx
+}
+
+func (c *testChain) addBatch(txs ...*common.L2Tx) *core.Batch {
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			ParentHash: c.head.Hash(),
+			Number:     big.NewInt(0).Add(c.head.Header.Number, big.NewInt(1)),
+			BaseFee:    big.NewInt(0),
+		},
+		Transactions: txs,
+	}
+	c.storage.batches[batch.Hash()] = batch
+	c.storage.withState[batch.Hash()] = true
+	for _, tx := range txs {
+		c.storage.txs[tx.Hash()] = batch
+	}
+	c.head = batch
+	return batch
+}
+
+// execute - executes the batch on top of the state resulting from executing all its ancestors
+func (c *testChain) execute(batch *core.Batch, tracerFn evm.TracerFn) (types.Receipts, []uint64, error) {
+	statedb, err := c.genesisState()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ancestors []*core.Batch
+	for current := batch; current.NumberU64() > 0; current = c.storage.batches[current.Header.ParentHash] {
+		ancestors = append(ancestors, current)
+	}
+	var receipts types.Receipts
+	var gasUsed []uint64
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		var fn evm.TracerFn
+		if i == 0 {
+			fn = tracerFn
+		}
+		receipts, gasUsed, err = c.executeTransactions(ancestors[i], statedb, fn)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return receipts, gasUsed, nil
+}
+
+// genesisState - the state the first batch is executed on top of
+func (c *testChain) genesisState() (*state.StateDB, error) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range c.accounts {
+		statedb.SetBalance(account, big.NewInt(params.Ether))
+	}
+	statedb.SetCode(returnFortyTwoAddress, returnFortyTwo)
+	statedb.SetCode(infiniteLoopAddress, infiniteLoop)
+	return statedb, nil
+}
+
+func (c *testChain) blockContext(batch *core.Batch) vm.BlockContext {
+	return vm.BlockContext{
+		CanTransfer: gethcore.CanTransfer,
+		Transfer:    gethcore.Transfer,
+		BlockNumber: batch.Number(),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    params.MaxGasLimit,
+		BaseFee:     big.NewInt(0),
+	}
+}
+
+func (c *testChain) executeTransactions(batch *core.Batch, statedb *state.StateDB, tracerFn evm.TracerFn) (types.Receipts, []uint64, error) {
+	blockContext := c.blockContext(batch)
+	var receipts types.Receipts
+	var gasUsed []uint64
+	for i, tx := range batch.Transactions {
+		msg, err := gethcore.TransactionToMessage(tx, c.signer, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		vmCfg := vm.Config{}
+		if tracerFn != nil {
+			vmCfg.Tracer = tracerFn(tx, i)
+		}
+		env := vm.NewEVM(blockContext, gethcore.NewEVMTxContext(msg), statedb, params.AllEthashProtocolChanges, vmCfg)
+		result, err := gethcore.ApplyMessage(env, msg, new(gethcore.GasPool).AddGas(tx.Gas()))
+		if err != nil {
+			continue
+		}
+		receipts = append(receipts, &types.Receipt{TxHash: tx.Hash()})
+		gasUsed = append(gasUsed, result.UsedGas)
+	}
+	return receipts, gasUsed, nil
+}
+
+// testBatchExecutor - executes the transactions of the batches on top of the state of their ancestors
+type testBatchExecutor struct {
+	components.BatchExecutor
+	chain *testChain
+
+	executed  []gethcommon.Hash // the batches executed to regenerate state
+	traced    []gethcommon.Hash // the batches traced
+	tracedTxs []gethcommon.Hash // the transactions that were given a tracer
+	gasUsed   []uint64          // the gas used by the transactions of the last traced batch
+}
+
+func (e *testBatchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts, error) {
+	if !e.chain.storage.withState[batch.Header.ParentHash] {
+		return nil, errors.New("missing parent state")
+	}
+	receipts, _, err := e.chain.execute(batch, nil)
+	if err != nil {
+		return nil, err
+	}
+	e.executed = append(e.executed, batch.Hash())
+	e.chain.storage.withState[batch.Hash()] = true
+	return receipts, nil
+}
+
+func (e *testBatchExecutor) TraceBatch(batch *core.Batch, tracerFn evm.TracerFn) (types.Receipts, error) {
+	if !e.chain.storage.withState[batch.Header.ParentHash] {
+		return nil, errors.New("missing parent state")
+	}
+	e.traced = append(e.traced, batch.Hash())
+	receipts, gasUsed, err := e.chain.execute(batch, func(tx *common.L2Tx, txIndex int) vm.EVMLogger {
+		tracer := tracerFn(tx, txIndex)
+		if cancellable, ok := tracer.(*cancellableTracer); ok && cancellable.EVMLogger != cancellable.canceller.noop {
+			e.tracedTxs = append(e.tracedTxs, tx.Hash())
+		}
+		return tracer
+	})
+	e.gasUsed = gasUsed
+	return receipts, err
+}
+
+// callGas - the gas the test calls are executed with
+const callGas = 10_000_000
+
+// testObscuroChain - executes the calls on top of the genesis state of the test chain
+type testObscuroChain struct {
+	l2chain.ObscuroChain
+	chain *testChain
+
+	gasUsed uint64 // the gas used by the last call
+}
+
+func (c *testObscuroChain) TraceCallAtBlock(apiArgs *gethapi.TransactionArgs, _ *gethrpc.BlockNumber, tracer vm.EVMLogger) (*gethcore.ExecutionResult, error) {
+	statedb, err := c.chain.genesisState()
+	if err != nil {
+		return nil, err
+	}
+	msg := &gethcore.Message{
+		From:              *apiArgs.From,
+		To:                apiArgs.To,
+		Value:             big.NewInt(0),
+		GasLimit:          callGas,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		SkipAccountChecks: true,
+	}
+	env := vm.NewEVM(c.chain.blockContext(c.chain.head), gethcore.NewEVMTxContext(msg), statedb, params.AllEthashProtocolChanges, vm.Config{Tracer: tracer})
+	result, err := gethcore.ApplyMessage(env, msg, new(gethcore.GasPool).AddGas(msg.GasLimit))
+	if err != nil {
+		return nil, err
+	}
+	c.gasUsed = result.UsedGas
+	return result, nil
+}
+
+// testStorage - serves the batches and transactions of the test chain
+type testStorage struct {
+	storage.Storage
+	batches   map[gethcommon.Hash]*core.Batch
+	txs       map[gethcommon.Hash]*core.Batch
+	withState map[gethcommon.Hash]bool
+}
+
+func (s *testStorage) FetchBatch(hash common.L2BatchHash) (*core.Batch, error) {
+	batch, found := s.batches[hash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return batch, nil
+}
+
+func (s *testStorage) CreateStateDB(hash common.L2BatchHash) (*state.StateDB, error) {
+	if !s.withState[hash] {
+		return nil, errors.New("missing trie node")
+	}
+	return nil, nil //nolint:nilnil
+}
+
+func (s *testStorage) GetTransaction(txHash common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error) {
+	batch, found := s.txs[txHash]
+	if !found {
+		return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+	}
+	for i, tx := range batch.Transactions {
+		if tx.Hash() == txHash {
+			return tx, batch.Hash(), batch.NumberU64(), uint64(i), nil
+		}
+	}
+	return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+}
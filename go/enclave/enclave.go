@@ -1,7 +1,6 @@
 package enclave
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/compression"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
 	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
@@ -36,7 +36,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/profiler"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
@@ -50,6 +49,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
@@ -166,16 +166,16 @@ func NewEnclave(
 
 	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
 
-	gasOracle := gas.NewGasOracle()
+	gasOracle := gas.NewGasOracle(storage, logger)
 	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, gasOracle, logger)
-	batchExecutor := components.NewBatchExecutor(storage, gethEncodingService, crossChainProcessors, genesis, gasOracle, chainConfig, config.GasBatchExecutionLimit, logger)
+	batchExecutor := components.NewBatchExecutor(storage, gethEncodingService, crossChainProcessors, genesis, gasOracle, chainConfig, config.GasBatchExecutionLimit, config.CrossChainTreeSeqNo, logger)
 	sigVerifier, err := components.NewSignatureValidator(config.SequencerID, storage)
 	registry := components.NewBatchRegistry(storage, logger)
-	rProducer := components.NewRollupProducer(config.SequencerID, storage, registry, logger)
+	rProducer := components.NewRollupProducer(config.SequencerID, storage, registry, config.CrossChainTreeSeqNo, logger)
 	if err != nil {
 		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
 	}
-	rollupCompression := components.NewRollupCompression(registry, batchExecutor, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, logger)
+	rollupCompression := components.NewRollupCompression(registry, batchExecutor, gasOracle, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, logger)
 	rConsumer := components.NewRollupConsumer(mgmtContractLib, registry, rollupCompression, storage, logger, sigVerifier)
 	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, storage, logger)
 
@@ -186,6 +186,7 @@ func NewEnclave(
 	}
 
 	var service nodetype.NodeType
+	validator := nodetype.NewValidator(blockProcessor, batchExecutor, registry, rConsumer, chainConfig, config.SequencerID, storage, sigVerifier, mempool, logger)
 	if config.NodeType == common.Sequencer {
 		service = nodetype.NewSequencer(
 			blockProcessor,
@@ -211,9 +212,10 @@ func NewEnclave(
 				BaseFee:           config.BaseFee,
 			},
 			blockchain,
+			validator,
 		)
 	} else {
-		service = nodetype.NewValidator(blockProcessor, batchExecutor, registry, rConsumer, chainConfig, config.SequencerID, storage, sigVerifier, mempool, logger)
+		service = validator
 	}
 
 	chain := l2chain.NewChain(
@@ -225,8 +227,12 @@ func NewEnclave(
 		registry,
 		config.GasLocalExecutionCapFlag,
 	)
-	rpcEncryptionManager := rpc.NewEncryptionManager(ecies.ImportECDSA(obscuroKey), storage, registry, crossChainProcessors, service, config, gasOracle, storage, blockProcessor, chain, logger)
+	// the debug endpoints are refused unless the debug namespace is enabled, and only trace the calls and transactions
+	// of the account of the viewing key
+	debug := debugger.New(chain, batchExecutor, storage, chainConfig)
+
 	subscriptionManager := events.NewSubscriptionManager(storage, config.ObscuroChainID, logger)
+	rpcEncryptionManager := rpc.NewEncryptionManager(ecies.ImportECDSA(obscuroKey), storage, registry, crossChainProcessors, service, config, gasOracle, storage, blockProcessor, chain, debug, subscriptionManager, logger)
 
 	// ensure cached chain state data is up-to-date using the persisted batch data
 	err = restoreStateDBCache(storage, registry, batchExecutor, genesis, logger)
@@ -234,9 +240,6 @@ func NewEnclave(
 		logger.Crit("failed to resync L2 chain state DB after restart", log.ErrKey, err)
 	}
 
-	// TODO ensure debug is allowed/disallowed
-	debug := debugger.New(chain, storage, chainConfig)
-
 	logger.Info("Enclave service created with following config", log.CfgKey, config.HostID)
 	return &enclaveImpl{
 		config:                 config,
@@ -388,14 +391,23 @@ func (e *enclaveImpl) StreamL2Updates() (chan common.StreamL2UpdatesResponse, fu
 			e.streamEventsForNewHeadBatch(batch, receipts, l2UpdatesChannel)
 		}
 	})
+	e.subscriptionManager.SetPendingTxsListener(func(pendingTxs common.EncryptedSubscriptionLogs) {
+		// submitting a transaction must not wait for the host to read the stream
+		select {
+		case l2UpdatesChannel <- common.StreamL2UpdatesResponse{Logs: pendingTxs}:
+		default:
+			e.logger.Warn("L2 updates stream is full, dropping pending transaction events")
+		}
+	})
 
 	return l2UpdatesChannel, func() {
 		e.registry.UnsubscribeFromBatches()
+		e.subscriptionManager.SetPendingTxsListener(nil)
 	}
 }
 
 // SubmitL1Block is used to update the enclave with an additional L1 block.
-func (e *enclaveImpl) SubmitL1Block(block types.Block, receipts types.Receipts, _ bool) (*common.BlockSubmissionResponse, common.SystemError) {
+func (e *enclaveImpl) SubmitL1Block(block types.Block, receipts types.Receipts, blobs common.L1Blobs, _ bool) (*common.BlockSubmissionResponse, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitL1Block with the enclave stopping"))
 	}
@@ -406,7 +418,7 @@ func (e *enclaveImpl) SubmitL1Block(block types.Block, receipts types.Receipts,
 	e.logger.Info("SubmitL1Block", log.BlockHeightKey, block.Number(), log.BlockHashKey, block.Hash())
 
 	// If the block and receipts do not match, reject the block.
-	br, err := common.ParseBlockAndReceipts(&block, &receipts)
+	br, err := common.ParseBlockAndReceipts(&block, &receipts, blobs)
 	if err != nil {
 		return nil, e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", err))
 	}
@@ -742,6 +754,17 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.EstimateGasValidate, rpc.EstimateGasExecute)
 }
 
+// EstimateGasBreakdown decrypts CallMsg data, runs the gas estimation for the data, and returns it split between the
+// execution and the L1 publishing cost, encrypted with the viewing key of the sender.
+func (e *enclaveImpl) EstimateGasBreakdown(encryptedParams common.EncryptedParamsEstimateGas) (*responses.GasBreakdown, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested EstimateGasBreakdown with the enclave stopping"))
+	}
+
+	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "enclave.go:EstimateGasBreakdown()")
+	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.EstimateGasBreakdownValidate, rpc.EstimateGasBreakdownExecute)
+}
+
 func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetLogs with the enclave stopping"))
@@ -781,7 +804,9 @@ func (e *enclaveImpl) HealthCheck() (bool, common.SystemError) {
 	return storageHealthy && l1blockHealthy && l2batchHealthy, nil
 }
 
-func (e *enclaveImpl) DebugTraceTransaction(txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+// DebugTraceTransaction handles param decryption, validation and encryption
+// and traces the transaction (debug_traceTransaction). Only the sender of the transaction is authorised to see the trace.
+func (e *enclaveImpl) DebugTraceTransaction(encryptedParams common.EncryptedParamsDebugTraceTx) (*responses.DebugTraceTx, common.SystemError) {
 	// ensure the enclave is running
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested DebugTraceTransaction with the enclave stopping"))
@@ -792,16 +817,47 @@ func (e *enclaveImpl) DebugTraceTransaction(txHash gethcommon.Hash, config *trac
 		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
 	}
 
-	jsonMsg, err := e.debugger.DebugTraceTransaction(context.Background(), txHash, config)
-	if err != nil {
-		if errors.Is(err, syserr.InternalError{}) {
-			return nil, responses.ToInternalError(err)
-		}
-		// TODO *Pedro* MOVE THIS TO Enclave Response
-		return json.RawMessage(err.Error()), nil
+	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.DebugTraceTransactionValidate, rpc.DebugTraceTransactionExecute)
+}
+
+// DebugTraceCall handles param decryption, validation and encryption
+// and traces the call (debug_traceCall). Only the caller is authorised to see the trace.
+func (e *enclaveImpl) DebugTraceCall(encryptedParams common.EncryptedParamsDebugTraceCall) (*responses.DebugTraceCall, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested DebugTraceCall with the enclave stopping"))
 	}
 
-	return jsonMsg, nil
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+
+	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.DebugTraceCallValidate, rpc.DebugTraceCallExecute)
+}
+
+// DebugTraceBatch handles param decryption, validation and encryption
+// and traces the batch (debug_traceBatchByNumber and debug_traceBatchByHash).
+// Only the transactions sent by the viewing key account are traced.
+func (e *enclaveImpl) DebugTraceBatch(encryptedParams common.EncryptedParamsDebugTraceBatch, batchID common.BatchIdentifier) (*responses.DebugTraceBatch, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested DebugTraceBatch with the enclave stopping"))
+	}
+
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+
+	switch batchID {
+	case common.BatchByNumber:
+		return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.DebugTraceBatchByNumberValidate, rpc.DebugTraceBatchExecute)
+	case common.BatchByHash:
+		return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.DebugTraceBatchByHashValidate, rpc.DebugTraceBatchExecute)
+	default:
+		return nil, responses.ToInternalError(fmt.Errorf("unknown batch identifier %d", batchID))
+	}
 }
 
 func (e *enclaveImpl) DebugEventLogRelevancy(txHash gethcommon.Hash) (json.RawMessage, common.SystemError) {
@@ -836,6 +892,110 @@ func (e *enclaveImpl) GetTotalContractCount() (*big.Int, common.SystemError) {
 	return e.storage.GetContractCount()
 }
 
+func (e *enclaveImpl) GetBatchRewardPercentiles(batchHashes []common.L2BatchHash, percentiles []float64) ([][]*big.Int, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetBatchRewardPercentiles with the enclave stopping"))
+	}
+
+	rewards := make([][]*big.Int, len(batchHashes))
+	for i, batchHash := range batchHashes {
+		batch, err := e.storage.FetchBatch(batchHash)
+		if err != nil {
+			return nil, responses.ToInternalError(fmt.Errorf("unable to fetch batch %s - %w", batchHash, err))
+		}
+		receipts, err := e.storage.GetReceiptsByBatchHash(batchHash)
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return nil, responses.ToInternalError(fmt.Errorf("unable to fetch receipts for batch %s - %w", batchHash, err))
+		}
+		rewards[i] = gas.RewardPercentiles(batch.Transactions, receipts, batch.Header.BaseFee, percentiles)
+	}
+	return rewards, nil
+}
+
+func (e *enclaveImpl) GetCrossChainProof(batchHash common.L2BatchHash, index uint64) (*common.CrossChainProof, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetCrossChainProof with the enclave stopping"))
+	}
+
+	batch, err := e.storage.FetchBatch(batchHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("batch %s not found", batchHash)
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("unable to fetch batch %s - %w", batchHash, err))
+	}
+	if batch.SeqNo().Uint64() < e.config.CrossChainTreeSeqNo {
+		return nil, fmt.Errorf("batch %s was produced before the cross chain tree was enabled and has no proofs", batchHash)
+	}
+	receipts, err := e.storage.GetReceiptsByBatchHash(batchHash)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, responses.ToInternalError(fmt.Errorf("unable to fetch receipts for batch %s - %w", batchHash, err))
+	}
+
+	// the transfers are extracted again from the receipts of the transactions of the batch, in the order of execution
+	receiptsByTx := make(map[gethcommon.Hash]*types.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		receiptsByTx[receipt.TxHash] = receipt
+	}
+	txReceipts := make(types.Receipts, 0, len(batch.Transactions))
+	for _, tx := range batch.Transactions {
+		if receipt, found := receiptsByTx[tx.Hash()]; found {
+			txReceipts = append(txReceipts, receipt)
+		}
+	}
+	transfers, err := e.crossChainProcessors.Local.ExtractOutboundTransfers(txReceipts)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("unable to extract the value transfers of batch %s - %w", batchHash, err))
+	}
+	valueTransfers := merkle.ValueTransferMessages(transfers, batch.SeqNo().Uint64())
+	tree, err := merkle.CrossChainTree(batch.Header.CrossChainMessages, valueTransfers)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	if tree.Root() != batch.Header.TransfersTree {
+		return nil, responses.ToInternalError(fmt.Errorf("the cross chain tree of batch %s does not match its header", batchHash))
+	}
+	if leaves := uint64(len(batch.Header.CrossChainMessages) + len(valueTransfers)); index >= leaves {
+		return nil, fmt.Errorf("index %d is out of range, batch %s has %d cross chain messages and value transfers", index, batchHash, leaves)
+	}
+
+	leaf, err := tree.Leaf(int(index))
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	proof, err := tree.Proof(int(index))
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	crossChainProof := &common.CrossChainProof{
+		BatchHash: batchHash,
+		Root:      tree.Root(),
+		Leaf:      leaf,
+		Proof:     proof,
+	}
+	if messages := batch.Header.CrossChainMessages; index < uint64(len(messages)) {
+		crossChainProof.Message = &messages[index]
+	} else {
+		crossChainProof.ValueTransfer = &valueTransfers[index-uint64(len(messages))]
+	}
+	return crossChainProof, nil
+}
+
+func (e *enclaveImpl) GetCrossChainMessageStatus(query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetCrossChainMessageStatus with the enclave stopping"))
+	}
+
+	statuses, err := e.crossChainProcessors.Status.GetStatus(query)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("unable to get the status of the cross chain messages - %w", err))
+	}
+	return statuses, nil
+}
+
 func (e *enclaveImpl) GetCustomQuery(encryptedParams common.EncryptedParamsGetStorageAt) (*responses.PrivateQueryResponse, common.SystemError) {
 	// ensure the enclave is running
 	if e.stopControl.IsStopping() {
@@ -873,7 +1033,14 @@ func (e *enclaveImpl) EnclavePublicConfig() (*common.EnclavePublicConfig, common
 	if systemError != nil {
 		return nil, systemError
 	}
-	return &common.EnclavePublicConfig{L2MessageBusAddress: address}, nil
+	// the sequencer keys are only known once the attestations of the sequencer enclaves have been processed
+	var sequencerKeys [][]byte
+	if keys, err := e.storage.FetchAttestedKeys(e.config.SequencerID); err == nil {
+		for _, key := range keys {
+			sequencerKeys = append(sequencerKeys, gethcrypto.CompressPubkey(key))
+		}
+	}
+	return &common.EnclavePublicConfig{L2MessageBusAddress: address, SequencerPublicKeys: sequencerKeys}, nil
 }
 
 func (e *enclaveImpl) rejectBlockErr(cause error) *errutil.BlockRejectError {
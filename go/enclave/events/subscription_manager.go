@@ -43,6 +43,10 @@ type SubscriptionManager struct {
 	chainID           int64
 	subscriptionMutex *sync.RWMutex // the mutex guards the subscriptions/lastHead pair
 
+	// receives the encrypted hashes of the pending transactions, for the subscriptions to pending transactions
+	pendingTxsListener func(common.EncryptedSubscriptionLogs)
+	listenerMutex      sync.RWMutex
+
 	logger gethlog.Logger
 }
 
@@ -141,6 +145,10 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	userAddrsForLog := map[*types.Log][]*gethcommon.Address{}
 
 	for id, sub := range s.subscriptions {
+		if sub.Subscription.PendingTxs {
+			continue
+		}
+
 		// first filter the logs
 		filteredLogs := filterLogs(allLogs, sub.Subscription.Filter.FromBlock, sub.Subscription.Filter.ToBlock, sub.Subscription.Filter.Addresses, sub.Subscription.Filter.Topics, s.logger)
 
@@ -168,6 +176,58 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	return s.encryptLogs(relevantLogsPerSubscription)
 }
 
+// SetPendingTxsListener sets the function the encrypted pending transactions are sent to (nil to stop sending them).
+func (s *SubscriptionManager) SetPendingTxsListener(listener func(common.EncryptedSubscriptionLogs)) {
+	s.listenerMutex.Lock()
+	defer s.listenerMutex.Unlock()
+	s.pendingTxsListener = listener
+}
+
+// SendPendingTx sends the hash of a transaction accepted by the enclave to the subscriptions to pending transactions
+// of its sender and recipient, encrypted with their viewing keys.
+func (s *SubscriptionManager) SendPendingTx(tx *common.L2Tx) {
+	s.listenerMutex.RLock()
+	defer s.listenerMutex.RUnlock()
+	if s.pendingTxsListener == nil {
+		return
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(s.chainID)), tx)
+	if err != nil {
+		s.logger.Debug("Could not recover the sender of the pending transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
+		return
+	}
+
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+
+	jsonTxHash, err := json.Marshal(tx.Hash())
+	if err != nil {
+		s.logger.Error("Could not marshal the pending transaction hash", log.TxKey, tx.Hash(), log.ErrKey, err)
+		return
+	}
+	encryptedTxs := common.EncryptedSubscriptionLogs{}
+	for id, sub := range s.subscriptions {
+		if !sub.Subscription.PendingTxs {
+			continue
+		}
+		account := sub.ViewingKeyEncryptor.AccountAddress
+		if *account != sender && (tx.To() == nil || *account != *tx.To()) {
+			continue
+		}
+		encryptedTx, err := sub.ViewingKeyEncryptor.Encrypt(jsonTxHash)
+		if err != nil {
+			s.logger.Error("Could not encrypt the pending transaction", log.SubIDKey, id, log.ErrKey, err)
+			continue
+		}
+		encryptedTxs[id] = encryptedTx
+	}
+
+	if len(encryptedTxs) > 0 {
+		s.pendingTxsListener(encryptedTxs)
+	}
+}
+
 func isRelevant(sub *gethcommon.Address, userAddrs []*gethcommon.Address) bool {
 	// If there are no user addresses, this is a lifecycle event, and is therefore relevant to everyone.
 	if len(userAddrs) == 0 {
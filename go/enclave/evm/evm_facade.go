@@ -30,9 +30,14 @@ import (
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
+// TracerFn - returns the tracer to be attached to the EVM while executing the transaction found at txIndex in the batch,
+// or nil if the transaction should not be traced.
+type TracerFn func(tx *common.L2Tx, txIndex int) vm.EVMLogger
+
 // ExecuteTransactions
 // header - the header of the rollup where this transaction will be included
 // fromTxIndex - for the receipts and events, the evm needs to know for each transaction the order in which it was executed in the block.
+// tracerFn - optional, used to trace the execution of the transactions
 func ExecuteTransactions(
 	txs common.L2PricedTransactions,
 	s *state.StateDB,
@@ -43,6 +48,7 @@ func ExecuteTransactions(
 	fromTxIndex int,
 	noBaseFee bool,
 	batchGasLimit uint64,
+	tracerFn TracerFn,
 	logger gethlog.Logger,
 ) map[common.TxHash]interface{} { // todo - return error
 	chain, vmCfg := initParams(storage, gethEncodingService, noBaseFee, logger)
@@ -67,6 +73,11 @@ func ExecuteTransactions(
 	// this should not open up any attack vectors on the randomness.
 	tCountRollback := 0
 	for i, t := range txs {
+		txIndex := (fromTxIndex + i) - tCountRollback
+		txVMCfg := vmCfg
+		if tracerFn != nil {
+			txVMCfg.Tracer = tracerFn(t.Tx, txIndex)
+		}
 		r, err := executeTransaction(
 			s,
 			chainConfig,
@@ -75,8 +86,8 @@ func ExecuteTransactions(
 			ethHeader,
 			t,
 			usedGas,
-			vmCfg,
-			(fromTxIndex+i)-tCountRollback,
+			txVMCfg,
+			txIndex,
 			hash,
 			header.Number.Uint64(),
 		)
@@ -229,6 +240,7 @@ func logReceipt(r *types.Receipt, logger gethlog.Logger) {
 }
 
 // ExecuteObsCall - executes the eth_call call
+// tracer - optional, used to trace the execution of the call
 func ExecuteObsCall(
 	msg *gethcore.Message,
 	s *state.StateDB,
@@ -237,6 +249,7 @@ func ExecuteObsCall(
 	gethEncodingService gethencoding.EncodingService,
 	chainConfig *params.ChainConfig,
 	gasEstimationCap uint64,
+	tracer vm.EVMLogger,
 	logger gethlog.Logger,
 ) (*gethcore.ExecutionResult, error) {
 	noBaseFee := true
@@ -249,6 +262,7 @@ func ExecuteObsCall(
 	gp := gethcore.GasPool(gasEstimationCap)
 	gp.SetGas(gasEstimationCap)
 	chain, vmCfg := initParams(storage, gethEncodingService, noBaseFee, nil)
+	vmCfg.Tracer = tracer
 
 	ethHeader, err := gethEncodingService.CreateEthHeaderForBatch(header)
 	if err != nil {
@@ -319,6 +333,6 @@ func newRevertError(result *gethcore.ExecutionResult) error {
 	return &errutil.EVMSerialisableError{
 		Err:    err.Error(),
 		Reason: hexutil.Encode(result.Revert()),
-		Code:   3, // todo - magic number, really needs thought around the value and made a constant
+		Code:   errutil.RevertErrorCode,
 	}
 }
@@ -0,0 +1,69 @@
+package gas
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+// RewardPercentiles - returns the effective priority fees paid by the transactions of a batch at the requested
+// percentiles, weighted by the gas used by each transaction (same algorithm as the geth eth_feeHistory).
+// The percentiles must be sorted in ascending order. Transactions without a receipt are ignored.
+func RewardPercentiles(txs types.Transactions, receipts types.Receipts, baseFee *big.Int, percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(percentiles) == 0 {
+		return rewards
+	}
+
+	gasUsedByTx := make(map[gethcommon.Hash]uint64, len(receipts))
+	for _, receipt := range receipts {
+		gasUsedByTx[receipt.TxHash] = receipt.GasUsed
+	}
+
+	var totalGasUsed uint64
+	sorter := make([]txGasAndReward, 0, len(txs))
+	for _, tx := range txs {
+		gasUsed, found := gasUsedByTx[tx.Hash()]
+		if !found {
+			continue
+		}
+		reward, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			// the fee cap is below the base fee, so nothing was paid on top of the base fee
+			reward = big.NewInt(0)
+		}
+		totalGasUsed += gasUsed
+		sorter = append(sorter, txGasAndReward{gasUsed: gasUsed, reward: reward})
+	}
+
+	if len(sorter) == 0 {
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
+	}
+
+	sort.Slice(sorter, func(i, j int) bool {
+		return sorter[i].reward.Cmp(sorter[j].reward) < 0
+	})
+
+	var txIndex int
+	sumGasUsed := sorter[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(sorter)-1 {
+			txIndex++
+			sumGasUsed += sorter[txIndex].gasUsed
+		}
+		rewards[i] = sorter[txIndex].reward
+	}
+	return rewards
+}
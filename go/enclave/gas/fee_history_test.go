@@ -0,0 +1,36 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestRewardPercentiles(t *testing.T) {
+	baseFee := big.NewInt(100)
+	txs := types.Transactions{
+		types.NewTx(&types.DynamicFeeTx{Nonce: 0, GasTipCap: big.NewInt(30), GasFeeCap: big.NewInt(1000)}),
+		types.NewTx(&types.DynamicFeeTx{Nonce: 1, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(1000)}),
+		types.NewTx(&types.DynamicFeeTx{Nonce: 2, GasTipCap: big.NewInt(20), GasFeeCap: big.NewInt(115)}),
+	}
+	receipts := types.Receipts{
+		{TxHash: txs[0].Hash(), GasUsed: 10},
+		{TxHash: txs[1].Hash(), GasUsed: 60},
+		{TxHash: txs[2].Hash(), GasUsed: 30},
+	}
+
+	// sorted by effective tip: 10 (60 gas), 15 (30 gas, capped by the fee cap), 30 (10 gas)
+	rewards := RewardPercentiles(txs, receipts, baseFee, []float64{0, 50, 60, 75, 95, 100})
+	expected := []int64{10, 10, 10, 15, 30, 30}
+	for i, reward := range rewards {
+		if reward.Int64() != expected[i] {
+			t.Errorf("unexpected reward at percentile index %d. Expected %d, got %d", i, expected[i], reward.Int64())
+		}
+	}
+
+	rewards = RewardPercentiles(types.Transactions{}, types.Receipts{}, baseFee, []float64{50})
+	if len(rewards) != 1 || rewards[0].Sign() != 0 {
+		t.Errorf("expected zero reward for an empty batch, got %v", rewards)
+	}
+}
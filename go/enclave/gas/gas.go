@@ -1,12 +1,17 @@
 package gas
 
 import (
+	"bytes"
 	"math/big"
 
-	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+
+	gethcore "github.com/ethereum/go-ethereum/core"
 )
 
 type ObscuroGasPool struct {
@@ -25,18 +30,47 @@ func (gp *ObscuroGasPool) ForTransaction(tx *types.Transaction) (*gethcore.GasPo
 		return nil, err
 	}
 
-	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0))
+	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0), DefaultCompressionRatio)
 
 	gPool := gethcore.GasPool(l1Gas.Uint64())
 	return &gPool, nil
 }
 
+const (
+	// compressionRatioBasis - the compression ratios are expressed in basis points, to keep the calculations in integers
+	compressionRatioBasis = 10_000
+	// DefaultCompressionRatio - the compression ratio used before any rollup was measured
+	DefaultCompressionRatio = 9_000
+)
+
 // CalculateL1GasUsed - calculates the gas cost of having a transaction on the l1.
-func CalculateL1GasUsed(data []byte, overhead *big.Int) *big.Int {
+// compressionRatio - the expected size of the data once compressed in the rollup, in basis points of the original size
+func CalculateL1GasUsed(data []byte, overhead *big.Int, compressionRatio uint64) *big.Int {
 	reducedTxSize := uint64(len(data))
-	reducedTxSize = (reducedTxSize * 90) / 100
+	reducedTxSize = (reducedTxSize * compressionRatio) / compressionRatioBasis
 	reducedTxSize = reducedTxSize * params.TxDataNonZeroGasEIP2028
 
 	l1Gas := new(big.Int).SetUint64(reducedTxSize)
 	return new(big.Int).Add(l1Gas, overhead)
 }
+
+// CalculateL1BlobGasUsed - calculates the blob gas cost of having a transaction in a rollup published with blobs.
+// compressionRatio - the expected size of the data once compressed in the rollup, in basis points of the original size
+func CalculateL1BlobGasUsed(data []byte, compressionRatio uint64) *big.Int {
+	reducedTxSize := (uint64(len(data)) * compressionRatio) / compressionRatioBasis
+	// the blob gas is paid for the whole blob, whether all its bytes are usable or not
+	blobGas := (reducedTxSize * params.BlobTxBlobGasPerBlob) / ethadapter.BlobCapacity
+	return new(big.Int).SetUint64(blobGas)
+}
+
+// placeholderSignature - a signature whose R and S take the maximum space in the encoding of a transaction
+var placeholderSignature = append(bytes.Repeat([]byte{0xff}, 64), 1)
+
+// SignedTxForMsg - returns the transaction the message would be signed into, with a placeholder signature of the
+// maximum size, so its encoding is at least as large as the one of the transaction published in the rollup.
+// The nonce and the gas of the message must be set.
+func SignedTxForMsg(args *gethapi.TransactionArgs, chainID *big.Int) (*types.Transaction, error) {
+	withChainID := *args
+	withChainID.ChainID = (*hexutil.Big)(chainID)
+	return withChainID.ToTransaction().WithSignature(types.LatestSignerForChainID(chainID), placeholderSignature)
+}
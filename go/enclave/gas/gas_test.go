@@ -0,0 +1,67 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestSignedTxForMsgIsAtLeastAsLargeAsTheSignedTx(t *testing.T) {
+	chainID := big.NewInt(443)
+	to := gethcommon.HexToAddress("0x1234")
+	data := hexutil.Bytes(make([]byte, 100))
+	nonce := hexutil.Uint64(1_000)
+	gas := hexutil.Uint64(250_000)
+	value := (*hexutil.Big)(big.NewInt(1_000_000))
+
+	messages := map[string]*gethapi.TransactionArgs{
+		"legacy": {
+			To: &to, Data: &data, Nonce: &nonce, Gas: &gas, Value: value,
+			GasPrice: (*hexutil.Big)(big.NewInt(1_000_000_000)),
+		},
+		"dynamic fee": {
+			To: &to, Data: &data, Nonce: &nonce, Gas: &gas, Value: value,
+			MaxFeePerGas: (*hexutil.Big)(big.NewInt(2_000_000_000)), MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1_000)),
+		},
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	for name, msg := range messages {
+		placeholderTx, err := SignedTxForMsg(msg, chainID)
+		if err != nil {
+			t.Fatalf("%s: could not create the placeholder tx. Cause: %s", name, err)
+		}
+		withChainID := *msg
+		withChainID.ChainID = (*hexutil.Big)(chainID)
+		signedTx, err := types.SignTx(withChainID.ToTransaction(), signer, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		placeholderSize := encodedSize(t, placeholderTx)
+		signedSize := encodedSize(t, signedTx)
+		// the placeholder signature takes the maximum space, a real one can only be a few bytes shorter
+		if placeholderSize < signedSize || placeholderSize > signedSize+4 {
+			t.Errorf("%s: expected the placeholder tx to be up to 4 bytes larger than the signed tx (%d bytes), got %d bytes", name, signedSize, placeholderSize)
+		}
+	}
+}
+
+func encodedSize(t *testing.T, tx *types.Transaction) int {
+	encoded, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(encoded)
+}
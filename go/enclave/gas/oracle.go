@@ -1,40 +1,142 @@
 package gas
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
-	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// baseFeeWindowSize - the number of L1 blocks over which the L1 base fee and the L1 blob base fee are averaged
+	baseFeeWindowSize = 20
+	// compressionWindowSize - the number of rollups over which the compression ratio is averaged
+	compressionWindowSize = 10
 )
 
 // Oracle - the interface for the future precompiled gas oracle contract
 // which will expose necessary l1 information.
 type Oracle interface {
-	ProcessL1Block(block *types.Block)
+	// ProcessL1Block - updates the oracle with the base fee of the block. Must be called for every ingested block,
+	// before any of the estimations is requested for that block.
+	ProcessL1Block(block *types.Block) error
+	// ProcessRollupCompression - records the compression ratio of a rollup published in the L1 block, and whether it
+	// was published with blobs. compressedSize is the size of the rollup as published on the L1, uncompressedSize is
+	// the size of the serialised payload.
+	ProcessRollupCompression(block *types.Block, compressedSize uint64, uncompressedSize uint64, withBlobs bool) error
+	// EstimateL1StorageGasCost - returns the cost of publishing the signed transaction in a rollup, at the L1 block.
+	// The transaction is priced with the blob base fee if the most recent rollup was published with blobs.
 	EstimateL1StorageGasCost(tx *types.Transaction, block *types.Block) (*big.Int, error)
-	EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Block) (*big.Int, error)
+}
+
+// oracleState - the state of the oracle after processing an L1 block.
+// The state is derived from the state of the parent block, so the estimations only depend on the L1 chain,
+// and are the same on all the nodes that have processed the same blocks. The state of every block is kept, as any batch
+// can be re-executed against the L1 block it references (e.g. to replay the batches after a restart, or to trace a
+// transaction), and must be priced the same way again.
+type oracleState struct {
+	BaseFees          []*big.Int // the base fees of the most recent L1 blocks, oldest first
+	CompressionRatios []uint64   // the compression ratios of the most recent rollups in basis points, oldest first
+	BlobBaseFees      []*big.Int `rlp:"optional"` // the blob base fees of the most recent L1 blocks, oldest first
+	RollupsWithBlobs  bool       `rlp:"optional"` // whether the most recent rollup was published with blobs
+}
+
+// baseFee - the average of the recent L1 base fees
+func (s *oracleState) baseFee() *big.Int {
+	return average(s.BaseFees)
+}
+
+// blobBaseFee - the average of the recent L1 blob base fees
+func (s *oracleState) blobBaseFee() *big.Int {
+	return average(s.BlobBaseFees)
+}
+
+// compressionRatio - the average compression ratio of the recent rollups, or the default one if no rollup was recorded
+func (s *oracleState) compressionRatio() uint64 {
+	if len(s.CompressionRatios) == 0 {
+		return DefaultCompressionRatio
+	}
+	var sum uint64
+	for _, ratio := range s.CompressionRatios {
+		sum += ratio
+	}
+	return sum / uint64(len(s.CompressionRatios))
 }
 
 type oracle struct {
-	baseFee *big.Int
+	storage storage.Storage
+	logger  gethlog.Logger
+
+	// the state of the most recently processed block, which is what most estimations are requested for
+	lastBlockHash common.L1BlockHash
+	lastState     *oracleState
+	lock          sync.RWMutex
 }
 
-func NewGasOracle() Oracle {
+func NewGasOracle(storage storage.Storage, logger gethlog.Logger) Oracle {
 	return &oracle{
-		baseFee: big.NewInt(1),
+		storage: storage,
+		logger:  logger,
 	}
 }
 
-// ProcessL1Block - should be used to update the gas oracle. Currently does not really
-// fit into phase 1 gas mechanics as the information needs to be available per block.
-// would be fixed when this becomes a smart contract using the stateDB
-func (o *oracle) ProcessL1Block(block *types.Block) {
-	blockBaseFee := block.BaseFee()
-	if blockBaseFee != nil {
-		o.baseFee = blockBaseFee
+// ProcessL1Block - adds the base fee of the block to the window of base fees inherited from its parent,
+// and persists the resulting state, so it survives restarts and is not affected by forks.
+func (o *oracle) ProcessL1Block(block *types.Block) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	parentState, err := o.fetchState(block.ParentHash())
+	if err != nil {
+		return err
+	}
+
+	state := &oracleState{
+		BaseFees:          parentState.BaseFees,
+		CompressionRatios: parentState.CompressionRatios,
+		BlobBaseFees:      parentState.BlobBaseFees,
+		RollupsWithBlobs:  parentState.RollupsWithBlobs,
+	}
+	if block.BaseFee() != nil {
+		state.BaseFees = appendToWindow(state.BaseFees, block.BaseFee(), baseFeeWindowSize)
+	}
+	if block.ExcessBlobGas() != nil {
+		state.BlobBaseFees = appendToWindow(state.BlobBaseFees, eip4844.CalcBlobFee(*block.ExcessBlobGas()), baseFeeWindowSize)
+	}
+	return o.storeState(block.Hash(), state)
+}
+
+func (o *oracle) ProcessRollupCompression(block *types.Block, compressedSize uint64, uncompressedSize uint64, withBlobs bool) error {
+	if uncompressedSize == 0 {
+		return nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	state, err := o.fetchState(block.Hash())
+	if err != nil {
+		return err
+	}
+
+	ratio := compressedSize * compressionRatioBasis / uncompressedSize
+	updatedState := &oracleState{
+		BaseFees:          state.BaseFees,
+		CompressionRatios: appendToWindow(state.CompressionRatios, ratio, compressionWindowSize),
+		BlobBaseFees:      state.BlobBaseFees,
+		RollupsWithBlobs:  withBlobs,
 	}
+	o.logger.Debug(fmt.Sprintf("Rollup compression ratio: %d. Average: %d", ratio, updatedState.compressionRatio()))
+	return o.storeState(block.Hash(), updatedState)
 }
 
 // EstimateL1StorageGasCost - Returns the expected l1 gas cost for a transaction at a given l1 block.
@@ -44,26 +146,102 @@ func (o *oracle) EstimateL1StorageGasCost(tx *types.Transaction, block *types.Bl
 		return nil, err
 	}
 
-	blockBaseFee := block.BaseFee()
-	if blockBaseFee == nil {
-		return big.NewInt(0), nil
+	state, err := o.stateAt(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.RollupsWithBlobs {
+		blobGas := CalculateL1BlobGasUsed(encodedTx, state.compressionRatio())
+		return big.NewInt(0).Mul(blobGas, state.blobBaseFee()), nil
+	}
+	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0), state.compressionRatio())
+	return big.NewInt(0).Mul(l1Gas, state.baseFee()), nil
+}
+
+// stateAt - returns the state of the oracle after processing the block.
+// For blocks that were never processed by the oracle (i.e. ingested before the oracle state was persisted)
+// the state is derived from the block alone.
+func (o *oracle) stateAt(block *types.Block) (*oracleState, error) {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+
+	state, err := o.fetchState(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if len(state.BaseFees) == 0 && block.BaseFee() != nil {
+		state = &oracleState{
+			BaseFees:          []*big.Int{block.BaseFee()},
+			CompressionRatios: state.CompressionRatios,
+			BlobBaseFees:      state.BlobBaseFees,
+			RollupsWithBlobs:  state.RollupsWithBlobs,
+		}
+	}
+	if len(state.BlobBaseFees) == 0 && block.ExcessBlobGas() != nil {
+		state = &oracleState{
+			BaseFees:          state.BaseFees,
+			CompressionRatios: state.CompressionRatios,
+			BlobBaseFees:      []*big.Int{eip4844.CalcBlobFee(*block.ExcessBlobGas())},
+			RollupsWithBlobs:  state.RollupsWithBlobs,
+		}
+	}
+	return state, nil
+}
+
+// fetchState - returns the persisted state for the block, or an empty state if there is none
+func (o *oracle) fetchState(blockHash common.L1BlockHash) (*oracleState, error) {
+	if o.lastState != nil && o.lastBlockHash == blockHash {
+		return o.lastState, nil
+	}
+
+	encoded, err := o.storage.FetchGasOracleState(blockHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return &oracleState{}, nil
+		}
+		return nil, fmt.Errorf("could not fetch gas oracle state. Cause: %w", err)
+	}
+
+	state := &oracleState{}
+	if err := rlp.DecodeBytes(encoded, state); err != nil {
+		return nil, fmt.Errorf("could not decode gas oracle state. Cause: %w", err)
 	}
+	return state, nil
+}
 
-	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0))
-	return big.NewInt(0).Mul(l1Gas, block.BaseFee()), nil
+func (o *oracle) storeState(blockHash common.L1BlockHash, state *oracleState) error {
+	encoded, err := rlp.EncodeToBytes(state)
+	if err != nil {
+		return fmt.Errorf("could not encode gas oracle state. Cause: %w", err)
+	}
+	if err := o.storage.StoreGasOracleState(blockHash, encoded); err != nil {
+		return fmt.Errorf("could not store gas oracle state. Cause: %w", err)
+	}
+	o.lastBlockHash = blockHash
+	o.lastState = state
+	return nil
 }
 
-func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Block) (*big.Int, error) {
-	encoded := make([]byte, 0)
-	if args.Data != nil {
-		encoded = append(encoded, *args.Data...)
+// average - the average of the values, or zero if there are none
+func average(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return big.NewInt(0)
 	}
+	sum := big.NewInt(0)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(values))))
+}
 
-	// We get the non zero gas cost per byte of calldata, and multiply it by the fixed bytes
-	// of a transaction. Then we take the data of a transaction and calculate the l1 gas used for it.
-	// Both are added together and multiplied by the base fee to give us the final cost for the message.
-	nonZeroGas := big.NewInt(int64(params.TxDataNonZeroGasEIP2028))
-	overhead := big.NewInt(0).Mul(big.NewInt(150), nonZeroGas)
-	l1Gas := CalculateL1GasUsed(encoded, overhead)
-	return big.NewInt(0).Mul(l1Gas, block.BaseFee()), nil
+// appendToWindow - returns a new slice with the value appended, keeping only the most recent windowSize values
+func appendToWindow[T any](window []T, value T, windowSize int) []T {
+	start := 0
+	if len(window) >= windowSize {
+		start = len(window) - windowSize + 1
+	}
+	result := make([]T, 0, windowSize)
+	result = append(result, window[start:]...)
+	return append(result, value)
 }
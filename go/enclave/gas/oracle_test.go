@@ -0,0 +1,194 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// the transaction priced in the tests
+var pricedTx = types.NewTx(&types.LegacyTx{Gas: 100_000, GasPrice: big.NewInt(1), Data: make([]byte, 1_000)})
+
+func TestOracleAveragesTheBaseFeesOfTheMostRecentBlocks(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+
+	// the base fee of the block at height i is i gwei
+	blocks := processChain(t, db, oracle, nil, 1, baseFeeWindowSize+5, nil)
+	head := blocks[len(blocks)-1]
+
+	// only the base fees of the last blocks of the window are averaged
+	expectedBaseFee := averageGwei(6, baseFeeWindowSize+5)
+	assertCost(t, oracle, head, calldataCost(t, DefaultCompressionRatio, expectedBaseFee))
+
+	// a fork inherits the window of its parent, not the one of the canonical block at the same height
+	fork := processChain(t, db, oracle, blocks[len(blocks)-2], uint64(len(blocks)), 1, big.NewInt(1_000))[0]
+	forkBaseFee := average(append(gweiRange(6, baseFeeWindowSize+4), big.NewInt(1_000)))
+	assertCost(t, oracle, fork, calldataCost(t, DefaultCompressionRatio, forkBaseFee))
+	assertCost(t, oracle, head, calldataCost(t, DefaultCompressionRatio, expectedBaseFee))
+}
+
+func TestOracleStateSurvivesRestarts(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+	blocks := processChain(t, db, oracle, nil, 1, 5, nil)
+	head := blocks[len(blocks)-1]
+	if err := oracle.ProcessRollupCompression(head, 5_000, 10_000, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new oracle on the same storage prices transactions like the old one and continues the windows from the stored state
+	restarted := NewGasOracle(db, gethlog.New())
+	assertCost(t, restarted, head, calldataCost(t, 5_000, averageGwei(1, 5)))
+
+	next := processChain(t, db, restarted, head, 6, 1, nil)[0]
+	assertCost(t, restarted, next, calldataCost(t, 5_000, averageGwei(1, 6)))
+}
+
+func TestOracleAveragesTheCompressionRatiosOfTheMostRecentRollups(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+	head := processChain(t, db, oracle, nil, 1, 1, nil)[0]
+
+	// the ratios are 1000, 2000, ..., 12000 basis points
+	for i := uint64(1); i <= compressionWindowSize+2; i++ {
+		if err := oracle.ProcessRollupCompression(head, i*1_000, 10_000, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// an empty rollup does not affect the ratio
+	if err := oracle.ProcessRollupCompression(head, 1_000, 0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the ratios of the last rollups of the window are averaged: (3000 + ... + 12000) / 10
+	assertCost(t, oracle, head, calldataCost(t, 7_500, averageGwei(1, 1)))
+}
+
+func TestOraclePricesUnknownBlocksWithTheirOwnBaseFee(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+
+	unknown := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10), BaseFee: big.NewInt(7)})
+	assertCost(t, oracle, unknown, calldataCost(t, DefaultCompressionRatio, big.NewInt(7)))
+
+	// a block without a base fee is free to publish to
+	withoutBaseFee := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(11)})
+	assertCost(t, oracle, withoutBaseFee, big.NewInt(0))
+}
+
+func TestOraclePricesRollupsWithBlobsWithTheBlobBaseFee(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+	excessBlobGas := uint64(10_000_000)
+	head := processChain(t, db, oracle, nil, 1, 3, nil, excessBlobGas)[2]
+
+	// the blob base fee is ignored while the rollups are published with calldata
+	if err := oracle.ProcessRollupCompression(head, 5_000, 10_000, false); err != nil {
+		t.Fatal(err)
+	}
+	assertCost(t, oracle, head, calldataCost(t, 5_000, averageGwei(1, 3)))
+
+	if err := oracle.ProcessRollupCompression(head, 5_000, 10_000, true); err != nil {
+		t.Fatal(err)
+	}
+	blobGas := CalculateL1BlobGasUsed(encode(t, pricedTx), 5_000)
+	if blobGas.Sign() <= 0 {
+		t.Fatal("expected the transaction to use blob gas")
+	}
+	assertCost(t, oracle, head, big.NewInt(0).Mul(blobGas, eip4844.CalcBlobFee(excessBlobGas)))
+}
+
+func TestOraclePricesOldBlocksTheSameWayAfterNewBlocksAreProcessed(t *testing.T) {
+	db := newTestStorage(t)
+	oracle := NewGasOracle(db, gethlog.New())
+	blocks := processChain(t, db, oracle, nil, 1, baseFeeWindowSize+5, nil)
+	old := blocks[4]
+	expectedCost := calldataCost(t, DefaultCompressionRatio, averageGwei(1, 5))
+	assertCost(t, oracle, old, expectedCost)
+
+	// a batch referencing the old block is re-executed long after, e.g. when replaying the batches after a restart
+	processChain(t, db, oracle, blocks[len(blocks)-1], uint64(len(blocks))+1, 2*baseFeeWindowSize, nil)
+	restarted := NewGasOracle(db, gethlog.New())
+	assertCost(t, restarted, old, expectedCost)
+}
+
+func newTestStorage(t *testing.T) storage.Storage {
+	logger := gethlog.New()
+	db, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage.NewStorage(db, nil, logger)
+}
+
+// processChain - stores count blocks starting at the height, on top of the parent, and processes them with the oracle.
+// The base fee of each block is its height in gwei, unless a base fee is given.
+func processChain(t *testing.T, db storage.Storage, oracle Oracle, parent *types.Block, height uint64, count int, baseFee *big.Int, excessBlobGas ...uint64) []*types.Block {
+	blocks := make([]*types.Block, count)
+	for i := range blocks {
+		header := &types.Header{Number: big.NewInt(int64(height) + int64(i)), BaseFee: baseFee}
+		if baseFee == nil {
+			header.BaseFee = big.NewInt(0).Mul(header.Number, big.NewInt(1_000_000_000))
+		}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+		}
+		if len(excessBlobGas) > 0 {
+			header.ExcessBlobGas = &excessBlobGas[0]
+		}
+		block := types.NewBlockWithHeader(header)
+		if err := db.StoreBlock(block, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := oracle.ProcessL1Block(block); err != nil {
+			t.Fatal(err)
+		}
+		blocks[i] = block
+		parent = block
+	}
+	return blocks
+}
+
+func assertCost(t *testing.T, oracle Oracle, block *types.Block, expected *big.Int) {
+	t.Helper()
+	cost, err := oracle.EstimateL1StorageGasCost(pricedTx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost.Cmp(expected) != 0 {
+		t.Errorf("unexpected cost at block %d. Expected %d, got %d", block.NumberU64(), expected, cost)
+	}
+}
+
+func calldataCost(t *testing.T, compressionRatio uint64, baseFee *big.Int) *big.Int {
+	return big.NewInt(0).Mul(CalculateL1GasUsed(encode(t, pricedTx), big.NewInt(0), compressionRatio), baseFee)
+}
+
+func encode(t *testing.T, tx *types.Transaction) []byte {
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encodedTx
+}
+
+// gweiRange - the values from first to last gwei
+func gweiRange(first int64, last int64) []*big.Int {
+	values := make([]*big.Int, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		values = append(values, big.NewInt(i*1_000_000_000))
+	}
+	return values
+}
+
+func averageGwei(first int64, last int64) *big.Int {
+	return average(gweiRange(first, last))
+}
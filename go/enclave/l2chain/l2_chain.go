@@ -1,16 +1,13 @@
 package l2chain
 
 import (
-	"errors"
 	"fmt"
-	"math/big"
 
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -21,7 +18,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
-	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/evm"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
 )
@@ -116,6 +112,14 @@ func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *g
 }
 
 func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
+	return oc.obsCallAtBlock(apiArgs, blockNumber, nil)
+}
+
+func (oc *obscuroChain) TraceCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, tracer vm.EVMLogger) (*gethcore.ExecutionResult, error) {
+	return oc.obsCallAtBlock(apiArgs, blockNumber, tracer)
+}
+
+func (oc *obscuroChain) obsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, tracer vm.EVMLogger) (*gethcore.ExecutionResult, error) {
 	// fetch the chain state at given batch
 	blockState, err := oc.Registry.GetBatchStateAtHeight(blockNumber)
 	if err != nil {
@@ -141,7 +145,7 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 			batch.Header.Root.Hex())
 	}})
 
-	result, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, oc.storage, oc.gethEncodingService, oc.chainConfig, oc.gasEstimationCap, oc.logger)
+	result, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, oc.storage, oc.gethEncodingService, oc.chainConfig, oc.gasEstimationCap, tracer, oc.logger)
 	if err != nil {
 		// also return the result as the result can be evaluated on some errors like ErrIntrinsicGas
 		return result, err
@@ -150,64 +154,6 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 	return result, nil
 }
 
-// GetChainStateAtTransaction Returns the state of the chain at certain block height after executing transactions up to the selected transaction
-// TODO make this cacheable
-func (oc *obscuroChain) GetChainStateAtTransaction(batch *core.Batch, txIndex int, _ uint64) (*gethcore.Message, vm.BlockContext, *state.StateDB, error) {
-	// Short circuit if it's genesis batch.
-	if batch.NumberU64() == 0 {
-		return nil, vm.BlockContext{}, nil, errors.New("no transaction in genesis")
-	}
-	// Create the parent state database
-	parent, err := oc.Registry.GetBatchAtHeight(gethrpc.BlockNumber(batch.NumberU64() - 1))
-	if err != nil {
-		return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to fetch parent batch - %w", err)
-	}
-	parentBlockNumber := gethrpc.BlockNumber(parent.NumberU64())
-
-	// Lookup the statedb of parent batch from the live database,
-	// otherwise regenerate it on the flight.
-	statedb, err := oc.Registry.GetBatchStateAtHeight(&parentBlockNumber)
-	if err != nil {
-		return nil, vm.BlockContext{}, nil, err
-	}
-	if txIndex == 0 && len(batch.Transactions) == 0 {
-		return nil, vm.BlockContext{}, statedb, nil
-	}
-	// Recompute transactions up to the target index.
-	// TODO - Once the enclave's genesis.json is set, retrieve the signer type using `types.MakeSigner`.
-	rules := oc.chainConfig.Rules(big.NewInt(0), true, 0)
-	signer := types.LatestSigner(oc.chainConfig)
-	for idx, tx := range batch.Transactions {
-		// Assemble the transaction call message and return if the requested offset
-		msg, err := gethcore.TransactionToMessage(tx, signer, big.NewInt(0))
-		if err != nil {
-			return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to convert tx to message - %w", err)
-		}
-		txContext := gethcore.NewEVMTxContext(msg)
-
-		chain := evm.NewObscuroChainContext(oc.storage, oc.gethEncodingService, oc.logger)
-
-		blockHeader, err := oc.gethEncodingService.CreateEthHeaderForBatch(batch.Header)
-		if err != nil {
-			return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to convert batch header to eth header - %w", err)
-		}
-		context := gethcore.NewEVMBlockContext(blockHeader, chain, nil)
-		if idx == txIndex {
-			return msg, context, statedb, nil
-		}
-		// Not yet the searched for transaction, execute on top of the current state
-		vmenv := vm.NewEVM(context, txContext, statedb, oc.chainConfig, vm.Config{})
-		statedb.Prepare(rules, msg.From, gethcommon.Address{}, tx.To(), nil, nil)
-		if _, err := gethcore.ApplyMessage(vmenv, msg, new(gethcore.GasPool).AddGas(tx.Gas())); err != nil {
-			return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction %#x failed: %w", tx.Hash(), err)
-		}
-		// Ensure any modifications are committed to the state
-		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
-		statedb.Finalise(vmenv.ChainConfig().IsEIP158(batch.Number()))
-	}
-	return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction index %d out of range for batch %#x", txIndex, batch.Hash())
-}
-
 // Returns whether the account is a contract
 func (oc *obscuroChain) isAccountContractAtBlock(accountAddr gethcommon.Address, blockNumber *gethrpc.BlockNumber) (bool, error) {
 	chainState, err := oc.Registry.GetBatchStateAtHeight(blockNumber)
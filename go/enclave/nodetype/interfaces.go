@@ -32,7 +32,8 @@ type Sequencer interface {
 	// and adds as many batches to it as possible.
 	CreateRollup(lastBatchNo uint64) (*common.ExtRollup, error)
 
-	NodeType
+	// ObsValidator - the standby sequencer enclaves of a host execute the batches of the active one, like validators
+	ObsValidator
 }
 
 type ObsValidator interface {
@@ -61,9 +61,12 @@ type sequencer struct {
 	dataCompressionService compression.DataCompressionService
 	settings               SequencerSettings
 	blockchain             *ethchainadapter.EthChainAdapter
+
+	// executes the batches produced by another enclave of the host, while this one is on standby
+	standby ObsValidator
 }
 
-func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupProducer components.RollupProducer, rollupConsumer components.RollupConsumer, rollupCompression *components.RollupCompression, gethEncodingService gethencoding.EncodingService, logger gethlog.Logger, hostID gethcommon.Address, chainConfig *params.ChainConfig, enclavePrivateKey *crypto.EnclaveKey, mempool *txpool.TxPool, storage storage.Storage, dataEncryptionService crypto.DataEncryptionService, dataCompressionService compression.DataCompressionService, settings SequencerSettings, blockchain *ethchainadapter.EthChainAdapter) Sequencer {
+func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupProducer components.RollupProducer, rollupConsumer components.RollupConsumer, rollupCompression *components.RollupCompression, gethEncodingService gethencoding.EncodingService, logger gethlog.Logger, hostID gethcommon.Address, chainConfig *params.ChainConfig, enclavePrivateKey *crypto.EnclaveKey, mempool *txpool.TxPool, storage storage.Storage, dataEncryptionService crypto.DataEncryptionService, dataCompressionService compression.DataCompressionService, settings SequencerSettings, blockchain *ethchainadapter.EthChainAdapter, standby ObsValidator) Sequencer {
 	return &sequencer{
 		blockProcessor:         blockProcessor,
 		batchProducer:          batchExecutor,
@@ -82,6 +85,7 @@ func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor comp
 		dataCompressionService: dataCompressionService,
 		settings:               settings,
 		blockchain:             blockchain,
+		standby:                standby,
 	}
 }
 
@@ -368,6 +372,13 @@ func (s *sequencer) duplicateBatches(l1Head *types.Block, nonCanonicalL1Path []c
 		return batchesToDuplicate[i].Number().Cmp(batchesToDuplicate[j].Number()) == -1
 	})
 
+	// a standby enclave of the sequencer host receives the duplicates from the enclave that produced the batches
+	lastBatch := batchesToDuplicate[len(batchesToDuplicate)-1]
+	if !ecdsa.Verify(s.enclaveKey.PublicKey(), lastBatch.Hash().Bytes(), lastBatch.Header.R, lastBatch.Header.S) {
+		s.logger.Info("Not duplicating batches produced by another enclave", log.BatchHashKey, lastBatch.Hash())
+		return nil
+	}
+
 	currentHead := batchesToDuplicate[0].Header.ParentHash
 
 	// find all batches for that path
@@ -441,8 +452,16 @@ func (s *sequencer) signRollup(rollup *common.ExtRollup) error {
 }
 
 func (s *sequencer) OnL1Block(_ types.Block, _ *components.BlockIngestionType) error {
-	// nothing to do
-	return nil
+	// the batches received while on standby may have been waiting for their L1 block
+	return s.standby.ExecuteStoredBatches()
+}
+
+func (s *sequencer) ExecuteStoredBatches() error {
+	return s.standby.ExecuteStoredBatches()
+}
+
+func (s *sequencer) VerifySequencerSignature(b *core.Batch) error {
+	return s.standby.VerifySequencerSignature(b)
 }
 
 func (s *sequencer) Close() error {
@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+type DebugTraceBatchParams struct {
+	batchHash   *gethcommon.Hash
+	batchNumber *gethrpc.BlockNumber
+	config      *tracers.TraceConfig
+}
+
+// DebugTraceBatchByNumberValidate - validates the params of a debug_traceBatchByNumber request
+func DebugTraceBatchByNumberValidate(reqParams []any, builder *CallBuilder[DebugTraceBatchParams, []*tracers.TxTraceResult], _ *EncryptionManager) error {
+	return debugTraceBatchValidate(reqParams, builder, func(batchID string) (*DebugTraceBatchParams, error) {
+		batchNumber, err := gethencoding.ExtractBlockNumber(batchID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch number - %w", err)
+		}
+		return &DebugTraceBatchParams{batchNumber: batchNumber}, nil
+	})
+}
+
+// DebugTraceBatchByHashValidate - validates the params of a debug_traceBatchByHash request
+func DebugTraceBatchByHashValidate(reqParams []any, builder *CallBuilder[DebugTraceBatchParams, []*tracers.TxTraceResult], _ *EncryptionManager) error {
+	return debugTraceBatchValidate(reqParams, builder, func(batchID string) (*DebugTraceBatchParams, error) {
+		hashBytes, err := hexutil.Decode(batchID)
+		if err != nil || len(hashBytes) != gethcommon.HashLength {
+			return nil, fmt.Errorf("invalid batch hash %s", batchID)
+		}
+		batchHash := gethcommon.BytesToHash(hashBytes)
+		return &DebugTraceBatchParams{batchHash: &batchHash}, nil
+	})
+}
+
+func debugTraceBatchValidate(reqParams []any, builder *CallBuilder[DebugTraceBatchParams, []*tracers.TxTraceResult], parseBatchID func(string) (*DebugTraceBatchParams, error)) error {
+	// Parameters are [BatchHash or BatchNumber, TraceConfig (optional)]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	batchID, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("invalid batch hash or number")
+		return nil
+	}
+
+	config, err := extractTraceConfig(reqParams, 1)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	params, err := parseBatchID(batchID)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+	params.config = config
+	builder.Param = params
+	return nil
+}
+
+func DebugTraceBatchExecute(builder *CallBuilder[DebugTraceBatchParams, []*tracers.TxTraceResult], rpc *EncryptionManager) error {
+	var batch *core.Batch
+	var err error
+	if builder.Param.batchHash != nil {
+		batch, err = rpc.storage.FetchBatch(*builder.Param.batchHash)
+	} else {
+		batch, err = rpc.registry.GetBatchAtHeight(*builder.Param.batchNumber)
+	}
+	if err != nil {
+		rpc.logger.Trace("error getting batch to trace", log.ErrKey, err)
+		if errors.Is(err, errutil.ErrNotFound) {
+			builder.Status = NotFound
+			return nil
+		}
+		return err
+	}
+
+	// Only the transactions sent by the viewing key account are traced, as the trace exposes the private state they touched.
+	traces, err := rpc.debugger.DebugTraceBatch(context.Background(), batch, builder.Param.config, *builder.VK.AccountAddress)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace batch. Cause: %w", err)
+		return nil
+	}
+
+	builder.ReturnValue = &traces
+	return nil
+}
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+)
+
+type DebugTraceCallParams struct {
+	callParams *CallParamsWithBlock
+	config     *tracers.TraceConfig
+}
+
+func DebugTraceCallValidate(reqParams []any, builder *CallBuilder[DebugTraceCallParams, json.RawMessage], _ *EncryptionManager) error {
+	// Parameters are [TransactionArgs, BlockNumber (optional), TraceConfig (optional)]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+
+	callMsg, err := gethencoding.ExtractEthCall(reqParams[0])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to decode EthCall Params - %w", err)
+		return nil
+	}
+
+	// throw an error if the call is not from a known account
+	if callMsg.From == nil {
+		builder.Err = fmt.Errorf("no from address provided")
+		return nil
+	}
+
+	blockNumber, err := gethencoding.ExtractOptionalBlockNumber(reqParams, 1)
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract requested block number - %w", err)
+		return nil
+	}
+
+	config, err := extractTraceConfig(reqParams, 2)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	builder.From = callMsg.From
+	builder.Param = &DebugTraceCallParams{callParams: &CallParamsWithBlock{callMsg, blockNumber}, config: config}
+	return nil
+}
+
+func DebugTraceCallExecute(builder *CallBuilder[DebugTraceCallParams, json.RawMessage], rpc *EncryptionManager) error {
+	err := authenticateFrom(builder.VK, builder.From)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	params := builder.Param.callParams
+	trace, err := rpc.debugger.DebugTraceCall(context.Background(), params.callParams, params.block, builder.Param.config)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace call. Cause: %w", err)
+		return nil
+	}
+
+	builder.ReturnValue = &trace
+	return nil
+}
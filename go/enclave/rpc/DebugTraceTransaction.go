@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type DebugTraceTxParams struct {
+	txHash gethcommon.Hash
+	config *tracers.TraceConfig
+}
+
+func DebugTraceTransactionValidate(reqParams []any, builder *CallBuilder[DebugTraceTxParams, json.RawMessage], _ *EncryptionManager) error {
+	// Parameters are [Hash, TraceConfig (optional)]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	txHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("invalid transaction hash")
+		return nil
+	}
+
+	config, err := extractTraceConfig(reqParams, 1)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	builder.Param = &DebugTraceTxParams{txHash: gethcommon.HexToHash(txHashStr), config: config}
+	return nil
+}
+
+func DebugTraceTransactionExecute(builder *CallBuilder[DebugTraceTxParams, json.RawMessage], rpc *EncryptionManager) error {
+	txHash := builder.Param.txHash
+	tx, _, _, _, err := rpc.storage.GetTransaction(txHash) //nolint:dogsled
+	if err != nil {
+		rpc.logger.Trace("error getting tx ", log.TxKey, txHash, log.ErrKey, err)
+		if errors.Is(err, errutil.ErrNotFound) {
+			builder.Status = NotFound
+			return nil
+		}
+		return err
+	}
+
+	// Only the sender of the transaction is allowed to see its trace, as it exposes the private state it touched.
+	txSigner, err := core.GetTxSigner(tx)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+	if txSigner.Hex() != builder.VK.AccountAddress.Hex() {
+		builder.Status = NotAuthorised
+		return nil
+	}
+
+	trace, err := rpc.debugger.DebugTraceTransaction(context.Background(), txHash, builder.Param.config)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace transaction. Cause: %w", err)
+		return nil
+	}
+
+	builder.ReturnValue = &trace
+	return nil
+}
+
+// extractTraceConfig returns the optional trace config found at position pos of the request params.
+func extractTraceConfig(reqParams []any, pos int) (*tracers.TraceConfig, error) {
+	if len(reqParams) <= pos || reqParams[pos] == nil {
+		return nil, nil
+	}
+	// the config arrives as a map, so we marshal it back to JSON in order to unmarshal it into the TraceConfig
+	configJSON, err := json.Marshal(reqParams[pos])
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal trace config to JSON. Cause: %w", err)
+	}
+	var config tracers.TraceConfig
+	if err = json.Unmarshal(configJSON, &config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal trace config. Cause: %w", err)
+	}
+	return &config, nil
+}
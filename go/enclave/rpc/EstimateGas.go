@@ -8,9 +8,12 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/measure"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -21,7 +24,31 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/syserr"
 )
 
+// publishingGasMarginPercent - the margin added to the gas charged for publishing the transaction on the L1, so the
+// estimate still covers it if the average L1 base fee rises before the transaction is included in a batch
+const publishingGasMarginPercent = 20
+
 func EstimateGasValidate(reqParams []any, builder *CallBuilder[CallParamsWithBlock, hexutil.Uint64], _ *EncryptionManager) error {
+	return estimateGasValidate(reqParams, builder)
+}
+
+func EstimateGasExecute(builder *CallBuilder[CallParamsWithBlock, hexutil.Uint64], rpc *EncryptionManager) error {
+	return estimateGasExecute(builder, rpc, func(breakdown *common.GasEstimateBreakdown) *hexutil.Uint64 {
+		return &breakdown.GasLimit
+	})
+}
+
+func EstimateGasBreakdownValidate(reqParams []any, builder *CallBuilder[CallParamsWithBlock, common.GasEstimateBreakdown], _ *EncryptionManager) error {
+	return estimateGasValidate(reqParams, builder)
+}
+
+func EstimateGasBreakdownExecute(builder *CallBuilder[CallParamsWithBlock, common.GasEstimateBreakdown], rpc *EncryptionManager) error {
+	return estimateGasExecute(builder, rpc, func(breakdown *common.GasEstimateBreakdown) *common.GasEstimateBreakdown {
+		return breakdown
+	})
+}
+
+func estimateGasValidate[R any](reqParams []any, builder *CallBuilder[CallParamsWithBlock, R]) error {
 	// Parameters are [callMsg, Block number (optional)]
 	if len(reqParams) < 1 {
 		builder.Err = fmt.Errorf("unexpected number of parameters")
@@ -51,7 +78,8 @@ func EstimateGasValidate(reqParams []any, builder *CallBuilder[CallParamsWithBlo
 	return nil
 }
 
-func EstimateGasExecute(builder *CallBuilder[CallParamsWithBlock, hexutil.Uint64], rpc *EncryptionManager) error {
+// estimateGasExecute - estimates the gas of the message and returns the part of the breakdown the method serves
+func estimateGasExecute[R any](builder *CallBuilder[CallParamsWithBlock, R], rpc *EncryptionManager, result func(*common.GasEstimateBreakdown) *R) error {
 	err := authenticateFrom(builder.VK, builder.From)
 	if err != nil {
 		builder.Err = err
@@ -60,39 +88,8 @@ func EstimateGasExecute(builder *CallBuilder[CallParamsWithBlock, hexutil.Uint64
 
 	txArgs := builder.Param.callParams
 	blockNumber := builder.Param.block
-	block, err := rpc.l1BlockProcessor.GetHead()
-	if err != nil {
-		return err
-	}
 
-	// The message is run through the l1 publishing cost estimation for the current
-	// known head Block.
-	l1Cost, err := rpc.gasOracle.EstimateL1CostForMsg(txArgs, block)
-	if err != nil {
-		return err
-	}
-
-	headBatchSeq := rpc.registry.HeadBatchSeq()
-	batch, err := rpc.storage.FetchBatchBySeqNo(headBatchSeq.Uint64())
-	if err != nil {
-		return err
-	}
-
-	// We divide the total estimated l1 cost by the l2 fee per gas in order to convert
-	// the expected cost into l2 gas based on current pricing.
-	// todo @siliev - add overhead when the base fee becomes dynamic.
-	publishingGas := big.NewInt(0).Div(l1Cost, batch.Header.BaseFee)
-
-	// The one additional gas captures the modulo leftover in some edge cases
-	// where BaseFee is bigger than the l1cost.
-	publishingGas = big.NewInt(0).Add(publishingGas, gethcommon.Big1)
-
-	// Overestimate the publishing cost in case of spikes.
-	// Batch execution still deducts normally.
-	// TODO: Change to fixed time period quotes, rather than this.
-	publishingGas = publishingGas.Mul(publishingGas, gethcommon.Big2)
-
-	executionGasEstimate, err := rpc.doEstimateGas(txArgs, blockNumber, rpc.config.GasLocalExecutionCapFlag)
+	executionGas, err := rpc.doEstimateGas(txArgs, blockNumber, rpc.config.GasLocalExecutionCapFlag)
 	if err != nil {
 		err = fmt.Errorf("unable to estimate transaction - %w", err)
 
@@ -109,11 +106,86 @@ func EstimateGasExecute(builder *CallBuilder[CallParamsWithBlock, hexutil.Uint64
 		return nil
 	}
 
-	totalGasEstimate := hexutil.Uint64(publishingGas.Uint64() + uint64(executionGasEstimate))
-	builder.ReturnValue = &totalGasEstimate
+	breakdown, err := rpc.estimatePublishingGas(txArgs, uint64(executionGas))
+	if err != nil {
+		return err
+	}
+	builder.ReturnValue = result(breakdown)
 	return nil
 }
 
+// estimatePublishingGas - adds to the execution gas the gas the batch executor charges upfront for publishing the
+// transaction on the L1, which is its L1 cost at the current head block converted at the L2 base fee.
+// The L1 cost is priced on the encoding of the signed transaction, which depends on its gas limit, so it is priced again
+// until the gas limit it results in no longer grows the encoding.
+func (rpc *EncryptionManager) estimatePublishingGas(args *gethapi.TransactionArgs, executionGas uint64) (*common.GasEstimateBreakdown, error) {
+	block, err := rpc.l1BlockProcessor.GetHead()
+	if err != nil {
+		return nil, err
+	}
+	batch, err := rpc.storage.FetchBatchBySeqNo(rpc.registry.HeadBatchSeq().Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	// the transaction is priced as the user would sign it, with the next nonce of the account and the base fee as
+	// the gas price if none is set
+	msg := *args
+	if msg.Nonce == nil {
+		stateDB, err := rpc.storage.CreateStateDB(batch.Hash())
+		if err != nil {
+			return nil, err
+		}
+		nonce := hexutil.Uint64(stateDB.GetNonce(*msg.From))
+		msg.Nonce = &nonce
+	}
+	if msg.GasPrice == nil && msg.MaxFeePerGas == nil {
+		msg.GasPrice = (*hexutil.Big)(batch.Header.BaseFee)
+	}
+	chainID := big.NewInt(rpc.config.ObscuroChainID)
+
+	breakdown := &common.GasEstimateBreakdown{
+		ExecutionGas: hexutil.Uint64(executionGas),
+		BaseFee:      (*hexutil.Big)(batch.Header.BaseFee),
+	}
+	for {
+		gasLimit := hexutil.Uint64(executionGas) + breakdown.PublishingGas
+		msg.Gas = &gasLimit
+		tx, err := gas.SignedTxForMsg(&msg, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the transaction to price - %w", err)
+		}
+		encodedTx, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			return nil, err
+		}
+		if breakdown.L1DataSize == hexutil.Uint64(len(encodedTx)) {
+			breakdown.GasLimit = gasLimit
+			return breakdown, nil
+		}
+
+		l1Cost, err := rpc.gasOracle.EstimateL1StorageGasCost(tx, block)
+		if err != nil {
+			return nil, err
+		}
+		breakdown.L1Cost = (*hexutil.Big)(l1Cost)
+		breakdown.L1DataSize = hexutil.Uint64(len(encodedTx))
+		breakdown.PublishingGas = hexutil.Uint64(publishingGas(l1Cost, batch.Header.BaseFee))
+	}
+}
+
+// publishingGas - converts the L1 cost into L2 gas the way the batch executor does, with the margin on top
+func publishingGas(l1Cost *big.Int, baseFee *big.Int) uint64 {
+	if l1Cost.Sign() == 0 || baseFee == nil || baseFee.Sign() == 0 {
+		return 0
+	}
+	// The one additional gas captures the modulo leftover of the division.
+	publishingGas := big.NewInt(0).Div(l1Cost, baseFee)
+	publishingGas.Add(publishingGas, gethcommon.Big1)
+	publishingGas.Mul(publishingGas, big.NewInt(100+publishingGasMarginPercent))
+	return publishingGas.Div(publishingGas, big.NewInt(100)).Uint64()
+}
+
 // DoEstimateGas returns the estimation of minimum gas required to execute transaction
 // This is a copy of https://github.com/ethereum/go-ethereum/blob/master/internal/ethapi/api.go#L1055
 // there's a high complexity to the method due to geth business rules (which is mimic'd here)
@@ -192,6 +264,46 @@ func (rpc *EncryptionManager) doEstimateGas(args *gethapi.TransactionArgs, blkNu
 	}
 	cap = hi //nolint: revive
 
+	// Execute at the highest allowance first. If the transaction fails there it fails with any gas limit, otherwise
+	// the gas it used is a lower bound of the gas limit it needs.
+	args.Gas = (*hexutil.Uint64)(&hi)
+	result, err := rpc.chain.ObsCallAtBlock(args, blkNumber)
+	if err != nil {
+		if errors.Is(err, gethcore.ErrIntrinsicGas) {
+			return 0, fmt.Errorf("gas required exceeds allowance (%d)", cap)
+		}
+		// reverts are returned as EVM errors carrying the revert reason
+		return 0, err
+	}
+	if result.Failed() {
+		if result.Err != vm.ErrOutOfGas { //nolint: errorlint
+			if len(result.Revert()) > 0 {
+				return 0, newRevertError(result)
+			}
+			return 0, result.Err
+		}
+		// Otherwise, the specified gas cap is too low
+		return 0, fmt.Errorf("gas required exceeds allowance (%d)", cap)
+	}
+	if result.UsedGas-1 > lo {
+		lo = result.UsedGas - 1
+	}
+
+	// Most transactions need little more gas than they use, the difference coming from the refunds and from the 1/64
+	// of the gas retained by each nested call. Try a limit just above the gas used before searching the whole range.
+	optimisticGasLimit := (result.UsedGas + params.CallStipend) * 64 / 63
+	if optimisticGasLimit < hi {
+		enough, err := rpc.isGasEnough(args, optimisticGasLimit, blkNumber)
+		if err != nil {
+			return 0, err
+		}
+		if enough {
+			hi = optimisticGasLimit
+		} else {
+			lo = optimisticGasLimit
+		}
+	}
+
 	// Execute the binary search and hone in on an isGasEnough gas limit
 	for lo+1 < hi {
 		mid := (hi + lo) / 2
@@ -201,52 +313,37 @@ func (rpc *EncryptionManager) doEstimateGas(args *gethapi.TransactionArgs, blkNu
 			// range here is skewed to favor the low side.
 			mid = lo * 2
 		}
-		failed, _, err := rpc.isGasEnough(args, mid, blkNumber)
+		enough, err := rpc.isGasEnough(args, mid, blkNumber)
 		// If the error is not nil(consensus error), it means the provided message
 		// call or transaction will never be accepted no matter how much gas it is
 		// assigned. Return the error directly, don't struggle any more.
 		if err != nil {
 			return 0, err
 		}
-		if failed {
-			lo = mid
-		} else {
+		if enough {
 			hi = mid
-		}
-	}
-	// Reject the transaction as invalid if it still fails at the highest allowance
-	if hi == cap { //nolint:nestif
-		failed, result, err := rpc.isGasEnough(args, hi, blkNumber)
-		if err != nil {
-			return 0, err
-		}
-		if failed {
-			if result != nil && result.Err != vm.ErrOutOfGas { //nolint: errorlint
-				if len(result.Revert()) > 0 {
-					return 0, newRevertError(result)
-				}
-				return 0, result.Err
-			}
-			// Otherwise, the specified gas cap is too low
-			return 0, fmt.Errorf("gas required exceeds allowance (%d)", cap)
+		} else {
+			lo = mid
 		}
 	}
 	return hexutil.Uint64(hi), nil
 }
 
-// Create a helper to check if a gas allowance results in an executable transaction
-// isGasEnough returns whether the gaslimit should be raised, lowered, or if it was impossible to execute the message
-func (rpc *EncryptionManager) isGasEnough(args *gethapi.TransactionArgs, gas uint64, blkNumber *gethrpc.BlockNumber) (bool, *gethcore.ExecutionResult, error) {
+// isGasEnough returns whether the message executes successfully with the gas limit, or an error if it can't be
+// executed whatever the gas limit. A message that reverts or runs out of gas might succeed with a higher gas limit,
+// as the contracts it calls can revert when they are left with too little gas.
+func (rpc *EncryptionManager) isGasEnough(args *gethapi.TransactionArgs, gas uint64, blkNumber *gethrpc.BlockNumber) (bool, error) {
 	defer core.LogMethodDuration(rpc.logger, measure.NewStopwatch(), "enclave.go:IsGasEnough")
 	args.Gas = (*hexutil.Uint64)(&gas)
 	result, err := rpc.chain.ObsCallAtBlock(args, blkNumber)
 	if err != nil {
-		if errors.Is(err, gethcore.ErrIntrinsicGas) {
-			return true, nil, nil // Special case, raise gas limit
+		var evmErr *errutil.EVMSerialisableError
+		if errors.Is(err, gethcore.ErrIntrinsicGas) || (errors.As(err, &evmErr) && evmErr.ErrorCode() == errutil.RevertErrorCode) {
+			return false, nil // raise the gas limit
 		}
-		return true, nil, err // Bail out
+		return false, err // Bail out
 	}
-	return result.Failed(), result, nil
+	return !result.Failed(), nil
 }
 
 func newRevertError(result *gethcore.ExecutionResult) *revertError {
@@ -271,7 +368,7 @@ type revertError struct {
 // ErrorCode returns the JSON error code for a revertal.
 // See: https://github.com/ethereum/wiki/wiki/JSON-RPC-Error-Codes-Improvement-Proposal
 func (e *revertError) ErrorCode() int {
-	return 3
+	return errutil.RevertErrorCode
 }
 
 // ErrorData returns the hex encoded revert reason.
@@ -29,6 +29,7 @@ func SubmitTxExecute(builder *CallBuilder[common.L2Tx, gethcommon.Hash], rpc *En
 		builder.Err = err
 		return nil
 	}
+	rpc.subscriptionManager.SendPendingTx(builder.Param)
 	h := builder.Param.Hash()
 	builder.ReturnValue = &h
 	return nil
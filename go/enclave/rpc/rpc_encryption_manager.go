@@ -9,6 +9,8 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/debugger"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
 	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
 	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
@@ -27,11 +29,13 @@ type EncryptionManager struct {
 	gasOracle              gas.Oracle
 	blockResolver          storage.BlockResolver
 	l1BlockProcessor       components.L1BlockProcessor
+	debugger               *debugger.Debugger
+	subscriptionManager    *events.SubscriptionManager
 	config                 *config.EnclaveConfig
 	logger                 gethlog.Logger
 }
 
-func NewEncryptionManager(enclavePrivateKeyECIES *ecies.PrivateKey, storage storage.Storage, registry components.BatchRegistry, processors *crosschain.Processors, service nodetype.NodeType, config *config.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, l1BlockProcessor components.L1BlockProcessor, chain l2chain.ObscuroChain, logger gethlog.Logger) *EncryptionManager {
+func NewEncryptionManager(enclavePrivateKeyECIES *ecies.PrivateKey, storage storage.Storage, registry components.BatchRegistry, processors *crosschain.Processors, service nodetype.NodeType, config *config.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, l1BlockProcessor components.L1BlockProcessor, chain l2chain.ObscuroChain, debugger *debugger.Debugger, subscriptionManager *events.SubscriptionManager, logger gethlog.Logger) *EncryptionManager {
 	return &EncryptionManager{
 		storage:                storage,
 		registry:               registry,
@@ -41,6 +45,8 @@ func NewEncryptionManager(enclavePrivateKeyECIES *ecies.PrivateKey, storage stor
 		config:                 config,
 		blockResolver:          blockResolver,
 		l1BlockProcessor:       l1BlockProcessor,
+		debugger:               debugger,
+		subscriptionManager:    subscriptionManager,
 		gasOracle:              oracle,
 		logger:                 logger,
 		enclavePrivateKeyECIES: enclavePrivateKeyECIES,
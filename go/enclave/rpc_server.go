@@ -15,7 +15,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
 	"google.golang.org/grpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -127,7 +127,12 @@ func (s *RPCServer) SubmitL1Block(_ context.Context, request *generated.SubmitBl
 		s.logger.Error("Error decoding receipts", log.ErrKey, err)
 		return nil, err
 	}
-	blockSubmissionResponse, err := s.enclave.SubmitL1Block(bl, receipts, request.IsLatest)
+	blobs, err := s.decodeBlobs(request.EncodedBlobs)
+	if err != nil {
+		s.logger.Error("Error decoding blobs", log.ErrKey, err)
+		return nil, err
+	}
+	blockSubmissionResponse, err := s.enclave.SubmitL1Block(bl, receipts, blobs, request.IsLatest)
 	if err != nil {
 		var rejErr *errutil.BlockRejectError
 		isReject := errors.As(err, &rejErr)
@@ -258,6 +263,15 @@ func (s *RPCServer) EstimateGas(_ context.Context, req *generated.EstimateGasReq
 	return &generated.EstimateGasResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
+func (s *RPCServer) EstimateGasBreakdown(_ context.Context, req *generated.EstimateGasBreakdownRequest) (*generated.EstimateGasBreakdownResponse, error) {
+	enclaveResp, sysError := s.enclave.EstimateGasBreakdown(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error estimating gas breakdown", log.ErrKey, sysError)
+		return &generated.EstimateGasBreakdownResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.EstimateGasBreakdownResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
 func (s *RPCServer) GetLogs(_ context.Context, req *generated.GetLogsRequest) (*generated.GetLogsResponse, error) {
 	enclaveResp, sysError := s.enclave.GetLogs(req.EncryptedParams)
 	if sysError != nil {
@@ -303,20 +317,30 @@ func (s *RPCServer) CreateBatch(_ context.Context, r *generated.CreateBatchReque
 }
 
 func (s *RPCServer) DebugTraceTransaction(_ context.Context, req *generated.DebugTraceTransactionRequest) (*generated.DebugTraceTransactionResponse, error) {
-	txHash := gethcommon.BytesToHash(req.TxHash)
-	var config tracers.TraceConfig
-
-	sysError := json.Unmarshal(req.Config, &config)
+	enclaveResp, sysError := s.enclave.DebugTraceTransaction(req.EncryptedParams)
 	if sysError != nil {
-		s.logger.Error("Error calling debug tx", log.ErrKey, sysError)
+		s.logger.Error("Error tracing transaction", log.ErrKey, sysError)
+		return &generated.DebugTraceTransactionResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.DebugTraceTransactionResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
 
-		return &generated.DebugTraceTransactionResponse{
-			SystemError: toRPCError(fmt.Errorf("unable to unmarshall config - %w", sysError)),
-		}, nil
+func (s *RPCServer) DebugTraceCall(_ context.Context, req *generated.DebugTraceCallRequest) (*generated.DebugTraceCallResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugTraceCall(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error tracing call", log.ErrKey, sysError)
+		return &generated.DebugTraceCallResponse{SystemError: toRPCError(sysError)}, nil
 	}
+	return &generated.DebugTraceCallResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
 
-	traceTx, sysError := s.enclave.DebugTraceTransaction(txHash, &config)
-	return &generated.DebugTraceTransactionResponse{Msg: string(traceTx), SystemError: toRPCError(sysError)}, nil
+func (s *RPCServer) DebugTraceBatch(_ context.Context, req *generated.DebugTraceBatchRequest) (*generated.DebugTraceBatchResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugTraceBatch(req.EncryptedParams, common.BatchIdentifier(req.BatchIdentifier))
+	if sysError != nil {
+		s.logger.Error("Error tracing batch", log.ErrKey, sysError)
+		return &generated.DebugTraceBatchResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.DebugTraceBatchResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
 func (s *RPCServer) GetBatch(_ context.Context, request *generated.GetBatchRequest) (*generated.GetBatchResponse, error) {
@@ -462,13 +486,76 @@ func (s *RPCServer) GetPublicTransactionData(_ context.Context, req *generated.G
 	return &generated.GetPublicTransactionDataResponse{PublicTransactionData: marshal}, nil
 }
 
+func (s *RPCServer) GetBatchRewardPercentiles(_ context.Context, req *generated.GetBatchRewardPercentilesRequest) (*generated.GetBatchRewardPercentilesResponse, error) {
+	batchHashes := make([]common.L2BatchHash, len(req.BatchHashes))
+	for i, batchHash := range req.BatchHashes {
+		batchHashes[i] = gethcommon.BytesToHash(batchHash)
+	}
+	rewards, sysError := s.enclave.GetBatchRewardPercentiles(batchHashes, req.Percentiles)
+	if sysError != nil {
+		s.logger.Error("Error getting batch reward percentiles", log.ErrKey, sysError)
+		return &generated.GetBatchRewardPercentilesResponse{SystemError: toRPCError(sysError)}, nil
+	}
+
+	marshal, err := json.Marshal(rewards)
+	if err != nil {
+		s.logger.Error("Error marshalling batch reward percentiles", log.ErrKey, err)
+		return &generated.GetBatchRewardPercentilesResponse{SystemError: toRPCError(err)}, nil
+	}
+
+	return &generated.GetBatchRewardPercentilesResponse{Rewards: marshal}, nil
+}
+
+func (s *RPCServer) GetCrossChainProof(_ context.Context, req *generated.GetCrossChainProofRequest) (*generated.GetCrossChainProofResponse, error) {
+	proof, sysError := s.enclave.GetCrossChainProof(gethcommon.BytesToHash(req.BatchHash), req.Index)
+	if sysError != nil {
+		s.logger.Debug("Error getting cross chain proof", log.ErrKey, sysError)
+		if !errors.Is(sysError, &syserr.InternalError{}) {
+			return &generated.GetCrossChainProofResponse{Error: sysError.Error()}, nil
+		}
+		return &generated.GetCrossChainProofResponse{SystemError: toRPCError(sysError)}, nil
+	}
+
+	marshal, err := json.Marshal(proof)
+	if err != nil {
+		s.logger.Error("Error marshalling cross chain proof", log.ErrKey, err)
+		return &generated.GetCrossChainProofResponse{SystemError: toRPCError(err)}, nil
+	}
+
+	return &generated.GetCrossChainProofResponse{Proof: marshal}, nil
+}
+
+func (s *RPCServer) GetCrossChainMessageStatus(_ context.Context, req *generated.GetCrossChainMessageStatusRequest) (*generated.GetCrossChainMessageStatusResponse, error) {
+	var query common.CrossChainMessageQuery
+	if err := json.Unmarshal(req.Query, &query); err != nil {
+		return &generated.GetCrossChainMessageStatusResponse{SystemError: toRPCError(err)}, nil
+	}
+
+	statuses, sysError := s.enclave.GetCrossChainMessageStatus(&query)
+	if sysError != nil {
+		s.logger.Debug("Error getting cross chain message status", log.ErrKey, sysError)
+		return &generated.GetCrossChainMessageStatusResponse{SystemError: toRPCError(sysError)}, nil
+	}
+
+	marshal, err := json.Marshal(statuses)
+	if err != nil {
+		s.logger.Error("Error marshalling cross chain message status", log.ErrKey, err)
+		return &generated.GetCrossChainMessageStatusResponse{SystemError: toRPCError(err)}, nil
+	}
+
+	return &generated.GetCrossChainMessageStatusResponse{Statuses: marshal}, nil
+}
+
 func (s *RPCServer) EnclavePublicConfig(_ context.Context, _ *generated.EnclavePublicConfigRequest) (*generated.EnclavePublicConfigResponse, error) {
 	enclaveCfg, sysError := s.enclave.EnclavePublicConfig()
 	if sysError != nil {
 		s.logger.Error("Error getting message bus address", log.ErrKey, sysError)
 		return &generated.EnclavePublicConfigResponse{SystemError: toRPCError(sysError)}, nil
 	}
-	return &generated.EnclavePublicConfigResponse{L2MessageBusAddress: enclaveCfg.L2MessageBusAddress.Bytes()}, nil
+	return &generated.EnclavePublicConfigResponse{
+		L2MessageBusAddress: enclaveCfg.L2MessageBusAddress.Bytes(),
+		SequencerPublicKeys: enclaveCfg.SequencerPublicKeys,
+	}, nil
 }
 
 func (s *RPCServer) decodeBlock(encodedBlock []byte) (types.Block, error) {
@@ -492,6 +579,20 @@ func (s *RPCServer) decodeReceipts(encodedReceipts []byte) (types.Receipts, erro
 	return receipts, nil
 }
 
+func (s *RPCServer) decodeBlobs(encodedBlobs []byte) (common.L1Blobs, error) {
+	blobs := make(common.L1Blobs, 0)
+	if len(encodedBlobs) == 0 {
+		return blobs, nil
+	}
+
+	err := rlp.DecodeBytes(encodedBlobs, &blobs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode blobs. Cause: %w", err)
+	}
+
+	return blobs, nil
+}
+
 func toRPCError(err common.SystemError) *generated.SystemError {
 	if err == nil {
 		return nil
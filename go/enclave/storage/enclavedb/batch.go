@@ -182,6 +182,13 @@ func ReadCurrentHeadBatch(db *sql.DB) (*core.Batch, error) {
 	return fetchBatch(db, " where b.is_canonical=true and b.is_executed=true and b.height=(select max(b1.height) from batch b1 where b1.is_canonical=true and b1.is_executed=true)")
 }
 
+// ReadRelayingBatchHeader returns the first canonical executed batch with an L1 proof at or above the height, which is the
+// batch that consumed the cross chain messages published in the canonical block at that height
+func ReadRelayingBatchHeader(db *sql.DB, blockHeight uint64) (*common.BatchHeader, error) {
+	query := " join block bl on b.l1_proof=bl.hash where b.is_canonical=true and b.is_executed=true and bl.is_canonical=true and bl.height>=? order by b.sequence limit 1"
+	return fetchBatchHeader(db, query, blockHeight)
+}
+
 func ReadBatchesByBlock(db *sql.DB, hash common.L1BlockHash) ([]*core.Batch, error) {
 	return fetchBatches(db, " where b.l1_proof=? order by b.sequence", truncTo16(hash))
 }
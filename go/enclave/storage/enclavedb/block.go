@@ -22,9 +22,10 @@ const (
 	l1msgValue  = "(?,?,?)"
 	selectL1Msg = "select message from l1_msg "
 
-	rollupInsert         = "replace into rollup values (?,?,?,?,?,?)"
+	rollupInsert         = "replace into rollup values (?,?,?,?,?,?,?)"
 	rollupSelect         = "select hash from rollup where compression_block in "
 	rollupSelectMetadata = "select start_seq, time_stamp from rollup where hash = ? "
+	rollupSelectForBatch = "select r.header, bl.header from rollup r join block bl on r.l1_block=bl.hash where bl.is_canonical=true and r.start_seq<=? and r.end_seq>=?"
 
 	updateCanonicalBlock = "update block set is_canonical=? where hash in "
 	// todo - do we need the is_canonical field?
@@ -142,7 +143,7 @@ func FetchL1Messages[T any](db *sql.DB, blockHash common.L1BlockHash, isTransfer
 	return result, nil
 }
 
-func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader *common.CalldataRollupHeader) error {
+func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader *common.CalldataRollupHeader, blockHash common.L1BlockHash) error {
 	// Write the encoded header
 	data, err := rlp.EncodeToBytes(rollup)
 	if err != nil {
@@ -155,6 +156,7 @@ func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader
 		internalHeader.StartTime,
 		data,
 		truncTo16(rollup.CompressionL1Head),
+		truncTo16(blockHash),
 	)
 	return nil
 }
@@ -198,6 +200,28 @@ func FetchRollupMetadata(db *sql.DB, hash common.L2RollupHash) (*common.PublicRo
 	return rollup, nil
 }
 
+// FetchRollupForBatch returns the header of the rollup published in a canonical block that contains the batch with the
+// sequence number, and the header of that block
+func FetchRollupForBatch(db *sql.DB, seqNo uint64) (*common.RollupHeader, *types.Header, error) {
+	var rollupHeader, blockHeader []byte
+	err := db.QueryRow(rollupSelectForBatch, seqNo, seqNo).Scan(&rollupHeader, &blockHeader)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errutil.ErrNotFound
+		}
+		return nil, nil, err
+	}
+	rollup := new(common.RollupHeader)
+	if err := rlp.Decode(bytes.NewReader(rollupHeader), rollup); err != nil {
+		return nil, nil, fmt.Errorf("could not decode rollup header. Cause: %w", err)
+	}
+	block := new(types.Header)
+	if err := rlp.Decode(bytes.NewReader(blockHeader), block); err != nil {
+		return nil, nil, fmt.Errorf("could not decode l1 block header. Cause: %w", err)
+	}
+	return rollup, block, nil
+}
+
 func fetchBlockHeader(db *sql.DB, whereQuery string, args ...any) (*types.Header, error) {
 	var header string
 	query := selectBlockHeader + " " + whereQuery
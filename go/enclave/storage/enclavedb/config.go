@@ -47,8 +47,29 @@ func WriteAttKey(db *sql.DB, party common.Address, key []byte) (sql.Result, erro
 	return db.Exec(attInsert, party.Bytes(), key)
 }
 
-func FetchAttKey(db *sql.DB, party common.Address) ([]byte, error) {
-	return readSingleRow(db, attSelect, party.Bytes())
+// FetchAttKeys returns the keys of all the enclaves attested under the party
+func FetchAttKeys(db *sql.DB, party common.Address) ([][]byte, error) {
+	rows, err := db.Query(attSelect, party.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys [][]byte
+	for rows.Next() {
+		var key []byte
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return keys, nil
 }
 
 func readSingleRow(db *sql.DB, query string, v any) ([]byte, error) {
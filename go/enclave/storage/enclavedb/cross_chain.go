@@ -0,0 +1,138 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	crossChainMsgInsert = "insert into cross_chain_msg (sender, sequence, is_outbound, message, l1_tx, block, batch) values "
+	crossChainMsgValue  = "(?,?,?,?,?,?,?)"
+
+	// only the messages published in canonical blocks or batches are returned
+	selectCrossChainMsg = "select ccm.message, ccm.is_outbound, ccm.l1_tx, bl.header, b.full_hash, b.sequence from cross_chain_msg ccm " +
+		"left join block bl on bl.hash=ccm.block left join batch b on b.sequence=ccm.batch " +
+		"where (ccm.block is null or bl.is_canonical=true) and (ccm.batch is null or b.is_canonical=true) "
+)
+
+// WriteInboundCrossChainMessages indexes the messages published on the L1 in the block by the transactions with the hashes
+func WriteInboundCrossChainMessages(db *sql.DB, blockHash common.L1BlockHash, messages common.CrossChainMessages, txHashes []gethcommon.Hash) error {
+	if len(messages) != len(txHashes) {
+		return fmt.Errorf("found %d transaction hashes for %d cross chain messages", len(txHashes), len(messages))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	insert, args, err := crossChainMsgRows(messages, false, func(i int) []any {
+		return []any{txHashes[i].Bytes(), truncTo16(blockHash), nil}
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(insert, args...)
+	return err
+}
+
+// WriteOutboundCrossChainMessages indexes the messages published on the L2 in the batch with the sequence number
+func WriteOutboundCrossChainMessages(dbtx DBTransaction, batchSeqNo uint64, messages common.CrossChainMessages) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	insert, args, err := crossChainMsgRows(messages, true, func(_ int) []any {
+		return []any{nil, nil, batchSeqNo}
+	})
+	if err != nil {
+		return err
+	}
+	dbtx.ExecuteSQL(insert, args...)
+	return nil
+}
+
+// FetchCrossChainMessages returns the indexed messages matching the query, with the stage in which they were published.
+// A transaction hash only matches messages published on the L1, because the index doesn't know the L2 transactions.
+func FetchCrossChainMessages(db *sql.DB, query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error) {
+	where := ""
+	args := make([]any, 0)
+	if query.TxHash != nil {
+		where += " and ccm.l1_tx=?"
+		args = append(args, query.TxHash.Bytes())
+	}
+	if query.Sender != nil {
+		where += " and ccm.sender=?"
+		args = append(args, query.Sender.Bytes())
+	}
+	if query.Sequence != nil {
+		where += " and ccm.sequence=?"
+		args = append(args, *query.Sequence)
+	}
+
+	rows, err := db.Query(selectCrossChainMsg+where+" order by ccm.id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*common.CrossChainMessageStatus, 0)
+	for rows.Next() {
+		var message, l1Tx, blockHeader, batchHash []byte
+		var isOutbound bool
+		var batchSeq sql.NullInt64
+		if err := rows.Scan(&message, &isOutbound, &l1Tx, &blockHeader, &batchHash, &batchSeq); err != nil {
+			return nil, err
+		}
+		status := &common.CrossChainMessageStatus{Direction: common.CrossChainL1ToL2, Stage: common.CrossChainPublished}
+		if err := rlp.DecodeBytes(message, &status.Message); err != nil {
+			return nil, fmt.Errorf("could not decode cross chain message. Cause: %w", err)
+		}
+
+		published := &common.CrossChainMessageStage{Stage: common.CrossChainPublished}
+		if isOutbound {
+			status.Direction = common.CrossChainL2ToL1
+			hash := gethcommon.BytesToHash(batchHash)
+			seqNo := uint64(batchSeq.Int64)
+			published.BatchHash = &hash
+			published.BatchSeqNo = &seqNo
+		} else {
+			header := new(types.Header)
+			if err := rlp.DecodeBytes(blockHeader, header); err != nil {
+				return nil, fmt.Errorf("could not decode l1 block header. Cause: %w", err)
+			}
+			blockHash := header.Hash()
+			blockNumber := header.Number.Uint64()
+			txHash := gethcommon.BytesToHash(l1Tx)
+			published.L1BlockHash = &blockHash
+			published.L1BlockNumber = &blockNumber
+			published.L1TxHash = &txHash
+		}
+		status.Stages = []*common.CrossChainMessageStage{published}
+		result = append(result, status)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// crossChainMsgRows returns the insert of the messages, where the location returns the l1_tx, block and batch values of
+// the message at the index
+func crossChainMsgRows(messages common.CrossChainMessages, isOutbound bool, location func(int) []any) (string, []any, error) {
+	insert := crossChainMsgInsert + strings.Repeat(crossChainMsgValue+",", len(messages))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for i, msg := range messages {
+		data, err := rlp.EncodeToBytes(msg)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not encode cross chain message. Cause: %w", err)
+		}
+		args = append(args, msg.Sender.Bytes(), msg.Sequence, isOutbound, data)
+		args = append(args, location(i)...)
+	}
+	return insert, args, nil
+}
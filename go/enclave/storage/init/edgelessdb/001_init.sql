@@ -58,11 +58,29 @@ create table if not exists obsdb.rollup
     time_stamp        int        NOT NULL,
     header            blob       NOT NULL,
     compression_block binary(16) NOT NULL,
+    l1_block          binary(16) NOT NULL,
     INDEX (compression_block),
     primary key (hash)
 );
 GRANT ALL ON obsdb.rollup TO obscuro;
 
+create table if not exists obsdb.cross_chain_msg
+(
+    id          INTEGER AUTO_INCREMENT,
+    sender      binary(20) NOT NULL,
+    sequence    int        NOT NULL,
+    is_outbound boolean    NOT NULL,
+    message     mediumblob NOT NULL,
+    l1_tx       binary(32),
+    block       binary(16),
+    batch       int,
+    INDEX (sender, sequence),
+    INDEX (l1_tx),
+    INDEX (batch),
+    primary key (id)
+);
+GRANT ALL ON obsdb.cross_chain_msg TO obscuro;
+
 create table if not exists obsdb.batch_body
 (
     id      int        NOT NULL,
@@ -47,9 +47,26 @@ create table if not exists rollup
     end_seq           int        NOT NULL,
     time_stamp        int        NOT NULL,
     header            blob       NOT NULL,
-    compression_block binary(16) NOT NULL REFERENCES block
+    compression_block binary(16) NOT NULL REFERENCES block,
+    l1_block          binary(16) NOT NULL REFERENCES block -- the block that published the rollup
 );
 
+-- the index of the cross chain messages published on either layer, used to look up their status
+create table if not exists cross_chain_msg
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    sender      binary(20) NOT NULL,
+    sequence    int        NOT NULL,
+    is_outbound boolean    NOT NULL,
+    message     mediumblob NOT NULL,
+    l1_tx       binary(32), -- the L1 transaction that published an inbound message
+    block       binary(16) REFERENCES block, -- the L1 block that published an inbound message
+    batch       int -- the sequence of the batch that published an outbound message
+);
+create index IDX_CCM_SENDER on cross_chain_msg (sender, sequence);
+create index IDX_CCM_L1_TX on cross_chain_msg (l1_tx);
+create index IDX_CCM_BATCH on cross_chain_msg (batch);
+
 create table if not exists batch_body
 (
     id      int        NOT NULL primary key,
@@ -68,10 +68,14 @@ type BatchResolver interface {
 	// StoreExecutedBatch - store the batch after it was executed
 	StoreExecutedBatch(batch *core.Batch, receipts []*types.Receipt) error
 
-	// StoreRollup
-	StoreRollup(rollup *common.ExtRollup, header *common.CalldataRollupHeader) error
+	// StoreRollup - stores the rollup published in the L1 block
+	StoreRollup(rollup *common.ExtRollup, header *common.CalldataRollupHeader, blockHash common.L1BlockHash) error
 	FetchRollupMetadata(hash common.L2RollupHash) (*common.PublicRollupMetadata, error)
 	FetchReorgedRollup(reorgedBlocks []common.L1BlockHash) (*common.L2BatchHash, error)
+	// FetchRollupForBatch - returns the rollup published on the canonical chain that contains the batch, and the L1 block it was published in
+	FetchRollupForBatch(seqNo uint64) (*common.RollupHeader, *types.Header, error)
+	// FetchRelayingBatch - returns the first canonical executed batch that consumed the cross chain messages of the L1 blocks up to the height
+	FetchRelayingBatch(blockHeight uint64) (*common.BatchHeader, error)
 }
 
 type GethStateDB interface {
@@ -100,18 +104,23 @@ type TransactionStorage interface {
 }
 
 type AttestationStorage interface {
-	// FetchAttestedKey returns the public key of an attested aggregator
-	FetchAttestedKey(aggregator gethcommon.Address) (*ecdsa.PublicKey, error)
-	// StoreAttestedKey - store the public key of an attested aggregator
+	// FetchAttestedKeys returns the public keys of the enclaves attested for an aggregator (a host can have several)
+	FetchAttestedKeys(aggregator gethcommon.Address) ([]*ecdsa.PublicKey, error)
+	// StoreAttestedKey - store the public key of an enclave attested for an aggregator
 	StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa.PublicKey) error
 }
 
 type CrossChainMessagesStorage interface {
-	StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages) error
+	// StoreL1Messages - stores the messages published in the L1 block, together with the hashes of the L1 transactions that published them
+	StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages, txHashes []gethcommon.Hash) error
 	GetL1Messages(blockHash common.L1BlockHash) (common.CrossChainMessages, error)
 
 	StoreValueTransfers(blockHash common.L1BlockHash, transfers common.ValueTransferEvents) error
 	GetL1Transfers(blockHash common.L1BlockHash) (common.ValueTransferEvents, error)
+
+	// FetchCrossChainMessages - returns the messages published on the canonical chains of either layer that match the query,
+	// with the stage in which they were published
+	FetchCrossChainMessages(query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error)
 }
 
 type EnclaveKeyStorage interface {
@@ -119,6 +128,13 @@ type EnclaveKeyStorage interface {
 	GetEnclaveKey() (*crypto.EnclaveKey, error)
 }
 
+type GasOracleStorage interface {
+	// StoreGasOracleState - stores the encoded state of the gas oracle after processing the L1 block
+	StoreGasOracleState(blockHash common.L1BlockHash, state []byte) error
+	// FetchGasOracleState - returns the encoded state of the gas oracle after processing the L1 block
+	FetchGasOracleState(blockHash common.L1BlockHash) ([]byte, error)
+}
+
 // Storage is the enclave's interface for interacting with the enclave's datastore
 type Storage interface {
 	BlockResolver
@@ -129,6 +145,7 @@ type Storage interface {
 	AttestationStorage
 	CrossChainMessagesStorage
 	EnclaveKeyStorage
+	GasOracleStorage
 	ScanStorage
 	io.Closer
 
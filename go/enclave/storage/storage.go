@@ -24,6 +24,7 @@ import (
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/trie"
 
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
 
 	"github.com/ethereum/go-ethereum/core/state"
@@ -361,24 +362,36 @@ func (s *storageImpl) GetTransactionReceipt(txHash gethcommon.Hash) (*types.Rece
 	return enclavedb.ReadReceipt(s.db.GetSQLDB(), txHash, s.chainConfig)
 }
 
-func (s *storageImpl) FetchAttestedKey(address gethcommon.Address) (*ecdsa.PublicKey, error) {
-	defer s.logDuration("FetchAttestedKey", measure.NewStopwatch())
-	key, err := enclavedb.FetchAttKey(s.db.GetSQLDB(), address)
+func (s *storageImpl) FetchAttestedKeys(address gethcommon.Address) ([]*ecdsa.PublicKey, error) {
+	defer s.logDuration("FetchAttestedKeys", measure.NewStopwatch())
+	keys, err := enclavedb.FetchAttKeys(s.db.GetSQLDB(), address)
 	if err != nil {
-		return nil, fmt.Errorf("could not retrieve attestation key for address %s. Cause: %w", address, err)
+		return nil, fmt.Errorf("could not retrieve attestation keys for address %s. Cause: %w", address, err)
 	}
 
-	publicKey, err := gethcrypto.DecompressPubkey(key)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse key from db. Cause: %w", err)
+	publicKeys := make([]*ecdsa.PublicKey, len(keys))
+	for i, key := range keys {
+		publicKeys[i], err = gethcrypto.DecompressPubkey(key)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse key from db. Cause: %w", err)
+		}
 	}
-
-	return publicKey, nil
+	return publicKeys, nil
 }
 
 func (s *storageImpl) StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa.PublicKey) error {
 	defer s.logDuration("StoreAttestedKey", measure.NewStopwatch())
-	_, err := enclavedb.WriteAttKey(s.db.GetSQLDB(), aggregator, gethcrypto.CompressPubkey(key))
+	// the attestation may be processed again, e.g. after an L1 fork
+	keys, err := s.FetchAttestedKeys(aggregator)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return err
+	}
+	for _, attestedKey := range keys {
+		if attestedKey.Equal(key) {
+			return nil
+		}
+	}
+	_, err = enclavedb.WriteAttKey(s.db.GetSQLDB(), aggregator, gethcrypto.CompressPubkey(key))
 	return err
 }
 
@@ -448,6 +461,10 @@ func (s *storageImpl) StoreExecutedBatch(batch *core.Batch, receipts []*types.Re
 		return fmt.Errorf("could not write transaction receipts. Cause: %w", err)
 	}
 
+	if err := enclavedb.WriteOutboundCrossChainMessages(dbTx, batch.SeqNo().Uint64(), batch.Header.CrossChainMessages); err != nil {
+		return fmt.Errorf("could not index cross chain messages. Cause: %w", err)
+	}
+
 	if batch.Number().Int64() > 1 {
 		stateDB, err := s.CreateStateDB(batch.Header.ParentHash)
 		if err != nil {
@@ -471,9 +488,12 @@ func (s *storageImpl) StoreValueTransfers(blockHash common.L1BlockHash, transfer
 	return enclavedb.WriteL1Messages(s.db.GetSQLDB(), blockHash, transfers, true)
 }
 
-func (s *storageImpl) StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages) error {
+func (s *storageImpl) StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages, txHashes []gethcommon.Hash) error {
 	defer s.logDuration("StoreL1Messages", measure.NewStopwatch())
-	return enclavedb.WriteL1Messages(s.db.GetSQLDB(), blockHash, messages, false)
+	if err := enclavedb.WriteL1Messages(s.db.GetSQLDB(), blockHash, messages, false); err != nil {
+		return err
+	}
+	return enclavedb.WriteInboundCrossChainMessages(s.db.GetSQLDB(), blockHash, messages, txHashes)
 }
 
 func (s *storageImpl) GetL1Messages(blockHash common.L1BlockHash) (common.CrossChainMessages, error) {
@@ -485,6 +505,11 @@ func (s *storageImpl) GetL1Transfers(blockHash common.L1BlockHash) (common.Value
 	return enclavedb.FetchL1Messages[common.ValueTransferEvent](s.db.GetSQLDB(), blockHash, true)
 }
 
+func (s *storageImpl) FetchCrossChainMessages(query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error) {
+	defer s.logDuration("FetchCrossChainMessages", measure.NewStopwatch())
+	return enclavedb.FetchCrossChainMessages(s.db.GetSQLDB(), query)
+}
+
 const enclaveKeyKey = "ek"
 
 func (s *storageImpl) StoreEnclaveKey(enclaveKey *crypto.EnclaveKey) error {
@@ -511,11 +536,28 @@ func (s *storageImpl) GetEnclaveKey() (*crypto.EnclaveKey, error) {
 	return crypto.NewEnclaveKey(ecdsaKey), nil
 }
 
-func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader) error {
+// the state of the gas oracle is stored in the key-value table, under this prefix followed by the L1 block hash
+const gasOracleStatePrefix = "gasoracle"
+
+func gasOracleStateKey(blockHash common.L1BlockHash) []byte {
+	return append([]byte(gasOracleStatePrefix), blockHash.Bytes()...)
+}
+
+func (s *storageImpl) StoreGasOracleState(blockHash common.L1BlockHash, state []byte) error {
+	defer s.logDuration("StoreGasOracleState", measure.NewStopwatch())
+	return enclavedb.Put(s.db.GetSQLDB(), gasOracleStateKey(blockHash), state)
+}
+
+func (s *storageImpl) FetchGasOracleState(blockHash common.L1BlockHash) ([]byte, error) {
+	defer s.logDuration("FetchGasOracleState", measure.NewStopwatch())
+	return enclavedb.Get(s.db.GetSQLDB(), gasOracleStateKey(blockHash))
+}
+
+func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader, blockHash common.L1BlockHash) error {
 	defer s.logDuration("StoreRollup", measure.NewStopwatch())
 	dbBatch := s.db.NewDBTransaction()
 
-	if err := enclavedb.WriteRollup(dbBatch, rollup.Header, internalHeader); err != nil {
+	if err := enclavedb.WriteRollup(dbBatch, rollup.Header, internalHeader, blockHash); err != nil {
 		return fmt.Errorf("could not write rollup. Cause: %w", err)
 	}
 
@@ -533,6 +575,14 @@ func (s *storageImpl) FetchRollupMetadata(hash common.L2RollupHash) (*common.Pub
 	return enclavedb.FetchRollupMetadata(s.db.GetSQLDB(), hash)
 }
 
+func (s *storageImpl) FetchRollupForBatch(seqNo uint64) (*common.RollupHeader, *types.Header, error) {
+	return enclavedb.FetchRollupForBatch(s.db.GetSQLDB(), seqNo)
+}
+
+func (s *storageImpl) FetchRelayingBatch(blockHeight uint64) (*common.BatchHeader, error) {
+	return enclavedb.ReadRelayingBatchHeader(s.db.GetSQLDB(), blockHeight)
+}
+
 func (s *storageImpl) DebugGetLogs(txHash common.TxHash) ([]*tracers.DebugLogs, error) {
 	defer s.logDuration("DebugGetLogs", measure.NewStopwatch())
 	return enclavedb.DebugGetLogs(s.db.GetSQLDB(), txHash)
@@ -0,0 +1,150 @@
+package ethadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	beaconGenesisPath      = "/eth/v1/beacon/genesis"
+	beaconSpecPath         = "/eth/v1/config/spec"
+	beaconBlobSidecarsPath = "/eth/v1/beacon/blob_sidecars/%d"
+)
+
+// beaconClient fetches blob sidecars from the beacon node API, since the execution layer does not serve them
+type beaconClient struct {
+	url     string
+	timeout time.Duration
+	client  *http.Client
+
+	// the genesis time and slot duration are required to find the slot of an execution layer block
+	genesisTime    uint64
+	secondsPerSlot uint64
+	initLock       sync.Mutex
+}
+
+type beaconGenesisResponse struct {
+	Data struct {
+		GenesisTime string `json:"genesis_time"` //nolint:tagliatelle
+	} `json:"data"`
+}
+
+type beaconSpecResponse struct {
+	Data struct {
+		SecondsPerSlot string `json:"SECONDS_PER_SLOT"` //nolint:tagliatelle
+	} `json:"data"`
+}
+
+type beaconBlobSidecarsResponse struct {
+	Data []struct {
+		Blob          hexutil.Bytes `json:"blob"`
+		KZGCommitment hexutil.Bytes `json:"kzg_commitment"` //nolint:tagliatelle
+	} `json:"data"`
+}
+
+func newBeaconClient(url string, timeout time.Duration) *beaconClient {
+	return &beaconClient{
+		url:     url,
+		timeout: timeout,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// blobsByHashes returns the blobs with the given versioned hashes that were included in the block, in the requested order
+func (b *beaconClient) blobsByHashes(block *types.Block, blobHashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	if err := b.init(); err != nil {
+		return nil, err
+	}
+	if block.Time() < b.genesisTime {
+		return nil, fmt.Errorf("block %s is older than the beacon chain genesis", block.Hash())
+	}
+	slot := (block.Time() - b.genesisTime) / b.secondsPerSlot
+
+	var response beaconBlobSidecarsResponse
+	if err := b.get(fmt.Sprintf(beaconBlobSidecarsPath, slot), &response); err != nil {
+		return nil, fmt.Errorf("could not fetch blob sidecars for slot %d. Cause: %w", slot, err)
+	}
+
+	blobsByHash := make(map[gethcommon.Hash]*kzg4844.Blob, len(response.Data))
+	for _, sidecar := range response.Data {
+		if len(sidecar.Blob) != len(kzg4844.Blob{}) || len(sidecar.KZGCommitment) != len(kzg4844.Commitment{}) {
+			return nil, fmt.Errorf("invalid blob sidecar for slot %d", slot)
+		}
+		var blob kzg4844.Blob
+		var commitment kzg4844.Commitment
+		copy(blob[:], sidecar.Blob)
+		copy(commitment[:], sidecar.KZGCommitment)
+		blobsByHash[KZGToVersionedHash(commitment)] = &blob
+	}
+
+	blobs := make([]*kzg4844.Blob, len(blobHashes))
+	for i, blobHash := range blobHashes {
+		blob, found := blobsByHash[blobHash]
+		if !found {
+			return nil, fmt.Errorf("blob %s not found for slot %d", blobHash, slot)
+		}
+		blobs[i] = blob
+	}
+	return blobs, nil
+}
+
+func (b *beaconClient) init() error {
+	b.initLock.Lock()
+	defer b.initLock.Unlock()
+	if b.secondsPerSlot != 0 {
+		return nil
+	}
+
+	var genesis beaconGenesisResponse
+	if err := b.get(beaconGenesisPath, &genesis); err != nil {
+		return fmt.Errorf("could not fetch beacon genesis. Cause: %w", err)
+	}
+	genesisTime, err := strconv.ParseUint(genesis.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid beacon genesis time. Cause: %w", err)
+	}
+
+	var spec beaconSpecResponse
+	if err := b.get(beaconSpecPath, &spec); err != nil {
+		return fmt.Errorf("could not fetch beacon spec. Cause: %w", err)
+	}
+	secondsPerSlot, err := strconv.ParseUint(spec.Data.SecondsPerSlot, 10, 64)
+	if err != nil || secondsPerSlot == 0 {
+		return fmt.Errorf("invalid beacon seconds per slot %q", spec.Data.SecondsPerSlot)
+	}
+
+	b.genesisTime = genesisTime
+	b.secondsPerSlot = secondsPerSlot
+	return nil
+}
+
+func (b *beaconClient) get(path string, result any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.url+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,161 @@
+package ethadapter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// the first byte of every field element is left empty, so the value of the field element is always lower than
+	// the BLS modulus
+	usableBytesPerFieldElement = 31
+	// BlobCapacity - the number of bytes of data that fit in a single blob
+	BlobCapacity = params.BlobTxFieldElementsPerBlob * usableBytesPerFieldElement
+	// the data is prefixed with its length, so the padding of the last blob can be dropped when decoding
+	lengthPrefixSize = 4
+	// MaxBlobsPerTx - the number of blobs that fit in a single L1 block
+	MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+)
+
+// rollupBlobPayload - the parts of a rollup that are published as blobs when the rollup is published with blobs.
+// The header is published as calldata, so the management contract can process it.
+type rollupBlobPayload struct {
+	CalldataRollupHeader []byte
+	BatchPayloads        []byte
+}
+
+// SplitRollupForBlobs - moves the payloads of the rollup into blobs.
+// Returns the encoded rollup without the payloads, to be published as calldata, and the blobs carrying the payloads.
+func SplitRollupForBlobs(rollup *common.ExtRollup) (common.EncodedRollup, []kzg4844.Blob, error) {
+	payload, err := rlp.EncodeToBytes(&rollupBlobPayload{
+		CalldataRollupHeader: rollup.CalldataRollupHeader,
+		BatchPayloads:        rollup.BatchPayloads,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not encode rollup payload. Cause: %w", err)
+	}
+	blobs, err := EncodeBlobs(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the hash of the rollup only depends on the header, so the stripped rollup has the same hash and signature
+	stripped := &common.ExtRollup{Header: rollup.Header}
+	encoded, err := common.EncodeRollup(stripped)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not encode rollup. Cause: %w", err)
+	}
+	return encoded, blobs, nil
+}
+
+// ReconstructRollupFromBlobs - fills in the payloads of a rollup that was published with blobs.
+// The blobs must be in the order of the blob hashes of the transaction.
+func ReconstructRollupFromBlobs(rollup *common.ExtRollup, blobs []*kzg4844.Blob) error {
+	data, err := DecodeBlobs(blobs)
+	if err != nil {
+		return err
+	}
+	payload := &rollupBlobPayload{}
+	if err := rlp.DecodeBytes(data, payload); err != nil {
+		return fmt.Errorf("could not decode rollup payload. Cause: %w", err)
+	}
+	rollup.CalldataRollupHeader = payload.CalldataRollupHeader
+	rollup.BatchPayloads = payload.BatchPayloads
+	return nil
+}
+
+// EncodeBlobs - splits the data into as many blobs as needed. The data is prefixed with its length.
+func EncodeBlobs(data []byte) ([]kzg4844.Blob, error) {
+	prefixed := make([]byte, lengthPrefixSize, lengthPrefixSize+len(data))
+	binary.BigEndian.PutUint32(prefixed, uint32(len(data)))
+	prefixed = append(prefixed, data...)
+
+	numBlobs := (len(prefixed) + BlobCapacity - 1) / BlobCapacity
+	if numBlobs > MaxBlobsPerTx {
+		return nil, fmt.Errorf("data of %d bytes does not fit in %d blobs", len(data), MaxBlobsPerTx)
+	}
+
+	blobs := make([]kzg4844.Blob, numBlobs)
+	for i := range blobs {
+		chunk := prefixed[i*BlobCapacity:]
+		if len(chunk) > BlobCapacity {
+			chunk = chunk[:BlobCapacity]
+		}
+		for fe := 0; len(chunk) > 0; fe++ {
+			n := copy(blobs[i][fe*32+1:(fe+1)*32], chunk)
+			chunk = chunk[n:]
+		}
+	}
+	return blobs, nil
+}
+
+// DecodeBlobs - reverses EncodeBlobs
+func DecodeBlobs(blobs []*kzg4844.Blob) ([]byte, error) {
+	data := make([]byte, 0, len(blobs)*BlobCapacity)
+	for _, blob := range blobs {
+		for fe := 0; fe < params.BlobTxFieldElementsPerBlob; fe++ {
+			if blob[fe*32] != 0 {
+				return nil, fmt.Errorf("invalid field element %d in blob", fe)
+			}
+			data = append(data, blob[fe*32+1:(fe+1)*32]...)
+		}
+	}
+	if len(data) < lengthPrefixSize {
+		return nil, errors.New("no data in blobs")
+	}
+	length := binary.BigEndian.Uint32(data[:lengthPrefixSize])
+	if uint64(length) > uint64(len(data)-lengthPrefixSize) {
+		return nil, fmt.Errorf("invalid data length %d in blobs", length)
+	}
+	return data[lengthPrefixSize : lengthPrefixSize+int(length)], nil
+}
+
+// MakeSidecar - computes the KZG commitments and proofs of the blobs
+func MakeSidecar(blobs []kzg4844.Blob) (*types.BlobTxSidecar, error) {
+	sidecar := &types.BlobTxSidecar{}
+	for i, blob := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute commitment of blob %d. Cause: %w", i, err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute proof of blob %d. Cause: %w", i, err)
+		}
+		sidecar.Blobs = append(sidecar.Blobs, blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+	return sidecar, nil
+}
+
+// KZGToVersionedHash - computes the versioned hash of a blob commitment, as defined in EIP-4844
+func KZGToVersionedHash(commitment kzg4844.Commitment) gethcommon.Hash {
+	versionedHash := gethcommon.Hash(sha256.Sum256(commitment[:]))
+	versionedHash[0] = params.BlobTxHashVersion
+	return versionedHash
+}
+
+// IndexBlobs - returns the blobs by their versioned hash. The hashes are computed from the content of the blobs, so
+// the blobs found under the versioned hashes of a transaction are guaranteed to be the ones published with it
+func IndexBlobs(blobs []*kzg4844.Blob) (map[gethcommon.Hash]*kzg4844.Blob, error) {
+	blobsByHash := make(map[gethcommon.Hash]*kzg4844.Blob, len(blobs))
+	for i, blob := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(*blob)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute commitment of blob %d. Cause: %w", i, err)
+		}
+		blobsByHash[KZGToVersionedHash(commitment)] = blob
+	}
+	return blobsByHash, nil
+}
@@ -0,0 +1,60 @@
+package ethadapter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestRollupBlobsRoundTrip(t *testing.T) {
+	// large enough to require two blobs
+	payloads := bytes.Repeat([]byte{0xff, 0x01, 0x00}, BlobCapacity/2)
+	rollup := &common.ExtRollup{
+		Header:               &common.RollupHeader{LastBatchSeqNo: 7},
+		CalldataRollupHeader: []byte("calldata rollup header"),
+		BatchPayloads:        payloads,
+	}
+
+	encodedStripped, blobs, err := SplitRollupForBlobs(rollup)
+	if err != nil {
+		t.Fatalf("could not split rollup: %s", err)
+	}
+	if len(blobs) != 2 {
+		t.Fatalf("expected 2 blobs, got %d", len(blobs))
+	}
+
+	sidecar, err := MakeSidecar(blobs)
+	if err != nil {
+		t.Fatalf("could not make sidecar: %s", err)
+	}
+	blobPointers := make([]*kzg4844.Blob, len(sidecar.Blobs))
+	for i := range sidecar.Blobs {
+		blobPointers[i] = &sidecar.Blobs[i]
+	}
+	blobsByHash, err := IndexBlobs(blobPointers)
+	if err != nil {
+		t.Fatalf("could not index blobs: %s", err)
+	}
+	for i, blobHash := range sidecar.BlobHashes() {
+		if blobsByHash[blobHash] != blobPointers[i] {
+			t.Fatalf("blob %d is not indexed under its versioned hash", i)
+		}
+	}
+
+	stripped, err := common.DecodeRollup(encodedStripped)
+	if err != nil {
+		t.Fatalf("could not decode stripped rollup: %s", err)
+	}
+	if stripped.Hash() != rollup.Hash() || len(stripped.BatchPayloads) != 0 {
+		t.Fatal("stripped rollup should keep the header and drop the payloads")
+	}
+
+	if err := ReconstructRollupFromBlobs(stripped, blobPointers); err != nil {
+		t.Fatalf("could not reconstruct rollup: %s", err)
+	}
+	if !bytes.Equal(stripped.BatchPayloads, rollup.BatchPayloads) || !bytes.Equal(stripped.CalldataRollupHeader, rollup.CalldataRollupHeader) {
+		t.Fatal("reconstructed rollup does not match the original")
+	}
+}
@@ -0,0 +1,99 @@
+package crosschainlib
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/contracts/generated/CrossChainMessenger"
+	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	RelayMessageMethod             = "relayMessage"
+	GetMessageTimeOfFinalityMethod = "getMessageTimeOfFinality"
+)
+
+// CrossChainLib provides methods for creating the transactions and call messages that deliver the cross chain messages
+// of the L2 on the L1, once the rollups publishing them are final.
+type CrossChainLib interface {
+	// CreateRelayMessage creates a transaction that delivers the message to its target through the cross chain messenger
+	CreateRelayMessage(tx *ethadapter.L1RelayMessageTx) types.TxData
+
+	// GetMessageTimeOfFinalityMsg creates a call message that returns the L1 timestamp from which the message bus
+	// considers the message final. The call reverts if the message bus does not know the message.
+	GetMessageTimeOfFinalityMsg(msg common.CrossChainMessage) (ethereum.CallMsg, error)
+	DecodeMessageTimeOfFinalityResponse(callResponse []byte) (uint64, error)
+}
+
+type crossChainLibImpl struct {
+	messageBusAddr *gethcommon.Address
+	messengerAddr  *gethcommon.Address
+	messageBusABI  *abi.ABI
+	messengerABI   *abi.ABI
+}
+
+func NewCrossChainLib(messageBusAddr *gethcommon.Address, messengerAddr *gethcommon.Address) CrossChainLib {
+	messageBusABI, err := MessageBus.MessageBusMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	messengerABI, err := CrossChainMessenger.CrossChainMessengerMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	return &crossChainLibImpl{
+		messageBusAddr: messageBusAddr,
+		messengerAddr:  messengerAddr,
+		messageBusABI:  messageBusABI,
+		messengerABI:   messengerABI,
+	}
+}
+
+func (c *crossChainLibImpl) CreateRelayMessage(tx *ethadapter.L1RelayMessageTx) types.TxData {
+	data, err := c.messengerABI.Pack(RelayMessageMethod, CrossChainMessenger.StructsCrossChainMessage{
+		Sender:           tx.Message.Sender,
+		Sequence:         tx.Message.Sequence,
+		Nonce:            tx.Message.Nonce,
+		Topic:            tx.Message.Topic,
+		Payload:          tx.Message.Payload,
+		ConsistencyLevel: tx.Message.ConsistencyLevel,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &types.LegacyTx{
+		To:   c.messengerAddr,
+		Data: data,
+	}
+}
+
+func (c *crossChainLibImpl) GetMessageTimeOfFinalityMsg(msg common.CrossChainMessage) (ethereum.CallMsg, error) {
+	data, err := c.messageBusABI.Pack(GetMessageTimeOfFinalityMethod, msg)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: c.messageBusAddr, Data: data}, nil
+}
+
+func (c *crossChainLibImpl) DecodeMessageTimeOfFinalityResponse(callResponse []byte) (uint64, error) {
+	unpackedResponse, err := c.messageBusABI.Unpack(GetMessageTimeOfFinalityMethod, callResponse)
+	if err != nil {
+		return 0, fmt.Errorf("could not unpack call response. Cause: %w", err)
+	}
+	if len(unpackedResponse) != 1 {
+		return 0, fmt.Errorf("unexpected number of results (%d) returned from call", len(unpackedResponse))
+	}
+	timeOfFinality, ok := unpackedResponse[0].(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("could not decode time of finality from call response")
+	}
+	return timeOfFinality.Uint64(), nil
+}
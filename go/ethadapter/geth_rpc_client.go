@@ -3,6 +3,7 @@ package ethadapter
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -11,11 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethlog "github.com/ethereum/go-ethereum/log"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/holiman/uint256"
 
 	"github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -39,10 +43,17 @@ type gethRPCClient struct {
 	logger     gethlog.Logger
 	rpcURL     string
 	blockCache *lru.Cache[gethcommon.Hash, *types.Block]
+	beacon     *beaconClient // fetches the blobs from the beacon node - nil if no beacon node was configured
 }
 
 // NewEthClientFromURL instantiates a new ethadapter.EthClient that connects to an ethereum node
 func NewEthClientFromURL(rpcURL string, timeout time.Duration, l2ID gethcommon.Address, logger gethlog.Logger) (EthClient, error) {
+	return NewEthClientWithBeacon(rpcURL, "", timeout, l2ID, logger)
+}
+
+// NewEthClientWithBeacon instantiates a new ethadapter.EthClient that connects to an ethereum node, and to the beacon
+// node at beaconURL to fetch blobs (no blobs can be fetched if beaconURL is empty)
+func NewEthClientWithBeacon(rpcURL string, beaconURL string, timeout time.Duration, l2ID gethcommon.Address, logger gethlog.Logger) (EthClient, error) {
 	client, err := connect(rpcURL, timeout)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to the eth node (%s) - %w", rpcURL, err)
@@ -56,6 +67,11 @@ func NewEthClientFromURL(rpcURL string, timeout time.Duration, l2ID gethcommon.A
 		return nil, fmt.Errorf("unable to initialize block cache - %w", err)
 	}
 
+	var beacon *beaconClient
+	if beaconURL != "" {
+		beacon = newBeaconClient(beaconURL, timeout)
+	}
+
 	return &gethRPCClient{
 		client:     client,
 		l2ID:       l2ID,
@@ -63,6 +79,7 @@ func NewEthClientFromURL(rpcURL string, timeout time.Duration, l2ID gethcommon.A
 		logger:     logger,
 		rpcURL:     rpcURL,
 		blockCache: blkCache,
+		beacon:     beacon,
 	}, nil
 }
 
@@ -231,6 +248,13 @@ func (e *gethRPCClient) GetLogs(q ethereum.FilterQuery) ([]types.Log, error) {
 	return e.client.FilterLogs(ctx, q)
 }
 
+func (e *gethRPCClient) BlobsByHashes(block *types.Block, blobHashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	if e.beacon == nil {
+		return nil, errors.New("no beacon node configured to fetch the blobs")
+	}
+	return e.beacon.blobsByHashes(block, blobHashes)
+}
+
 func (e *gethRPCClient) Stop() {
 	e.client.Close()
 }
@@ -279,6 +303,10 @@ func (e *gethRPCClient) PrepareTransactionToRetry(txData types.TxData, from geth
 		return nil, err
 	}
 
+	if blobTx, ok := txData.(*types.BlobTx); ok {
+		return e.prepareBlobTx(blobTx, nonce, gasLimit, retryNumber)
+	}
+
 	return &types.LegacyTx{
 		Nonce:    nonce,
 		GasPrice: retryPrice,
@@ -289,6 +317,51 @@ func (e *gethRPCClient) PrepareTransactionToRetry(txData types.TxData, from geth
 	}, nil
 }
 
+// prepareBlobTx sets the nonce, gas and fee caps of a blob transaction, keeping its blobs.
+// The fees are doubled for each retry, as the mempool only accepts replacement blob transactions with fees at least 100% higher.
+func (e *gethRPCClient) prepareBlobTx(blobTx *types.BlobTx, nonce uint64, gasLimit uint64, retryNumber int) (types.TxData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	chainID, err := e.client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head, err := e.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil || head.ExcessBlobGas == nil {
+		return nil, errors.New("the L1 does not support blob transactions")
+	}
+	gasTipCap, err := e.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retries := int(math.Min(_maxRetryPriceIncreases, float64(retryNumber)))
+	multiplier := big.NewInt(1 << retries)
+	gasTipCap = big.NewInt(0).Mul(gasTipCap, multiplier)
+	// allow for the base fee doubling before the tx is included
+	gasFeeCap := big.NewInt(0).Add(big.NewInt(0).Mul(head.BaseFee, big.NewInt(2)), gasTipCap)
+	blobFeeCap := big.NewInt(0).Mul(eip4844.CalcBlobFee(*head.ExcessBlobGas), big.NewInt(2))
+	blobFeeCap.Mul(blobFeeCap, multiplier)
+
+	return &types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		Gas:        gasLimit,
+		To:         blobTx.To,
+		Value:      blobTx.Value,
+		Data:       blobTx.Data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+		BlobHashes: blobTx.BlobHashes,
+		Sidecar:    blobTx.Sidecar,
+	}, nil
+}
+
 // ReconnectIfClosed closes the existing client connection and creates a new connection to the same address:port
 func (e *gethRPCClient) ReconnectIfClosed() error {
 	if e.Alive() {
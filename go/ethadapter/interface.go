@@ -10,6 +10,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
@@ -28,6 +29,9 @@ type EthClient interface {
 	BalanceAt(account gethcommon.Address, blockNumber *big.Int) (*big.Int, error) // fetches the balance of the account
 	GetLogs(q ethereum.FilterQuery) ([]types.Log, error)                          // fetches the logs for a given query
 
+	// BlobsByHashes fetches the blobs with the given versioned hashes that were published in the block, in the requested order
+	BlobsByHashes(block *types.Block, blobHashes []gethcommon.Hash) ([]*kzg4844.Blob, error)
+
 	Info() Info                                                         // retrieves the node Info
 	FetchHeadBlock() (*types.Block, error)                              // retrieves the block at head height
 	BlocksBetween(block *types.Block, head *types.Block) []*types.Block // returns the blocks between two blocks
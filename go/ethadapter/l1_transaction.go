@@ -15,7 +15,22 @@ import (
 type L1Transaction interface{}
 
 type L1RollupTx struct {
-	Rollup common.EncodedRollup
+	Rollup     common.EncodedRollup
+	BlobHashes []gethcommon.Hash // the versioned hashes of the blobs carrying the rollup payloads, if the rollup was published with blobs
+}
+
+// L1WithdrawalTx claims an outbound value transfer of the L2 on the L1, with the proof of its inclusion in the cross chain
+// tree of its batch
+type L1WithdrawalTx struct {
+	Transfer common.ValueTransferMessage
+	Proof    []gethcommon.Hash
+	Root     gethcommon.Hash
+}
+
+// L1RelayMessageTx delivers a cross chain message published by the L2 to its target on the L1, through the cross chain
+// messenger, once the message is final
+type L1RelayMessageTx struct {
+	Message common.CrossChainMessage
 }
 
 type L1DepositTx struct {
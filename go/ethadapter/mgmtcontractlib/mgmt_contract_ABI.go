@@ -4,13 +4,16 @@ import "github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
 
 const (
 	AddRollupMethod                = "AddRollup"
+	AddRollupWithBlobsMethod       = "AddRollupWithBlobs"
 	RespondSecretMethod            = "RespondNetworkSecret"
 	RequestSecretMethod            = "RequestNetworkSecret"
 	InitializeSecretMethod         = "InitializeNetworkSecret" //#nosec
 	GetHostAddressesMethod         = "GetHostAddresses"
+	AttestedMethod                 = "Attested"
 	GetImportantContractKeysMethod = "GetImportantContractKeys"
 	SetImportantContractsMethod    = "SetImportantContractAddress"
 	GetImportantAddressMethod      = "importantContractAddresses"
+	ExtractNativeValueMethod       = "ExtractNativeValue"
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
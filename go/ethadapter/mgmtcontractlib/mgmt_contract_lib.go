@@ -25,9 +25,13 @@ const methodBytesLen = 4
 // messages for call requests, and converting ethereum transactions into L1Transactions.
 type MgmtContractLib interface {
 	CreateRollup(t *ethadapter.L1RollupTx) types.TxData
+	// CreateBlobRollup creates a blob transaction (EIP-4844) that publishes the rollup payloads as blobs, and the rest of the rollup as calldata
+	CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxData, error)
 	CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData
 	CreateRespondSecret(tx *ethadapter.L1RespondSecretTx, verifyAttester bool) types.TxData
 	CreateInitializeSecret(tx *ethadapter.L1InitializeSecretTx) types.TxData
+	// CreateWithdrawal creates a transaction that pays out an outbound value transfer of the L2 to its receiver on the L1
+	CreateWithdrawal(tx *ethadapter.L1WithdrawalTx) types.TxData
 
 	// DecodeTx receives a *types.Transaction and converts it to an common.L1Transaction
 	DecodeTx(tx *types.Transaction) ethadapter.L1Transaction
@@ -38,6 +42,9 @@ type MgmtContractLib interface {
 	GetHostAddressesMsg() (ethereum.CallMsg, error)
 	DecodeHostAddressesResponse(callResponse []byte) ([]string, error)
 
+	GetAttestedMsg(hostID gethcommon.Address) (ethereum.CallMsg, error)
+	DecodeAttestedResponse(callResponse []byte) (bool, error)
+
 	SetImportantContractMsg(key string, address gethcommon.Address) (ethereum.CallMsg, error)
 
 	GetImportantContractKeysMsg() (ethereum.CallMsg, error)
@@ -95,6 +102,23 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 			Rollup: rollup,
 		}
 
+	case AddRollupWithBlobsMethod:
+		if err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[4:]); err != nil {
+			panic(err)
+		}
+		callData, found := contractCallData["_rollupData"]
+		if !found {
+			panic("call data not found for rollupData")
+		}
+		rollup := Base64DecodeFromString(callData.(string))
+
+		// the blob hashes are taken from the transaction rather than the calldata, since those are the ones that were
+		// checked by the L1 against the published blobs
+		return &ethadapter.L1RollupTx{
+			Rollup:     rollup,
+			BlobHashes: tx.BlobHashes(),
+		}
+
 	case RespondSecretMethod:
 		return c.unpackRespondSecretTx(tx, method, contractCallData)
 
@@ -124,21 +148,11 @@ func (c *contractLibImpl) CreateRollup(t *ethadapter.L1RollupTx) types.TxData {
 
 	encRollupData := base64EncodeToString(t.Rollup)
 
-	metaRollup := ManagementContract.StructsMetaRollup{
-		Hash:               decodedRollup.Hash(),
-		AggregatorID:       decodedRollup.Header.Coinbase,
-		LastSequenceNumber: big.NewInt(int64(decodedRollup.Header.LastBatchSeqNo)),
-	}
-
-	crossChain := ManagementContract.StructsHeaderCrossChainData{
-		Messages: convertCrossChainMessages(decodedRollup.Header.CrossChainMessages),
-	}
-
 	data, err := c.contractABI.Pack(
 		AddRollupMethod,
-		metaRollup,
+		metaRollupOf(decodedRollup),
 		encRollupData,
-		crossChain,
+		crossChainDataOf(decodedRollup),
 	)
 	if err != nil {
 		panic(err)
@@ -150,6 +164,65 @@ func (c *contractLibImpl) CreateRollup(t *ethadapter.L1RollupTx) types.TxData {
 	}
 }
 
+func (c *contractLibImpl) CreateBlobRollup(t *ethadapter.L1RollupTx) (types.TxData, error) {
+	decodedRollup, err := common.DecodeRollup(t.Rollup)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode rollup. Cause: %w", err)
+	}
+
+	strippedRollup, blobs, err := ethadapter.SplitRollupForBlobs(decodedRollup)
+	if err != nil {
+		return nil, err
+	}
+	sidecar, err := ethadapter.MakeSidecar(blobs)
+	if err != nil {
+		return nil, err
+	}
+	blobHashes := sidecar.BlobHashes()
+
+	contractBlobHashes := make([][32]byte, len(blobHashes))
+	for i, blobHash := range blobHashes {
+		contractBlobHashes[i] = blobHash
+	}
+
+	data, err := c.contractABI.Pack(
+		AddRollupWithBlobsMethod,
+		metaRollupOf(decodedRollup),
+		base64EncodeToString(strippedRollup),
+		crossChainDataOf(decodedRollup),
+		contractBlobHashes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+
+	return &types.BlobTx{
+		To:         *c.addr,
+		Data:       data,
+		BlobHashes: blobHashes,
+		Sidecar:    sidecar,
+	}, nil
+}
+
+func metaRollupOf(rollup *common.ExtRollup) ManagementContract.StructsMetaRollup {
+	return ManagementContract.StructsMetaRollup{
+		Hash:               rollup.Hash(),
+		AggregatorID:       rollup.Header.Coinbase,
+		LastSequenceNumber: big.NewInt(int64(rollup.Header.LastBatchSeqNo)),
+	}
+}
+
+func crossChainDataOf(rollup *common.ExtRollup) ManagementContract.StructsHeaderCrossChainData {
+	roots := make([][32]byte, len(rollup.Header.CrossChainRoots))
+	for i, root := range rollup.Header.CrossChainRoots {
+		roots[i] = root
+	}
+	return ManagementContract.StructsHeaderCrossChainData{
+		Messages:        convertCrossChainMessages(rollup.Header.CrossChainMessages),
+		CrossChainRoots: roots,
+	}
+}
+
 func (c *contractLibImpl) CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData {
 	data, err := c.contractABI.Pack(RequestSecretMethod, base64EncodeToString(tx.Attestation))
 	if err != nil {
@@ -198,6 +271,32 @@ func (c *contractLibImpl) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	}
 }
 
+func (c *contractLibImpl) CreateWithdrawal(tx *ethadapter.L1WithdrawalTx) types.TxData {
+	proof := make([][32]byte, len(tx.Proof))
+	for i, sibling := range tx.Proof {
+		proof[i] = sibling
+	}
+	data, err := c.contractABI.Pack(
+		ExtractNativeValueMethod,
+		ManagementContract.StructsValueTransferMessage{
+			Sender:        tx.Transfer.Sender,
+			Receiver:      tx.Transfer.Receiver,
+			Amount:        tx.Transfer.Amount,
+			BatchSequence: tx.Transfer.BatchSequence,
+			Index:         tx.Transfer.Index,
+		},
+		proof,
+		tx.Root,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}
+}
+
 func (c *contractLibImpl) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	data, err := c.contractABI.Pack(GetHostAddressesMethod)
 	if err != nil {
@@ -224,6 +323,31 @@ func (c *contractLibImpl) DecodeHostAddressesResponse(callResponse []byte) ([]st
 	return addresses, nil
 }
 
+func (c *contractLibImpl) GetAttestedMsg(hostID gethcommon.Address) (ethereum.CallMsg, error) {
+	data, err := c.contractABI.Pack(AttestedMethod, hostID)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: c.addr, Data: data}, nil
+}
+
+func (c *contractLibImpl) DecodeAttestedResponse(callResponse []byte) (bool, error) {
+	unpackedResponse, err := c.contractABI.Unpack(AttestedMethod, callResponse)
+	if err != nil {
+		return false, fmt.Errorf("could not unpack call response. Cause: %w", err)
+	}
+
+	if len(unpackedResponse) != 1 {
+		return false, fmt.Errorf("unexpected number of results (%d) returned from call, response: %s", len(unpackedResponse), unpackedResponse)
+	}
+	attested, ok := unpackedResponse[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("could not convert element in call response to bool")
+	}
+
+	return attested, nil
+}
+
 func (c *contractLibImpl) GetContractNamesMsg() (ethereum.CallMsg, error) {
 	data, err := c.contractABI.Pack(GetImportantContractKeysMethod)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common"
@@ -25,6 +26,7 @@ type HostConfigToml struct {
 	ClientRPCPortWS           uint
 	ClientRPCHost             string
 	EnclaveRPCAddress         string
+	EnclaveRPCAddresses       []string
 	P2PBindAddress            string
 	P2PPublicAddress          string
 	L1WebsocketURL            string
@@ -52,6 +54,10 @@ type HostConfigToml struct {
 	IsInboundP2PDisabled      bool
 	L1BlockTime               int
 	MaxRollupSize             int
+	L1BeaconURL               string
+	UseBlobsForRollups        bool
+	IsP2PGossipEnabled        bool
+	P2PGossipFanout           int
 }
 
 // ParseConfig returns a config.HostInputConfig based on either the file identified by the `config` flag, or the flags with
@@ -67,6 +73,7 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	clientRPCPortWS := flag.Uint64(clientRPCPortWSName, cfg.ClientRPCPortWS, flagUsageMap[clientRPCPortWSName])
 	clientRPCHost := flag.String(clientRPCHostName, cfg.ClientRPCHost, flagUsageMap[clientRPCHostName])
 	enclaveRPCAddress := flag.String(enclaveRPCAddressName, cfg.EnclaveRPCAddress, flagUsageMap[enclaveRPCAddressName])
+	enclaveRPCAddresses := flag.String(enclaveRPCAddressesName, strings.Join(cfg.EnclaveRPCAddresses, ","), flagUsageMap[enclaveRPCAddressesName])
 	p2pBindAddress := flag.String(p2pBindAddressName, cfg.P2PBindAddress, flagUsageMap[p2pBindAddressName])
 	p2pPublicAddress := flag.String(p2pPublicAddressName, cfg.P2PPublicAddress, flagUsageMap[p2pPublicAddressName])
 	l1WSURL := flag.String(l1WebsocketURLName, cfg.L1WebsocketURL, flagUsageMap[l1WebsocketURLName])
@@ -93,6 +100,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	rollupInterval := flag.String(rollupIntervalName, cfg.RollupInterval.String(), flagUsageMap[rollupIntervalName])
 	isInboundP2PDisabled := flag.Bool(isInboundP2PDisabledName, cfg.IsInboundP2PDisabled, flagUsageMap[isInboundP2PDisabledName])
 	maxRollupSize := flag.Uint64(maxRollupSizeFlagName, cfg.MaxRollupSize, flagUsageMap[maxRollupSizeFlagName])
+	l1BeaconURL := flag.String(l1BeaconURLName, cfg.L1BeaconURL, flagUsageMap[l1BeaconURLName])
+	useBlobsForRollups := flag.Bool(useBlobsForRollupsName, cfg.UseBlobsForRollups, flagUsageMap[useBlobsForRollupsName])
+	isP2PGossipEnabled := flag.Bool(isP2PGossipEnabledName, cfg.IsP2PGossipEnabled, flagUsageMap[isP2PGossipEnabledName])
+	p2pGossipFanout := flag.Int(p2pGossipFanoutName, cfg.P2PGossipFanout, flagUsageMap[p2pGossipFanoutName])
 
 	flag.Parse()
 
@@ -113,6 +124,9 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.ClientRPCPortWS = *clientRPCPortWS
 	cfg.ClientRPCHost = *clientRPCHost
 	cfg.EnclaveRPCAddress = *enclaveRPCAddress
+	if *enclaveRPCAddresses != "" {
+		cfg.EnclaveRPCAddresses = strings.Split(*enclaveRPCAddresses, ",")
+	}
 	cfg.P2PBindAddress = *p2pBindAddress
 	cfg.P2PPublicAddress = *p2pPublicAddress
 	cfg.L1WebsocketURL = *l1WSURL
@@ -148,6 +162,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	}
 	cfg.IsInboundP2PDisabled = *isInboundP2PDisabled
 	cfg.MaxRollupSize = *maxRollupSize
+	cfg.L1BeaconURL = *l1BeaconURL
+	cfg.UseBlobsForRollups = *useBlobsForRollups
+	cfg.IsP2PGossipEnabled = *isP2PGossipEnabled
+	cfg.P2PGossipFanout = *p2pGossipFanout
 
 	return cfg, nil
 }
@@ -190,6 +208,7 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		ClientRPCPortWS:           uint64(tomlConfig.ClientRPCPortWS),
 		ClientRPCHost:             tomlConfig.ClientRPCHost,
 		EnclaveRPCAddress:         tomlConfig.EnclaveRPCAddress,
+		EnclaveRPCAddresses:       tomlConfig.EnclaveRPCAddresses,
 		P2PBindAddress:            tomlConfig.P2PBindAddress,
 		P2PPublicAddress:          tomlConfig.P2PPublicAddress,
 		L1WebsocketURL:            tomlConfig.L1WebsocketURL,
@@ -215,5 +234,9 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		RollupInterval:            rollupInterval,
 		IsInboundP2PDisabled:      tomlConfig.IsInboundP2PDisabled,
 		L1BlockTime:               time.Duration(tomlConfig.L1BlockTime) * time.Second,
+		L1BeaconURL:               tomlConfig.L1BeaconURL,
+		UseBlobsForRollups:        tomlConfig.UseBlobsForRollups,
+		IsP2PGossipEnabled:        tomlConfig.IsP2PGossipEnabled,
+		P2PGossipFanout:           tomlConfig.P2PGossipFanout,
 	}, nil
 }
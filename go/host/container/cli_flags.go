@@ -10,6 +10,7 @@ const (
 	clientRPCPortWSName          = "clientRPCPortWs"
 	clientRPCHostName            = "clientRPCHost"
 	enclaveRPCAddressName        = "enclaveRPCAddress"
+	enclaveRPCAddressesName      = "enclaveRPCAddresses"
 	p2pBindAddressName           = "p2pBindAddress"
 	p2pPublicAddressName         = "p2pPublicAddress"
 	l1WebsocketURLName           = "l1WSURL"
@@ -36,6 +37,10 @@ const (
 	rollupIntervalName           = "rollupInterval"
 	isInboundP2PDisabledName     = "isInboundP2PDisabled"
 	maxRollupSizeFlagName        = "maxRollupSize"
+	l1BeaconURLName              = "l1BeaconURL"
+	useBlobsForRollupsName       = "useBlobsForRollups"
+	isP2PGossipEnabledName       = "isP2PGossipEnabled"
+	p2pGossipFanoutName          = "p2pGossipFanout"
 )
 
 // Returns a map of the flag usages.
@@ -50,6 +55,7 @@ func getFlagUsageMap() map[string]string {
 		clientRPCPortWSName:          "The port on which to listen for client application RPC requests over websockets",
 		clientRPCHostName:            "The host on which to handle client application RPC requests",
 		enclaveRPCAddressName:        "The address to use to connect to the Obscuro enclave service",
+		enclaveRPCAddressesName:      "Comma-separated addresses of all the enclaves of the host, which fail over to each other. Overrides enclaveRPCAddress if set",
 		p2pBindAddressName:           "The address where the p2p server is bound to. Defaults to 0.0.0.0:10000",
 		p2pPublicAddressName:         "The P2P address where the other servers should connect to. Defaults to 127.0.0.1:10000",
 		l1WebsocketURLName:           "The websocket RPC address the host can use for L1 requests",
@@ -76,5 +82,9 @@ func getFlagUsageMap() map[string]string {
 		rollupIntervalName:           "Duration between each rollup. Can be put down as 1.0s",
 		isInboundP2PDisabledName:     "Whether inbound p2p is enabled",
 		maxRollupSizeFlagName:        "Max size of a rollup",
+		l1BeaconURLName:              "The HTTP address of the L1 beacon node API, used to fetch the blobs of the rollups",
+		useBlobsForRollupsName:       "Whether the rollup payloads are published as blobs instead of calldata (Defaults to false)",
+		isP2PGossipEnabledName:       "Whether validators relay live batches to their peers instead of all receiving them from the sequencer (Defaults to false)",
+		p2pGossipFanoutName:          "The number of peers each live batch is sent or relayed to when gossip is enabled (Defaults to 3)",
 	}
 }
@@ -34,6 +34,7 @@ const (
 	APINamespaceNetwork = "net"
 	APINamespaceTest    = "test"
 	APINamespaceDebug   = "debug"
+	APINamespaceTen     = "ten"
 )
 
 type HostContainer struct {
@@ -114,7 +115,7 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger get
 	ethWallet := wallet.NewInMemoryWalletFromConfig(cfg.PrivateKeyString, cfg.L1ChainID, log.New("wallet", cfg.LogLevel, cfg.LogPath))
 
 	fmt.Println("Connecting to L1 network...")
-	l1Client, err := ethadapter.NewEthClientFromURL(cfg.L1WebsocketURL, cfg.L1RPCTimeout, cfg.ID, logger)
+	l1Client, err := ethadapter.NewEthClientWithBeacon(cfg.L1WebsocketURL, cfg.L1BeaconURL, cfg.L1RPCTimeout, cfg.ID, logger)
 	if err != nil {
 		logger.Crit("could not create Ethereum client.", log.ErrKey, err)
 	}
@@ -131,11 +132,18 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger get
 
 	fmt.Println("Connecting to the enclave...")
 	services := host.NewServicesRegistry(logger)
-	enclaveClient := enclaverpc.NewClient(cfg, logger)
+	// the config lists all the enclaves of the host, unless it was not normalised and only has the address of one enclave
+	enclaveClients := make([]common.Enclave, 0, len(cfg.EnclaveRPCAddresses))
+	for _, address := range cfg.EnclaveRPCAddresses {
+		enclaveClients = append(enclaveClients, enclaverpc.NewClientForAddress(cfg, address, logger))
+	}
+	if len(enclaveClients) == 0 {
+		enclaveClients = append(enclaveClients, enclaverpc.NewClient(cfg, logger))
+	}
 	p2pLogger := logger.New(log.CmpKey, log.P2PCmp)
 	metricsService := metrics.New(cfg.MetricsEnabled, cfg.MetricsHTTPPort, logger)
 
-	aggP2P := p2p.NewSocketP2PLayer(cfg, services, p2pLogger, metricsService.Registry())
+	aggP2P := p2p.NewSocketP2PLayer(cfg, ethWallet.PrivateKey(), services, p2pLogger, metricsService.Registry())
 
 	rpcServer := clientrpc.NewServer(cfg, logger)
 
@@ -143,13 +151,13 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger get
 	obscuroRelevantContracts := []gethcommon.Address{cfg.ManagementContractAddress, cfg.MessageBusAddress}
 	l1Repo := l1.NewL1Repository(l1Client, obscuroRelevantContracts, logger)
 
-	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Repo, enclaveClient, mgmtContractLib, ethWallet, rpcServer, logger, metricsService)
+	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Repo, enclaveClients, mgmtContractLib, ethWallet, rpcServer, logger, metricsService)
 }
 
 // NewHostContainer builds a host container with dependency injection rather than from config.
 // Useful for testing etc. (want to be able to pass in logger, and also have option to mock out dependencies)
-func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p2p hostcommon.P2PHostService, l1Client ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClient common.Enclave, contractLib mgmtcontractlib.MgmtContractLib, hostWallet wallet.Wallet, rpcServer clientrpc.Server, logger gethlog.Logger, metricsService *metrics.Service) *HostContainer {
-	h := host.NewHost(cfg, services, p2p, l1Client, l1Repo, enclaveClient, hostWallet, contractLib, logger, metricsService.Registry())
+func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p2p hostcommon.P2PHostService, l1Client ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, contractLib mgmtcontractlib.MgmtContractLib, hostWallet wallet.Wallet, rpcServer clientrpc.Server, logger gethlog.Logger, metricsService *metrics.Service) *HostContainer {
+	h := host.NewHost(cfg, services, p2p, l1Client, l1Repo, enclaveClients, hostWallet, contractLib, logger, metricsService.Registry())
 
 	hostContainer := &HostContainer{
 		host:           h,
@@ -202,6 +210,12 @@ func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p
 				Service:   clientapi.NewScanAPI(h, logger),
 				Public:    true,
 			},
+			{
+				Namespace: APINamespaceTen,
+				Version:   APIVersion1,
+				Service:   clientapi.NewTenAPI(h, logger),
+				Public:    true,
+			},
 		})
 
 		if cfg.DebugNamespaceEnabled {
@@ -209,7 +223,7 @@ func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p
 				{
 					Namespace: APINamespaceDebug,
 					Version:   APIVersion1,
-					Service:   clientapi.NewNetworkDebug(h),
+					Service:   clientapi.NewNetworkDebug(h, logger),
 					Public:    true,
 				},
 			})
@@ -27,6 +27,7 @@ var (
 	totalTransactionsKey    = []byte("t")
 	rollupHeaderPrefix      = []byte("rh")
 	rollupHeaderBlockPrefix = []byte("rhb")
+	rollupPublicationPrefix = []byte("rp")
 	tipRollupHash           = []byte("tr")
 	blockHeadedAtTip        = []byte("bht")
 )
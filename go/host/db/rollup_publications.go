@@ -0,0 +1,67 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// DB methods relating to the publication of rollups to the L1.
+
+// RollupPublication is the state of the L1 transaction that publishes a rollup. It is stored until the rollup is final,
+// so the publication can be resubmitted after a restart or if it is reorged out of the L1.
+type RollupPublication struct {
+	RollupHash     gethcommon.Hash
+	LastBatchSeqNo uint64
+	EncodedRollup  []byte // used to recreate the transaction when it has to be resubmitted
+	Nonce          uint64
+	TxHashes       []gethcommon.Hash // the transactions sent for the rollup, they share the nonce so at most one is included
+	Retries        uint64
+	FirstSentAt    uint64 // unix timestamp of the first submission
+	LastSentAt     uint64 // unix timestamp of the latest submission
+	// the L1 block in which the transaction was included, empty while it is pending
+	BlockHash   gethcommon.Hash
+	BlockNumber uint64
+}
+
+// IsIncluded returns true if the transaction was included in an L1 block
+func (p *RollupPublication) IsIncluded() bool {
+	return p.BlockHash != (gethcommon.Hash{})
+}
+
+// WriteRollupPublication adds or updates the publication of a rollup
+func (db *DB) WriteRollupPublication(publication *RollupPublication) error {
+	data, err := rlp.EncodeToBytes(publication)
+	if err != nil {
+		return fmt.Errorf("could not encode rollup publication. Cause: %w", err)
+	}
+	return db.kvStore.Put(rollupPublicationKey(publication.RollupHash), data)
+}
+
+// DeleteRollupPublication stops tracking the publication of a rollup
+func (db *DB) DeleteRollupPublication(rollupHash gethcommon.Hash) error {
+	return db.kvStore.Delete(rollupPublicationKey(rollupHash))
+}
+
+// GetRollupPublications returns the publications of all the rollups that are not final yet
+func (db *DB) GetRollupPublications() ([]*RollupPublication, error) {
+	it := db.kvStore.NewIterator(rollupPublicationPrefix, nil)
+	defer it.Release()
+
+	var publications []*RollupPublication
+	for it.Next() {
+		publication := new(RollupPublication)
+		if err := rlp.DecodeBytes(it.Value(), publication); err != nil {
+			return nil, fmt.Errorf("could not decode rollup publication. Cause: %w", err)
+		}
+		publications = append(publications, publication)
+	}
+	return publications, it.Error()
+}
+
+// rollupPublicationKey = rollupPublicationPrefix + rollup hash
+func rollupPublicationKey(hash gethcommon.Hash) []byte {
+	return append(rollupPublicationPrefix, hash.Bytes()...)
+}
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestCanStoreAndDeleteRollupPublications(t *testing.T) {
+	db := NewInMemoryDB(nil, nil)
+	publication := &RollupPublication{
+		RollupHash: gethcommon.BytesToHash([]byte{1}),
+		Nonce:      7,
+		TxHashes:   []gethcommon.Hash{gethcommon.BytesToHash([]byte{2})},
+	}
+	if err := db.WriteRollupPublication(publication); err != nil {
+		t.Fatalf("could not store rollup publication. Cause: %s", err)
+	}
+
+	publications, err := db.GetRollupPublications()
+	if err != nil {
+		t.Fatalf("could not retrieve rollup publications. Cause: %s", err)
+	}
+	if len(publications) != 1 || publications[0].Nonce != publication.Nonce || publications[0].TxHashes[0] != publication.TxHashes[0] {
+		t.Errorf("rollup publication was not stored correctly")
+	}
+
+	if err = db.DeleteRollupPublication(publication.RollupHash); err != nil {
+		t.Fatalf("could not delete rollup publication. Cause: %s", err)
+	}
+	publications, err = db.GetRollupPublications()
+	if err != nil {
+		t.Fatalf("could not retrieve rollup publications. Cause: %s", err)
+	}
+	if len(publications) != 0 {
+		t.Errorf("rollup publication was not deleted")
+	}
+}
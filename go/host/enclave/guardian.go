@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
@@ -44,6 +45,7 @@ type guardianServiceLocator interface {
 	L1Repo() host.L1BlockRepository
 	L2Repo() host.L2BatchRepository
 	LogSubs() host.LogSubscriptionManager
+	NewHeads() host.NewHeadsManager
 }
 
 // Guardian is a host service which monitors an enclave, it's responsibilities include:
@@ -72,6 +74,11 @@ type Guardian struct {
 	maxBatchInterval time.Duration
 	lastBatchCreated time.Time
 	enclaveID        *common.EnclaveID
+
+	// on a sequencer host, only one enclave at a time produces batches and rollups (see Service for the failover)
+	activeSequencer atomic.Bool
+	// whether a batch is being produced, in which case the head of the enclave is not known until it is done
+	producingBatch atomic.Bool
 }
 
 func NewGuardian(cfg *config.HostConfig, hostData host.Identity, serviceLocator guardianServiceLocator, enclaveClient common.Enclave, db *db.DB, interrupter *stopcontrol.StopControl, logger gethlog.Logger) *Guardian {
@@ -116,7 +123,7 @@ func (g *Guardian) Start() error {
 	go g.mainLoop()
 	if g.hostData.IsSequencer {
 		// if we are a sequencer then we need to start the periodic batch/rollup production
-		// Note: after HA work this will need additional check that we are the **active** sequencer enclave
+		// (the loops skip production while this is not the **active** sequencer enclave)
 		go g.periodicBatchProduction()
 		go g.periodicRollupProduction()
 	}
@@ -155,6 +162,30 @@ func (g *Guardian) HealthStatus() host.HealthStatus {
 	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
 }
 
+// IsActiveSequencer returns true if this is the enclave producing the batches and rollups of a sequencer host
+func (g *Guardian) IsActiveSequencer() bool {
+	return g.activeSequencer.Load()
+}
+
+func (g *Guardian) setActiveSequencer(active bool) {
+	g.activeSequencer.Store(active)
+}
+
+// producedL2Head returns the latest batch the enclave is known to have, which for the active sequencer can be ahead of
+// the batches it streamed to the host. It returns false while the enclave is producing a batch, as its head is unknown
+// until then.
+func (g *Guardian) producedL2Head() (*big.Int, bool) {
+	if g.producingBatch.Load() {
+		return nil, false
+	}
+	hostHead := g.state.GetHostL2Head()
+	enclaveHead := g.state.GetEnclaveL2Head()
+	if hostHead == nil || (enclaveHead != nil && enclaveHead.Cmp(hostHead) > 0) {
+		return enclaveHead, true
+	}
+	return hostHead, true
+}
+
 func (g *Guardian) GetEnclaveState() *StateTracker {
 	return g.state
 }
@@ -184,10 +215,10 @@ func (g *Guardian) HandleBlock(block *types.Block) {
 }
 
 // HandleBatch is called by the L2 repository when a new batch arrives
-// Note: this should only be called for validators, sequencers produce their own batches
+// Note: on a sequencer host, the batches come from the active enclave, and are only fed to the standby enclaves
 func (g *Guardian) HandleBatch(batch *common.ExtBatch) {
-	if g.hostData.IsSequencer {
-		g.logger.Error("Repo received batch but we are a sequencer, ignoring")
+	if g.hostData.IsSequencer && g.IsActiveSequencer() {
+		// the batch was produced by this enclave
 		return
 	}
 	g.logger.Debug("Received L2 block", log.BatchHashKey, batch.Hash(), log.BatchSeqNoKey, batch.Header.SequencerOrderNo)
@@ -254,17 +285,21 @@ func (g *Guardian) mainLoop() {
 			}
 		case Live:
 			// we're healthy: loop back to enclave status again after long monitoring interval
-			select {
-			case <-time.After(_monitoringInterval):
-				// loop back to check status
-			case <-g.hostInterrupter.Done():
-				// stop sleeping, we've been interrupted by the host stopping
-			}
+			g.sleepOrInterrupt(_monitoringInterval)
 		}
 	}
 	g.logger.Debug("stopping guardian main loop")
 }
 
+func (g *Guardian) sleepOrInterrupt(duration time.Duration) {
+	select {
+	case <-time.After(duration):
+		// loop back to check status
+	case <-g.hostInterrupter.Done():
+		// stop sleeping, we've been interrupted by the host stopping
+	}
+}
+
 func (g *Guardian) checkEnclaveStatus() {
 	s, err := g.enclaveClient.Status()
 	if err != nil {
@@ -386,8 +421,9 @@ func (g *Guardian) catchupWithL1() error {
 func (g *Guardian) catchupWithL2() error {
 	// while we are behind the L2 head and still running:
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L2Catchup {
-		if g.hostData.IsSequencer {
-			return errors.New("l2 catchup is not supported for sequencer")
+		if g.hostData.IsSequencer && g.IsActiveSequencer() {
+			// only the standby enclaves of a sequencer are fed the batches produced by the active one
+			return errors.New("l2 catchup is not supported for the active sequencer enclave")
 		}
 		// request the next batch by sequence number (based on what the enclave has been fed so far)
 		prevHead := g.state.GetEnclaveL2Head()
@@ -421,7 +457,12 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 		g.submitDataLock.Unlock() // lock must be released before returning
 		return false, fmt.Errorf("could not fetch obscuro receipts for block=%s - %w", block.Hash(), err)
 	}
-	resp, err := g.enclaveClient.SubmitL1Block(*block, receipts, isLatest)
+	blobs, err := g.sl.L1Repo().FetchObscuroBlobs(block)
+	if err != nil {
+		g.submitDataLock.Unlock() // lock must be released before returning
+		return false, fmt.Errorf("could not fetch obscuro blobs for block=%s - %w", block.Hash(), err)
+	}
+	resp, err := g.enclaveClient.SubmitL1Block(*block, receipts, blobs, isLatest)
 	g.submitDataLock.Unlock() // lock is only guarding the enclave call, so we can release it now
 	if err != nil {
 		if strings.Contains(err.Error(), errutil.ErrBlockAlreadyProcessed.Error()) {
@@ -537,6 +578,9 @@ func (g *Guardian) periodicBatchProduction() {
 		}
 		select {
 		case <-batchProdTicker.C:
+			if !g.IsActiveSequencer() {
+				continue
+			}
 			if !g.state.InSyncWithL1() {
 				// if we're behind the L1, we don't want to produce batches
 				g.logger.Debug("Skipping batch production because L1 is not up to date")
@@ -546,10 +590,16 @@ func (g *Guardian) periodicBatchProduction() {
 			// if maxBatchInterval is set higher than batchInterval then we are happy to skip creating batches when there is no data
 			// (up to a maximum time of maxBatchInterval)
 			skipBatchIfEmpty := g.maxBatchInterval > g.batchInterval && time.Since(g.lastBatchCreated) < g.maxBatchInterval
+			g.producingBatch.Store(true)
 			err := g.enclaveClient.CreateBatch(skipBatchIfEmpty)
 			if err != nil {
 				g.logger.Error("Unable to produce batch", log.ErrKey, err)
 			}
+			// the head of the enclave is recorded before the batch is streamed, so a standby enclave is not promoted
+			// until it has the batch. A batch produced without the host learning about it is never published, as the
+			// batches of a demoted enclave are ignored
+			g.checkEnclaveStatus()
+			g.producingBatch.Store(false)
 		case <-g.hostInterrupter.Done():
 			// interrupted - end periodic process
 			batchProdTicker.Stop()
@@ -570,11 +620,19 @@ func (g *Guardian) periodicRollupProduction() {
 	for {
 		select {
 		case <-rollupCheckTicker.C:
+			if !g.IsActiveSequencer() {
+				continue
+			}
 			if !g.state.IsUpToDate() {
 				// if we're behind the L1, we don't want to produce rollups
 				g.logger.Debug("skipping rollup production because L1 is not up to date", "state", g.state)
 				continue
 			}
+			if g.sl.L1Publisher().IsRollupPending() {
+				// the next rollup starts from the last batch on the L1, so it has to wait for the pending one
+				g.logger.Debug("skipping rollup production because the previous rollup is pending")
+				continue
+			}
 
 			fromBatch, err := g.getLatestBatchNo()
 			if err != nil {
@@ -596,16 +654,18 @@ func (g *Guardian) periodicRollupProduction() {
 			// produce and issue rollup when either:
 			// it has passed g.rollupInterval from last lastSuccessfulRollup
 			// or the size of accumulated batches is > g.maxRollupSize
+			// or the previous rollup could not be published
 			timeExpired := time.Since(lastSuccessfulRollup) > g.rollupInterval
 			sizeExceeded := estimatedRunningRollupSize >= g.maxRollupSize
-			if timeExpired || sizeExceeded {
-				g.logger.Info("Trigger rollup production.", "timeExpired", timeExpired, "sizeExceeded", sizeExceeded)
+			republish := g.sl.L1Publisher().RollupRepublishRequired()
+			if timeExpired || sizeExceeded || republish {
+				g.logger.Info("Trigger rollup production.", "timeExpired", timeExpired, "sizeExceeded", sizeExceeded, "republish", republish)
 				producedRollup, err := g.enclaveClient.CreateRollup(fromBatch)
 				if err != nil {
 					g.logger.Error("Unable to create rollup", log.BatchSeqNoKey, fromBatch, log.ErrKey, err)
 					continue
 				}
-				// this method waits until the receipt is received
+				// the publisher tracks the rollup tx until it is final
 				g.sl.L1Publisher().PublishRollup(producedRollup)
 				lastSuccessfulRollup = time.Now()
 			}
@@ -636,7 +696,12 @@ func (g *Guardian) streamEnclaveData() {
 				continue
 			}
 
-			if resp.Batch != nil { //nolint:nestif
+			if resp.Batch != nil && g.hostData.IsSequencer && !g.IsActiveSequencer() {
+				// batches from a standby sequencer enclave were already received from the active one, or were produced
+				// just before it was demoted and must not be published
+				g.logger.Debug("Ignoring batch from standby enclave", log.BatchSeqNoKey, resp.Batch.Header.SequencerOrderNo)
+				g.state.OnProcessedBatch(resp.Batch.Header.SequencerOrderNo)
+			} else if resp.Batch != nil { //nolint:nestif
 				lastBatch = resp.Batch
 				g.logger.Trace("Received batch from stream", log.BatchHashKey, lastBatch.Hash())
 				err := g.sl.L2Repo().AddBatch(resp.Batch)
@@ -657,6 +722,7 @@ func (g *Guardian) streamEnclaveData() {
 					g.logger.Debug("Received batch from enclave", log.BatchSeqNoKey, resp.Batch.Header.SequencerOrderNo, log.BatchHashKey, resp.Batch.Hash())
 				}
 				g.state.OnProcessedBatch(resp.Batch.Header.SequencerOrderNo)
+				g.sl.NewHeads().SendNewHead(resp.Batch.Header)
 			}
 
 			if resp.Logs != nil {
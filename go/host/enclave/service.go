@@ -1,9 +1,13 @@
 package enclave
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -20,34 +24,76 @@ type enclaveServiceLocator interface {
 }
 
 // Service is a host service that provides access to the enclave(s) - it handles failover, load balancing, circuit breaking when a host has multiple enclaves
+//
+// All the enclaves of a host are fed the same L1 and L2 data by their guardians, so requests can be served by any
+// enclave that is up-to-date. On a sequencer host only one enclave (the active one) produces batches and rollups, and
+// the standby enclaves are fed the batches it produces. If it becomes unavailable, a standby enclave is promoted - but
+// only if it already has every batch the active enclave produced, otherwise its next batch would compete with one the
+// network has already seen. Each enclave of the sequencer is attested under the sequencer ID, so validators accept the
+// batches of the promoted enclave.
 type Service struct {
 	hostData host.Identity
 	sl       enclaveServiceLocator
-	// eventually this service will support multiple enclaves for HA, but currently there's only one
-	// The service goes via the Guardian to talk to the enclave (because guardian knows if the enclave is healthy etc.)
-	enclaveGuardian *Guardian
+	// The service goes via the Guardians to talk to the enclaves (because guardians know if the enclaves are healthy etc.)
+	enclaveGuardians []*Guardian
+	// the guardian of the enclave producing the batches, only set on a sequencer host
+	activeSequencer atomic.Pointer[Guardian]
+	// enclaves that were demoted may hold batches that were never published, so they are never promoted again
+	demoted map[*Guardian]bool
+
+	// subscriptions live in the enclave that created them, so unsubscribe requests must be sent to the same enclave
+	subscriptions     map[rpc.ID]*Guardian
+	subscriptionsLock sync.Mutex
 
 	running atomic.Bool
 	logger  gethlog.Logger
 }
 
-func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardian *Guardian, logger gethlog.Logger) *Service {
+func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardians []*Guardian, logger gethlog.Logger) *Service {
 	return &Service{
-		hostData:        hostData,
-		sl:              serviceLocator,
-		enclaveGuardian: enclaveGuardian,
-		logger:          logger,
+		hostData:         hostData,
+		sl:               serviceLocator,
+		enclaveGuardians: enclaveGuardians,
+		demoted:          map[*Guardian]bool{},
+		subscriptions:    map[rpc.ID]*Guardian{},
+		logger:           logger,
 	}
 }
 
 func (e *Service) Start() error {
 	e.running.Store(true)
-	return e.enclaveGuardian.Start()
+	if e.hostData.IsSequencer {
+		e.enclaveGuardians[0].setActiveSequencer(true)
+		e.activeSequencer.Store(e.enclaveGuardians[0])
+	}
+
+	// the host can start as soon as the first enclave is available, the guardians of the others wait for them
+	if err := e.enclaveGuardians[0].Start(); err != nil {
+		return err
+	}
+	for _, guardian := range e.enclaveGuardians[1:] {
+		go func(guardian *Guardian) {
+			if err := guardian.Start(); err != nil {
+				e.logger.Error("Could not start enclave guardian", log.ErrKey, err)
+			}
+		}(guardian)
+	}
+
+	if e.hostData.IsSequencer && len(e.enclaveGuardians) > 1 {
+		go e.monitorActiveSequencer()
+	}
+	return nil
 }
 
 func (e *Service) Stop() error {
 	e.running.Store(false)
-	return e.enclaveGuardian.Stop()
+	var errs []error
+	for _, guardian := range e.enclaveGuardians {
+		if err := guardian.Stop(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func (e *Service) HealthStatus() host.HealthStatus {
@@ -55,41 +101,64 @@ func (e *Service) HealthStatus() host.HealthStatus {
 		return &host.BasicErrHealthStatus{ErrMsg: "not running"}
 	}
 
-	// check the enclave health, which in turn checks the DB health
-	enclaveHealthy, err := e.enclaveGuardian.enclaveClient.HealthCheck()
-	if err != nil {
-		return &host.BasicErrHealthStatus{ErrMsg: fmt.Sprintf("unable to HealthCheck enclave - %s", err.Error())}
-	} else if !enclaveHealthy {
-		return &host.BasicErrHealthStatus{ErrMsg: "enclave reported itself as not healthy"}
+	// a sequencer host is only healthy if the enclave producing the batches is
+	if e.hostData.IsSequencer {
+		if err := enclaveHealth(e.activeSequencer.Load()); err != nil {
+			return &host.BasicErrHealthStatus{ErrMsg: err.Error()}
+		}
+		return &host.BasicErrHealthStatus{ErrMsg: ""}
 	}
 
-	if !e.enclaveGuardian.GetEnclaveState().InSyncWithL1() {
-		return &host.BasicErrHealthStatus{ErrMsg: "enclave not in sync with L1"}
+	// other hosts are healthy as long as one of their enclaves is
+	errMsgs := make([]string, 0, len(e.enclaveGuardians))
+	for _, guardian := range e.enclaveGuardians {
+		err := enclaveHealth(guardian)
+		if err == nil {
+			// empty error msg means healthy
+			return &host.BasicErrHealthStatus{ErrMsg: ""}
+		}
+		errMsgs = append(errMsgs, err.Error())
 	}
-
-	// empty error msg means healthy
-	return &host.BasicErrHealthStatus{ErrMsg: ""}
+	return &host.BasicErrHealthStatus{ErrMsg: strings.Join(errMsgs, "; ")}
 }
 
 // LookupBatchBySeqNo is used to fetch batch data from the enclave - it is only used as a fallback for the sequencer
 // host if it's missing a batch (other host services should use L2Repo to fetch batch data)
 func (e *Service) LookupBatchBySeqNo(seqNo *big.Int) (*common.ExtBatch, error) {
-	state := e.enclaveGuardian.GetEnclaveState()
-	if state.GetEnclaveL2Head().Cmp(seqNo) < 0 {
-		return nil, errutil.ErrNotFound
+	for _, guardian := range e.guardiansByPreference() {
+		state := guardian.GetEnclaveState()
+		if !state.InSyncWithL1() {
+			continue
+		}
+		if l2Head := state.GetEnclaveL2Head(); l2Head == nil || l2Head.Cmp(seqNo) < 0 {
+			continue
+		}
+		return guardian.GetEnclaveClient().GetBatchBySeqNo(seqNo.Uint64())
 	}
-	client := e.enclaveGuardian.GetEnclaveClient()
-	return client.GetBatchBySeqNo(seqNo.Uint64())
+	return nil, errutil.ErrNotFound
 }
 
 func (e *Service) GetEnclaveClient() common.Enclave {
-	return e.enclaveGuardian.GetEnclaveClient()
+	return e.routeRequest().GetEnclaveClient()
 }
 
 func (e *Service) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error) {
 	encryptedTx := common.EncryptedTx(encryptedParams)
 
-	enclaveResponse, sysError := e.enclaveGuardian.GetEnclaveClient().SubmitTx(encryptedTx)
+	// on a sequencer the transaction must reach the mempool of the enclave producing the batches
+	guardian := e.routeRequest()
+	if e.hostData.IsSequencer {
+		guardian = e.activeSequencer.Load()
+		// the standby enclaves keep the transaction in their mempool too (like the transactions gossiped by the
+		// validators), so it is not lost if one of them takes over
+		for _, standby := range e.enclaveGuardians {
+			if standby != guardian {
+				go standby.HandleTransaction(encryptedTx)
+			}
+		}
+	}
+
+	enclaveResponse, sysError := guardian.GetEnclaveClient().SubmitTx(encryptedTx)
 	if sysError != nil {
 		e.logger.Warn("Could not submit transaction due to sysError.", log.ErrKey, sysError)
 		return nil, sysError
@@ -110,9 +179,116 @@ func (e *Service) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSen
 }
 
 func (e *Service) Subscribe(id rpc.ID, encryptedParams common.EncryptedParamsLogSubscription) error {
-	return e.enclaveGuardian.GetEnclaveClient().Subscribe(id, encryptedParams)
+	guardian := e.routeRequest()
+	if err := guardian.GetEnclaveClient().Subscribe(id, encryptedParams); err != nil {
+		return err
+	}
+	e.subscriptionsLock.Lock()
+	defer e.subscriptionsLock.Unlock()
+	e.subscriptions[id] = guardian
+	return nil
 }
 
 func (e *Service) Unsubscribe(id rpc.ID) error {
-	return e.enclaveGuardian.GetEnclaveClient().Unsubscribe(id)
+	e.subscriptionsLock.Lock()
+	guardian, found := e.subscriptions[id]
+	delete(e.subscriptions, id)
+	e.subscriptionsLock.Unlock()
+
+	if !found {
+		guardian = e.routeRequest()
+	}
+	return guardian.GetEnclaveClient().Unsubscribe(id)
+}
+
+// routeRequest returns the guardian of the enclave that should serve a request: the first enclave (by preference) that
+// is up-to-date, otherwise the first one that is in sync with the L1, otherwise the preferred one.
+func (e *Service) routeRequest() *Guardian {
+	guardians := e.guardiansByPreference()
+	for _, guardian := range guardians {
+		if guardian.GetEnclaveState().IsUpToDate() {
+			return guardian
+		}
+	}
+	for _, guardian := range guardians {
+		if guardian.GetEnclaveState().InSyncWithL1() {
+			return guardian
+		}
+	}
+	return guardians[0]
+}
+
+// guardiansByPreference returns the guardians in the order they should serve requests. On a sequencer host the active
+// enclave comes first, because the standby enclaves receive its batches after they were produced.
+func (e *Service) guardiansByPreference() []*Guardian {
+	active := e.activeSequencer.Load()
+	if active == nil {
+		return e.enclaveGuardians
+	}
+	guardians := []*Guardian{active}
+	for _, guardian := range e.enclaveGuardians {
+		if guardian != active {
+			guardians = append(guardians, guardian)
+		}
+	}
+	return guardians
+}
+
+// monitorActiveSequencer promotes a standby enclave when the active sequencer enclave becomes unavailable
+func (e *Service) monitorActiveSequencer() {
+	for e.running.Load() {
+		time.Sleep(_monitoringInterval)
+
+		active := e.activeSequencer.Load()
+		status := active.GetEnclaveState().GetStatus()
+		if status != Disconnected && status != Unavailable {
+			continue
+		}
+		e.failover(active)
+	}
+}
+
+func (e *Service) failover(failed *Guardian) {
+	// the latest batch the failed enclave produced, including the ones the host did not receive yet. Any enclave
+	// taking over must already have it
+	lastBatch, known := failed.producedL2Head()
+	if !known {
+		e.logger.Warn("Active sequencer enclave is unavailable while producing a batch, waiting for the outcome before promoting a standby enclave")
+		return
+	}
+	for _, candidate := range e.enclaveGuardians {
+		if candidate == failed || e.demoted[candidate] || !candidate.GetEnclaveState().InSyncWithL1() {
+			continue
+		}
+		candidateHead := candidate.GetEnclaveState().GetEnclaveL2Head()
+		if lastBatch != nil && (candidateHead == nil || candidateHead.Cmp(lastBatch) < 0) {
+			continue
+		}
+
+		// stop the failed enclave from producing before the candidate starts, so there is never more than one producer
+		failed.setActiveSequencer(false)
+		e.demoted[failed] = true
+		candidate.setActiveSequencer(true)
+		e.activeSequencer.Store(candidate)
+		e.logger.Warn("Active sequencer enclave is unavailable, promoted a standby enclave",
+			"failed", failed.enclaveID, "promoted", candidate.enclaveID, log.BatchSeqNoKey, lastBatch)
+		return
+	}
+	e.logger.Error("Active sequencer enclave is unavailable and no standby enclave can safely take over", log.BatchSeqNoKey, lastBatch)
+}
+
+// enclaveHealth returns an error if the enclave is not healthy
+func enclaveHealth(guardian *Guardian) error {
+	// check the enclave health, which in turn checks the DB health
+	enclaveHealthy, err := guardian.GetEnclaveClient().HealthCheck()
+	if err != nil {
+		return fmt.Errorf("unable to HealthCheck enclave - %s", err.Error())
+	} else if !enclaveHealthy {
+		return errors.New("enclave reported itself as not healthy")
+	}
+
+	if !guardian.GetEnclaveState().InSyncWithL1() {
+		return errors.New("enclave not in sync with L1")
+	}
+	return nil
 }
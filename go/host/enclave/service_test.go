@@ -0,0 +1,70 @@
+package enclave
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+)
+
+func TestService_FailoverOnlyPromotesEnclaveWithAllBatches(t *testing.T) {
+	active := guardianWithL2Head(_l2Batch457)
+	active.GetEnclaveState().OnDisconnected()
+	behind := guardianWithL2Head(_l2Batch456)
+	upToDate := guardianWithL2Head(_l2Batch457)
+
+	s := NewService(host.Identity{IsSequencer: true}, nil, []*Guardian{active, behind, upToDate}, stateTrackerLogger)
+	active.setActiveSequencer(true)
+	s.activeSequencer.Store(active)
+
+	s.failover(active)
+	assert.Equal(t, upToDate, s.activeSequencer.Load())
+	assert.False(t, active.IsActiveSequencer())
+	assert.False(t, behind.IsActiveSequencer())
+	assert.True(t, upToDate.IsActiveSequencer())
+
+	// the demoted enclave is never promoted again, even once it has recovered
+	active.GetEnclaveState().OnProcessedBatch(big.NewInt(458))
+	upToDate.GetEnclaveState().OnDisconnected()
+	s.failover(upToDate)
+	assert.Equal(t, upToDate, s.activeSequencer.Load())
+}
+
+func TestService_FailoverWaitsForTheBatchesTheFailedEnclaveProducedButDidNotStream(t *testing.T) {
+	// the active enclave produced batch 458, but failed before streaming it to the host
+	active := guardianWithL2Head(_l2Batch457)
+	active.GetEnclaveState().OnEnclaveStatus(common.Status{StatusCode: common.Running, L1Head: _l1Block123, L2Head: big.NewInt(458)})
+	active.GetEnclaveState().OnDisconnected()
+	behind := guardianWithL2Head(_l2Batch457)
+
+	s := NewService(host.Identity{IsSequencer: true}, nil, []*Guardian{active, behind}, stateTrackerLogger)
+	active.setActiveSequencer(true)
+	s.activeSequencer.Store(active)
+
+	s.failover(active)
+	assert.Equal(t, active, s.activeSequencer.Load())
+	assert.False(t, behind.IsActiveSequencer())
+
+	// no enclave is promoted while the failed enclave is producing a batch, as it may be ahead of what it reported
+	behind.GetEnclaveState().OnProcessedBatch(big.NewInt(458))
+	active.producingBatch.Store(true)
+	s.failover(active)
+	assert.Equal(t, active, s.activeSequencer.Load())
+
+	// the standby is promoted once it has every batch the failed enclave produced
+	active.producingBatch.Store(false)
+	s.failover(active)
+	assert.Equal(t, behind, s.activeSequencer.Load())
+	assert.True(t, behind.IsActiveSequencer())
+	assert.False(t, active.IsActiveSequencer())
+}
+
+func guardianWithL2Head(l2Head *big.Int) *Guardian {
+	g := &Guardian{state: NewStateTracker(stateTrackerLogger)}
+	g.state.OnReceivedBlock(_l1Block123)
+	g.state.OnProcessedBlock(_l1Block123)
+	g.state.OnProcessedBatch(l2Head)
+	return g
+}
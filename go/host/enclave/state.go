@@ -187,6 +187,16 @@ func (s *StateTracker) GetEnclaveL2Head() *big.Int {
 	return big.NewInt(0).SetBytes(s.enclaveL2Head.Bytes())
 }
 
+// GetHostL2Head returns the latest batch seen by the host, which for a sequencer is the latest batch its enclave produced
+func (s *StateTracker) GetHostL2Head() *big.Int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.hostL2Head == nil {
+		return nil
+	}
+	return big.NewInt(0).SetBytes(s.hostL2Head.Bytes())
+}
+
 // this must be called from within write-lock
 func (s *StateTracker) setStatus(newStatus Status) {
 	if s.status == newStatus {
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"math/big"
+	"sync"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	commonsubscription "github.com/ten-protocol/go-ten/go/common/subscription"
+)
+
+// NewHeadsManager feeds the new head batches to their subscribers (e.g. the `newHeads` subscriptions of the clients).
+// The enclaves of a host all stream the batches they process, so a batch is only sent once, when it is the new head.
+type NewHeadsManager struct {
+	subscribers *commonsubscription.Manager[chan *common.BatchHeader]
+	headLock    sync.Mutex
+	head        *big.Int // the seq no of the latest head sent to the subscribers
+	logger      gethlog.Logger
+}
+
+func NewNewHeadsManager(logger gethlog.Logger) *NewHeadsManager {
+	return &NewHeadsManager{
+		subscribers: commonsubscription.NewManager[chan *common.BatchHeader](),
+		logger:      logger,
+	}
+}
+
+func (n *NewHeadsManager) Start() error {
+	return nil
+}
+
+func (n *NewHeadsManager) Stop() error {
+	return nil
+}
+
+func (n *NewHeadsManager) HealthStatus() host.HealthStatus {
+	// always healthy
+	return &host.BasicErrHealthStatus{ErrMsg: ""}
+}
+
+func (n *NewHeadsManager) SubscribeNewHeads(ch chan *common.BatchHeader) func() {
+	return n.subscribers.Subscribe(ch)
+}
+
+func (n *NewHeadsManager) SendNewHead(header *common.BatchHeader) {
+	n.headLock.Lock()
+	if n.head != nil && header.SequencerOrderNo.Cmp(n.head) <= 0 {
+		n.headLock.Unlock()
+		return
+	}
+	n.head = header.SequencerOrderNo
+	n.headLock.Unlock()
+
+	for _, ch := range n.subscribers.Subscribers() {
+		// a slow subscriber must not hold up the enclave stream, it misses the heads it can't keep up with
+		select {
+		case ch <- header:
+		default:
+			n.logger.Debug("New heads subscriber is not keeping up, dropping head", log.BatchSeqNoKey, header.SequencerOrderNo)
+		}
+	}
+}
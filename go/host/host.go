@@ -49,7 +49,7 @@ type host struct {
 	l2MessageBusAddress *gethcommon.Address
 }
 
-func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClient common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry) hostcommon.Host {
+func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry) hostcommon.Host {
 	database, err := db.CreateDBFromConfig(config, regMetrics, logger)
 	if err != nil {
 		logger.Crit("unable to create database for host", log.ErrKey, err)
@@ -72,20 +72,31 @@ func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p host
 		stopControl: stopcontrol.New(),
 	}
 
-	enclGuardian := enclave.NewGuardian(config, hostIdentity, hostServices, enclaveClient, database, host.stopControl, logger)
-	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardian, logger)
+	enclGuardians := make([]*enclave.Guardian, len(enclaveClients))
+	for i, enclaveClient := range enclaveClients {
+		guardianIdentity := hostIdentity
+		if i > 0 {
+			// only the first enclave of the genesis host generates the network secret, the others request it like any
+			// other enclave joining the network
+			guardianIdentity.IsGenesis = false
+		}
+		enclGuardians[i] = enclave.NewGuardian(config, guardianIdentity, hostServices, enclaveClient, database, host.stopControl, logger)
+	}
+	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardians, logger)
 	l2Repo := l2.NewBatchRepository(config, hostServices, database, logger)
 	subsService := events.NewLogEventManager(hostServices, logger)
+	headsService := events.NewNewHeadsManager(logger)
 
 	hostServices.RegisterService(hostcommon.P2PName, p2p)
 	hostServices.RegisterService(hostcommon.L1BlockRepositoryName, l1Repo)
 	maxWaitForL1Receipt := 6 * config.L1BlockTime   // wait ~10 blocks to see if tx gets published before retrying
 	retryIntervalForL1Receipt := config.L1BlockTime // retry ~every block
-	l1Publisher := l1.NewL1Publisher(hostIdentity, ethWallet, ethClient, mgmtContractLib, l1Repo, host.stopControl, logger, maxWaitForL1Receipt, retryIntervalForL1Receipt)
+	l1Publisher := l1.NewL1Publisher(hostIdentity, ethWallet, ethClient, mgmtContractLib, l1Repo, database, host.stopControl, logger, maxWaitForL1Receipt, retryIntervalForL1Receipt, config.UseBlobsForRollups)
 	hostServices.RegisterService(hostcommon.L1PublisherName, l1Publisher)
 	hostServices.RegisterService(hostcommon.L2BatchRepositoryName, l2Repo)
 	hostServices.RegisterService(hostcommon.EnclaveServiceName, enclService)
 	hostServices.RegisterService(hostcommon.LogSubscriptionServiceName, subsService)
+	hostServices.RegisterService(hostcommon.NewHeadsServiceName, headsService)
 
 	var prof *profiler.Profiler
 	if config.ProfilerEnabled {
@@ -160,6 +171,10 @@ func (h *host) Unsubscribe(id rpc.ID) {
 	h.services.LogSubs().Unsubscribe(id)
 }
 
+func (h *host) SubscribeNewHeads(ch chan *common.BatchHeader) func() {
+	return h.services.NewHeads().SubscribeNewHeads(ch)
+}
+
 func (h *host) Stop() error {
 	// block all incoming requests
 	h.stopControl.Stop()
@@ -181,6 +196,10 @@ func (h *host) Stop() error {
 	return nil
 }
 
+func (h *host) Done() <-chan interface{} {
+	return h.stopControl.Done()
+}
+
 // HealthCheck returns whether the host, enclave and DB are healthy
 func (h *host) HealthCheck() (*hostcommon.HealthCheck, error) {
 	if h.stopControl.IsStopping() {
@@ -220,6 +220,22 @@ func (r *Repository) FetchBlockByHeight(height *big.Int) (*types.Block, error) {
 	return r.ethClient.BlockByNumber(height)
 }
 
+// FetchObscuroBlobs returns the blobs of the obscuro-relevant blob transactions of an L1 block (e.g. rollups published with blobs)
+func (r *Repository) FetchObscuroBlobs(block *common.L1Block) (common.L1Blobs, error) {
+	var blobs common.L1Blobs
+	for _, transaction := range block.Transactions() {
+		if len(transaction.BlobHashes()) == 0 || !r.isObscuroTransaction(transaction) {
+			continue
+		}
+		txBlobs, err := r.ethClient.BlobsByHashes(block, transaction.BlobHashes())
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch blobs for tx=%s - %w", transaction.Hash(), err)
+		}
+		blobs = append(blobs, txBlobs...)
+	}
+	return blobs, nil
+}
+
 // isObscuroTransaction will look at the 'to' address of the transaction, we are only interested in management contract and bridge transactions
 func (r *Repository) isObscuroTransaction(transaction *types.Transaction) bool {
 	for _, address := range r.obscuroRelevantContracts {
@@ -19,6 +19,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/retry"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/host/db"
 	"github.com/ten-protocol/go-ten/go/wallet"
 )
 
@@ -40,6 +41,10 @@ type Publisher struct {
 
 	maxWaitForL1Receipt       time.Duration
 	retryIntervalForL1Receipt time.Duration
+
+	useBlobsForRollups bool // whether the rollup payloads are published as blobs instead of calldata
+
+	rollupTracker *rollupTracker // tracks the rollup transactions until they are final
 }
 
 func NewL1Publisher(
@@ -48,10 +53,12 @@ func NewL1Publisher(
 	client ethadapter.EthClient,
 	mgmtContract mgmtcontractlib.MgmtContractLib,
 	repository host.L1BlockRepository,
+	database *db.DB,
 	hostStopper *stopcontrol.StopControl,
 	logger gethlog.Logger,
 	maxWaitForL1Receipt time.Duration,
 	retryIntervalForL1Receipt time.Duration,
+	useBlobsForRollups bool,
 ) *Publisher {
 	return &Publisher{
 		hostData:                  hostData,
@@ -63,6 +70,8 @@ func NewL1Publisher(
 		logger:                    logger,
 		maxWaitForL1Receipt:       maxWaitForL1Receipt,
 		retryIntervalForL1Receipt: retryIntervalForL1Receipt,
+		useBlobsForRollups:        useBlobsForRollups,
+		rollupTracker:             newRollupTracker(database, client, maxWaitForL1Receipt, logger),
 
 		importantContractAddresses: map[string]gethcommon.Address{},
 		importantAddressesMutex:    sync.RWMutex{},
@@ -77,6 +86,13 @@ func (p *Publisher) Start() error {
 			p.logger.Error("Could not load important contract addresses", log.ErrKey, err)
 		}
 	}()
+
+	if p.hostData.IsSequencer {
+		if err := p.rollupTracker.load(); err != nil {
+			return err
+		}
+		go p.trackRollupPublications()
+	}
 	return nil
 }
 
@@ -85,10 +101,11 @@ func (p *Publisher) Stop() error {
 }
 
 func (p *Publisher) HealthStatus() host.HealthStatus {
-	// todo (@matt) do proper health status based on failed transactions or something
 	errMsg := ""
 	if p.hostStopper.IsStopping() {
 		errMsg = "not running"
+	} else if err := p.rollupTracker.healthError(); err != nil {
+		errMsg = err.Error()
 	}
 	return &host.BasicErrHealthStatus{ErrMsg: errMsg}
 }
@@ -197,9 +214,6 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 	if err != nil {
 		p.logger.Crit("could not encode rollup.", log.ErrKey, err)
 	}
-	tx := &ethadapter.L1RollupTx{
-		Rollup: encRollup,
-	}
 	p.logger.Info("Publishing rollup", "size", len(encRollup)/1024, log.RollupHashKey, producedRollup.Hash())
 
 	p.logger.Trace("Sending transaction to publish rollup", "rollup_header",
@@ -212,13 +226,75 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 			return string(header)
 		}}, log.RollupHashKey, producedRollup.Header.Hash(), "batches_len", len(producedRollup.BatchPayloads))
 
-	rollupTx := p.mgmtContractLib.CreateRollup(tx)
-
-	err = p.publishTransaction(rollupTx)
+	now := uint64(time.Now().Unix())
+	publication := &db.RollupPublication{
+		RollupHash:     producedRollup.Hash(),
+		LastBatchSeqNo: producedRollup.Header.LastBatchSeqNo,
+		EncodedRollup:  encRollup,
+		Nonce:          p.hostWallet.GetNonceAndIncrement(),
+		FirstSentAt:    now,
+		LastSentAt:     now,
+	}
+	signedTx, err := p.sendRollupTx(publication)
 	if err != nil {
-		p.logger.Error("Could not issue rollup tx", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
+		p.hostWallet.SetNonce(publication.Nonce) // revert the wallet nonce because we failed to send the transaction
+		p.rollupTracker.failed(publication.RollupHash, err)
+		return
+	}
+	publication.TxHashes = []gethcommon.Hash{signedTx.Hash()}
+	p.rollupTracker.track(publication)
+}
+
+// IsRollupPending returns true while a published rollup is not included in the canonical L1 chain
+func (p *Publisher) IsRollupPending() bool {
+	return p.rollupTracker.isPending()
+}
+
+// RollupRepublishRequired returns true if a rollup could not be published since the last call
+func (p *Publisher) RollupRepublishRequired() bool {
+	return p.rollupTracker.takeRepublishRequest()
+}
+
+// sendRollupTx signs and sends the transaction of the rollup publication, with the fees bumped for its retries
+func (p *Publisher) sendRollupTx(publication *db.RollupPublication) (*types.Transaction, error) {
+	tx := &ethadapter.L1RollupTx{Rollup: publication.EncodedRollup}
+	var rollupTx types.TxData
+	if p.useBlobsForRollups {
+		blobTx, err := p.mgmtContractLib.CreateBlobRollup(tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create blob rollup tx")
+		}
+		rollupTx = blobTx
 	} else {
-		p.logger.Info("Rollup included in L1", log.RollupHashKey, producedRollup.Hash())
+		rollupTx = p.mgmtContractLib.CreateRollup(tx)
+	}
+
+	rollupTx, err := p.ethClient.PrepareTransactionToRetry(rollupTx, p.hostWallet.Address(), publication.Nonce, int(publication.Retries))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not estimate gas/gas price for rollup tx")
+	}
+	signedTx, err := p.hostWallet.SignTransaction(rollupTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign rollup tx")
+	}
+	p.logger.Info("Host issuing rollup tx", log.TxKey, signedTx.Hash(), "size", signedTx.Size()/1024, "retries", publication.Retries)
+	if err = p.ethClient.SendTransaction(signedTx); err != nil {
+		return nil, errors.Wrap(err, "could not broadcast rollup tx")
+	}
+	return signedTx, nil
+}
+
+// trackRollupPublications checks the rollup transactions every retry interval until the host stops
+func (p *Publisher) trackRollupPublications() {
+	ticker := time.NewTicker(p.retryIntervalForL1Receipt)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.rollupTracker.checkPublications(p.sendRollupTx)
+		case <-p.hostStopper.Done():
+			return
+		}
 	}
 }
 
@@ -253,6 +329,18 @@ func (p *Publisher) FetchLatestPeersList() ([]string, error) {
 	return filteredHostAddresses, nil
 }
 
+func (p *Publisher) IsHostAttested(hostID gethcommon.Address) (bool, error) {
+	msg, err := p.mgmtContractLib.GetAttestedMsg(hostID)
+	if err != nil {
+		return false, err
+	}
+	response, err := p.ethClient.CallContract(msg)
+	if err != nil {
+		return false, err
+	}
+	return p.mgmtContractLib.DecodeAttestedResponse(response)
+}
+
 func (p *Publisher) GetImportantContracts() map[string]gethcommon.Address {
 	p.importantAddressesMutex.RLock()
 	defer p.importantAddressesMutex.RUnlock()
@@ -0,0 +1,236 @@
+package l1
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/db"
+)
+
+const (
+	// a rollup is final once it is this many blocks deep in the L1, after which it is no longer tracked
+	rollupFinalityDepth = 64
+	// the publication of a rollup is reported as unhealthy once it has been pending for this many receipt timeouts
+	maxRollupPendingTimeouts = 5
+)
+
+// resubmitFunc sends the transaction of the publication again, with the fees bumped for the publication's retries
+type resubmitFunc func(publication *db.RollupPublication) (*types.Transaction, error)
+
+// rollupTracker tracks the L1 transactions that publish rollups until they are final. Transactions that are not
+// included in time are resubmitted with higher fees, and transactions that are reorged out of the L1 are tracked again
+// until they are included in the canonical chain.
+// If a rollup can't be published (its transaction failed or was replaced) it is dropped, and the sequencer is asked to
+// produce a new rollup from the latest batch seq no on the L1.
+type rollupTracker struct {
+	db                  *db.DB
+	ethClient           ethadapter.EthClient
+	maxWaitForL1Receipt time.Duration
+	logger              gethlog.Logger
+
+	lock         sync.Mutex
+	publications map[gethcommon.Hash]*db.RollupPublication // the publications that are not final, by rollup hash
+	lastFailure  error                                     // the cause of the latest failed publication, cleared by the next inclusion
+
+	republishRequired atomic.Bool
+}
+
+func newRollupTracker(database *db.DB, ethClient ethadapter.EthClient, maxWaitForL1Receipt time.Duration, logger gethlog.Logger) *rollupTracker {
+	return &rollupTracker{
+		db:                  database,
+		ethClient:           ethClient,
+		maxWaitForL1Receipt: maxWaitForL1Receipt,
+		logger:              logger,
+		publications:        map[gethcommon.Hash]*db.RollupPublication{},
+	}
+}
+
+// load restores the publications that were not final when the host stopped
+func (t *rollupTracker) load() error {
+	publications, err := t.db.GetRollupPublications()
+	if err != nil {
+		return fmt.Errorf("could not load rollup publications. Cause: %w", err)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, publication := range publications {
+		t.publications[publication.RollupHash] = publication
+	}
+	if len(publications) > 0 {
+		t.logger.Info("Resuming tracking of rollup publications", "count", len(publications))
+	}
+	return nil
+}
+
+// track starts tracking a publication after its first transaction was sent
+func (t *rollupTracker) track(publication *db.RollupPublication) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.publications[publication.RollupHash] = publication
+	t.persist(publication)
+}
+
+// failed drops a rollup that could not be published, and requests a new rollup
+func (t *rollupTracker) failed(rollupHash gethcommon.Hash, cause error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.drop(rollupHash)
+	t.lastFailure = cause
+	t.republishRequired.Store(true)
+	t.logger.Error("Rollup could not be published, a new rollup will be produced", log.RollupHashKey, rollupHash, log.ErrKey, cause)
+}
+
+// isPending returns true if a rollup transaction is not yet included in the canonical L1 chain
+func (t *rollupTracker) isPending() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, publication := range t.publications {
+		if !publication.IsIncluded() {
+			return true
+		}
+	}
+	return false
+}
+
+// takeRepublishRequest returns true if a new rollup is required since the last call
+func (t *rollupTracker) takeRepublishRequest() bool {
+	return t.republishRequired.Swap(false)
+}
+
+// healthError returns an error if rollups are not being published
+func (t *rollupTracker) healthError() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.lastFailure != nil {
+		return fmt.Errorf("last rollup publication failed: %w", t.lastFailure)
+	}
+	maxPending := maxRollupPendingTimeouts * t.maxWaitForL1Receipt
+	for _, publication := range t.publications {
+		pendingFor := time.Since(time.Unix(int64(publication.FirstSentAt), 0))
+		if !publication.IsIncluded() && pendingFor > maxPending {
+			return fmt.Errorf("rollup %s has been pending for %s (%d retries)", publication.RollupHash, pendingFor.Round(time.Second), publication.Retries)
+		}
+	}
+	return nil
+}
+
+// checkPublications checks the receipts of the tracked transactions. It resubmits the ones that were not included in
+// time, and stops tracking the ones that are final.
+func (t *rollupTracker) checkPublications(resubmit resubmitFunc) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(t.publications) == 0 {
+		return
+	}
+
+	head, err := t.ethClient.BlockNumber()
+	if err != nil {
+		t.logger.Warn("Could not fetch L1 head to check rollup publications", log.ErrKey, err)
+		return
+	}
+	for _, publication := range t.publications {
+		t.checkPublication(publication, head, resubmit)
+	}
+}
+
+// must be called with the lock held
+func (t *rollupTracker) checkPublication(publication *db.RollupPublication, head uint64, resubmit resubmitFunc) {
+	// any of the transactions sent for the rollup may be the one that was included
+	for _, txHash := range publication.TxHashes {
+		receipt, err := t.ethClient.TransactionReceipt(txHash)
+		if err == nil {
+			t.onReceipt(publication, txHash, receipt, head)
+			return
+		}
+	}
+
+	if publication.IsIncluded() {
+		// the node no longer knows the receipt, so the block that included the transaction is not canonical anymore
+		t.logger.Warn("Rollup was reorged out of the L1, waiting for it to be included again", log.RollupHashKey, publication.RollupHash,
+			log.BlockHashKey, publication.BlockHash)
+		publication.BlockHash = gethcommon.Hash{}
+		publication.BlockNumber = 0
+		t.persist(publication)
+	}
+
+	if time.Since(time.Unix(int64(publication.LastSentAt), 0)) < t.maxWaitForL1Receipt {
+		return // give the latest transaction time to be included
+	}
+
+	publication.Retries++
+	signedTx, err := resubmit(publication)
+	if err != nil {
+		if strings.Contains(err.Error(), core.ErrNonceTooLow.Error()) {
+			// another transaction used the nonce, and it wasn't ours (we have no receipt), so the rollup must be recreated
+			t.drop(publication.RollupHash)
+			t.lastFailure = fmt.Errorf("rollup transaction with nonce %d was replaced", publication.Nonce)
+			t.republishRequired.Store(true)
+			t.logger.Error("Rollup transaction was replaced, a new rollup will be produced", log.RollupHashKey, publication.RollupHash)
+			return
+		}
+		t.logger.Warn("Could not resubmit rollup transaction", log.RollupHashKey, publication.RollupHash, log.ErrKey, err)
+		return
+	}
+	t.logger.Info("Resubmitted rollup transaction with higher fees", log.RollupHashKey, publication.RollupHash,
+		log.TxKey, signedTx.Hash(), "retries", publication.Retries)
+	publication.TxHashes = append(publication.TxHashes, signedTx.Hash())
+	publication.LastSentAt = uint64(time.Now().Unix())
+	t.persist(publication)
+}
+
+// must be called with the lock held
+func (t *rollupTracker) onReceipt(publication *db.RollupPublication, txHash gethcommon.Hash, receipt *types.Receipt, head uint64) {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.drop(publication.RollupHash)
+		t.lastFailure = fmt.Errorf("rollup transaction %s was reverted", txHash)
+		t.republishRequired.Store(true)
+		t.logger.Error("Rollup transaction was reverted, a new rollup will be produced", log.RollupHashKey, publication.RollupHash, log.TxKey, txHash)
+		return
+	}
+	t.lastFailure = nil
+
+	// some L1s (e.g. the in-memory test L1) don't report the block of the receipt, so reorgs can't be detected
+	if receipt.BlockHash == (gethcommon.Hash{}) || receipt.BlockNumber == nil {
+		t.logger.Info("Rollup included in L1", log.RollupHashKey, publication.RollupHash)
+		t.drop(publication.RollupHash)
+		return
+	}
+
+	if publication.BlockHash != receipt.BlockHash {
+		t.logger.Info("Rollup included in L1", log.RollupHashKey, publication.RollupHash,
+			log.BlockHashKey, receipt.BlockHash, log.BlockHeightKey, receipt.BlockNumber)
+		publication.BlockHash = receipt.BlockHash
+		publication.BlockNumber = receipt.BlockNumber.Uint64()
+		t.persist(publication)
+	}
+	if head >= publication.BlockNumber+rollupFinalityDepth {
+		t.logger.Debug("Rollup is final", log.RollupHashKey, publication.RollupHash)
+		t.drop(publication.RollupHash)
+	}
+}
+
+// must be called with the lock held
+func (t *rollupTracker) persist(publication *db.RollupPublication) {
+	if err := t.db.WriteRollupPublication(publication); err != nil {
+		// the publication is still tracked in memory, it is only lost if the host restarts
+		t.logger.Error("Could not store rollup publication", log.RollupHashKey, publication.RollupHash, log.ErrKey, err)
+	}
+}
+
+// must be called with the lock held
+func (t *rollupTracker) drop(rollupHash gethcommon.Hash) {
+	delete(t.publications, rollupHash)
+	if err := t.db.DeleteRollupPublication(rollupHash); err != nil {
+		t.logger.Error("Could not delete rollup publication", log.RollupHashKey, rollupHash, log.ErrKey, err)
+	}
+}
@@ -0,0 +1,77 @@
+package l1
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/db"
+)
+
+// l1Client is embedded under another name, because the interface has an EthClient method
+type l1Client = ethadapter.EthClient
+
+// receiptsClient is an L1 client that only knows the given receipts
+type receiptsClient struct {
+	l1Client
+	head     uint64
+	receipts map[gethcommon.Hash]*types.Receipt
+}
+
+func (c *receiptsClient) BlockNumber() (uint64, error) {
+	return c.head, nil
+}
+
+func (c *receiptsClient) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	if receipt, found := c.receipts[hash]; found {
+		return receipt, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestRollupTrackerResubmitsReorgedRollupUntilFinal(t *testing.T) {
+	firstTx := gethcommon.BytesToHash([]byte{1})
+	l1 := &receiptsClient{head: 10, receipts: map[gethcommon.Hash]*types.Receipt{
+		firstTx: {Status: types.ReceiptStatusSuccessful, BlockHash: gethcommon.BytesToHash([]byte{10}), BlockNumber: big.NewInt(10)},
+	}}
+	tracker := newRollupTracker(db.NewInMemoryDB(nil, nil), l1, time.Minute, gethlog.New())
+
+	resubmittedTx := types.NewTx(&types.LegacyTx{Nonce: 1})
+	resubmit := func(*db.RollupPublication) (*types.Transaction, error) {
+		return resubmittedTx, nil
+	}
+
+	tracker.track(&db.RollupPublication{RollupHash: gethcommon.BytesToHash([]byte{9}), Nonce: 1, TxHashes: []gethcommon.Hash{firstTx}})
+	tracker.checkPublications(resubmit)
+	if tracker.isPending() {
+		t.Fatal("expected the rollup to be included")
+	}
+
+	// the block with the rollup is reorged out, and the transaction is not included again in time
+	delete(l1.receipts, firstTx)
+	tracker.checkPublications(resubmit)
+	if !tracker.isPending() {
+		t.Fatal("expected the reorged rollup to be pending")
+	}
+	publications, _ := tracker.db.GetRollupPublications()
+	if len(publications) != 1 || len(publications[0].TxHashes) != 2 || publications[0].Retries != 1 {
+		t.Fatalf("expected the rollup tx to be resubmitted, got %+v", publications)
+	}
+
+	// the resubmitted transaction is included and becomes final
+	l1.receipts[resubmittedTx.Hash()] = &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: gethcommon.BytesToHash([]byte{11}), BlockNumber: big.NewInt(11)}
+	l1.head = 11 + rollupFinalityDepth
+	tracker.checkPublications(resubmit)
+	publications, _ = tracker.db.GetRollupPublications()
+	if tracker.isPending() || len(publications) != 0 {
+		t.Errorf("expected the final rollup to no longer be tracked")
+	}
+	if tracker.takeRepublishRequest() {
+		t.Errorf("expected no new rollup to be required")
+	}
+}
@@ -173,6 +173,8 @@ func (r *Repository) FetchBatchBySeqNo(seqNo *big.Int) (*common.ExtBatch, error)
 // - when the node is a sequencer to store newly produced batches (the only way the sequencer host receives batches)
 // - when the node is a validator to store batches read from roll-ups
 // If the repository already has the batch it returns an AlreadyExists error which is typically ignored.
+// On a sequencer, the subscribers are notified of the new head batches, so the standby enclaves receive the batches
+// produced by the active one.
 func (r *Repository) AddBatch(batch *common.ExtBatch) error {
 	r.logger.Debug("Saving batch", log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.BatchHashKey, batch.Hash())
 	err := r.db.AddBatch(batch)
@@ -181,10 +183,17 @@ func (r *Repository) AddBatch(batch *common.ExtBatch) error {
 	}
 	// atomically compare and swap latest batch sequence number if successfully added batch is newer
 	r.latestSeqNoMutex.Lock()
-	defer r.latestSeqNoMutex.Unlock()
-	if batch.Header.SequencerOrderNo.Cmp(r.latestBatchSeqNo) > 0 {
+	isNewHead := batch.Header.SequencerOrderNo.Cmp(r.latestBatchSeqNo) > 0
+	if isNewHead {
 		r.latestBatchSeqNo = batch.Header.SequencerOrderNo
 	}
+	r.latestSeqNoMutex.Unlock()
+
+	if r.isSequencer && isNewHead {
+		for _, subscriber := range r.subscribers {
+			go subscriber.HandleBatch(batch)
+		}
+	}
 	return nil
 }
 
@@ -212,14 +221,14 @@ func (r *Repository) requestMissingBatchesFromPeers(fromSeqNo *big.Int) {
 	defer r.p2pReqMutex.Unlock()
 	if r.p2pInFlightReqTime != nil && time.Since(*r.p2pInFlightReqTime) < _timeoutWaitingForP2PResponse {
 		// don't send request if we have sent one too recently
-		r.logger.Trace("not requesting missing batches from sequencer - too soon since last request", "fromSeqNo", fromSeqNo, "lastReq", r.p2pInFlightReqTime)
+		r.logger.Trace("not requesting missing batches from peers - too soon since last request", "fromSeqNo", fromSeqNo, "lastReq", r.p2pInFlightReqTime)
 		return
 	}
 
-	r.logger.Debug("requesting missing batches from sequencer", "fromSeqNo", fromSeqNo)
-	err := r.sl.P2P().RequestBatchesFromSequencer(fromSeqNo)
+	r.logger.Debug("requesting missing batches from peers", "fromSeqNo", fromSeqNo)
+	err := r.sl.P2P().RequestBatchesFromPeers(fromSeqNo)
 	if err != nil {
-		r.logger.Warn("unable to request missing batches from sequencer", "fromSeqNo", fromSeqNo, log.ErrKey, err)
+		r.logger.Warn("unable to request missing batches from peers", "fromSeqNo", fromSeqNo, log.ErrKey, err)
 		return
 	}
 	now := time.Now()
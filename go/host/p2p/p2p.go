@@ -1,14 +1,16 @@
 package p2p
 
 import (
+	"crypto/ecdsa"
 	"fmt"
-	"io"
 	"math/big"
+	"math/rand"
 	"net"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/ten-protocol/go-ten/go/enclave/components"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 
 	"github.com/pkg/errors"
@@ -22,8 +24,11 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/config"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 const (
@@ -32,6 +37,11 @@ const (
 	msgTypeTx msgType = iota
 	msgTypeBatches
 	msgTypeBatchRequest
+
+	// the number of live batch messages remembered to deduplicate the gossip
+	gossipDedupCacheSize = 1024
+	// how long to wait for a transaction to be delivered to the sequencer, including the reconnections
+	txDeliveryTimeout = 30 * time.Second
 )
 
 var (
@@ -44,7 +54,7 @@ type msgType uint8
 
 // Associates an encoded message to its type.
 type message struct {
-	Sender   string // todo (#1619) - this needs to be authed in the future
+	Sender   string // the public P2P address of the sender, the host ID of the sender is authenticated by the handshake
 	Type     msgType
 	Contents []byte
 }
@@ -52,11 +62,17 @@ type message struct {
 type p2pServiceLocator interface {
 	L1Publisher() host.L1Publisher
 	L2Repo() host.L2BatchRepository
+	Enclaves() host.EnclaveService
 }
 
 // NewSocketP2PLayer - returns the Socket implementation of the P2P
-func NewSocketP2PLayer(config *config.HostConfig, serviceLocator p2pServiceLocator, logger gethlog.Logger, metricReg gethmetrics.Registry) *Service {
-	return &Service{
+// The host key is used to authenticate to the other hosts, which only accept connections from attested hosts
+func NewSocketP2PLayer(config *config.HostConfig, hostKey *ecdsa.PrivateKey, serviceLocator p2pServiceLocator, logger gethlog.Logger, metricReg gethmetrics.Registry) *Service {
+	seenBatchMsgs, err := lru.New[gethcommon.Hash, struct{}](gossipDedupCacheSize)
+	if err != nil {
+		panic(err) // only fails if the size is not positive
+	}
+	service := &Service{
 		batchSubscribers: subscription.NewManager[host.P2PBatchHandler](),
 		txSubscribers:    subscription.NewManager[host.P2PTxHandler](),
 		batchReqHandlers: subscription.NewManager[host.P2PBatchRequestHandler](),
@@ -68,6 +84,13 @@ func NewSocketP2PLayer(config *config.HostConfig, serviceLocator p2pServiceLocat
 		ourPublicAddress: config.P2PPublicAddress,
 		peerAddresses:    []string{},
 		p2pTimeout:       config.P2PConnectionTimeout,
+		hostKey:          hostKey,
+		attestedHosts:    map[gethcommon.Address]bool{},
+		peerConns:        map[string]*peerConn{},
+
+		isGossipEnabled: config.IsP2PGossipEnabled,
+		gossipFanout:    config.P2PGossipFanout,
+		seenBatchMsgs:   seenBatchMsgs,
 
 		peerAddressesMutex: sync.RWMutex{},
 
@@ -78,6 +101,8 @@ func NewSocketP2PLayer(config *config.HostConfig, serviceLocator p2pServiceLocat
 
 		isIncomingP2PDisabled: config.IsInboundP2PDisabled,
 	}
+	service.sigValidator = components.NewSignatureValidatorWithKeyFetcher(config.SequencerID, service.fetchSequencerKeys)
+	return service
 }
 
 type Service struct {
@@ -96,6 +121,20 @@ type Service struct {
 	peerAddresses    []string
 	p2pTimeout       time.Duration
 
+	hostKey            *ecdsa.PrivateKey
+	attestedHosts      map[gethcommon.Address]bool // cache of the hosts known to be attested by the management contract
+	attestedHostsMutex sync.RWMutex
+	sigValidator       *components.SignatureValidator
+
+	peerConns      map[string]*peerConn // the long-lived outbound connections, by peer address
+	peerConnsMutex sync.Mutex
+
+	// in gossip mode, live batches are sent to a bounded fanout of peers, and validators relay them to their own peers
+	isGossipEnabled bool
+	gossipFanout    int
+	seenBatchMsgs   *lru.Cache[gethcommon.Hash, struct{}] // the IDs of the live batch messages already handled
+	nextBatchSource atomic.Uint32                         // round-robin index of the peer to request missing batches from
+
 	peerTracker           *peerTracker
 	metricsRegistry       gethmetrics.Registry
 	logger                gethlog.Logger
@@ -132,6 +171,7 @@ func (p *Service) Start() error {
 func (p *Service) Stop() error {
 	p.logger.Info("Shutting down P2P.")
 	p.running.Store(false)
+	p.closePeerConns(nil)
 	if p.listener != nil {
 		// todo immediately shutting down the listener seems to impact other hosts shutdown process
 		time.Sleep(time.Second)
@@ -194,6 +234,7 @@ func (p *Service) RefreshPeerList() {
 	defer p.peerAddressesMutex.Unlock()
 	p.logger.Info(fmt.Sprintf("Updated peer list - old: %s new: %s", p.peerAddresses, newPeers))
 	p.peerAddresses = newPeers
+	p.closePeerConns(newPeers)
 }
 
 func (p *Service) SendTxToSequencer(tx common.EncryptedTx) error {
@@ -205,7 +246,12 @@ func (p *Service) SendTxToSequencer(tx common.EncryptedTx) error {
 	if err != nil {
 		return fmt.Errorf("failed to find sequencer - %w", err)
 	}
-	return p.send(msg, sequencer)
+	// unlike the other messages, the caller is told if the transaction does not reach the sequencer
+	msgEncoded, err := p.encode(msg)
+	if err != nil {
+		return err
+	}
+	return p.peerConn(sequencer).sendAndWait(msgEncoded, txDeliveryTimeout)
 }
 
 func (p *Service) BroadcastBatches(batches []*common.ExtBatch) error {
@@ -226,10 +272,14 @@ func (p *Service) BroadcastBatches(batches []*common.ExtBatch) error {
 	}
 
 	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatches, Contents: encodedBatchMsg}
+	if p.isGossipEnabled {
+		p.seenBatchMsgs.Add(batchMsgID(encodedBatchMsg), struct{}{})
+		return p.gossip(msg, nil)
+	}
 	return p.broadcast(msg)
 }
 
-func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+func (p *Service) RequestBatchesFromPeers(fromSeqNo *big.Int) error {
 	if p.isIncomingP2PDisabled {
 		return nil
 	}
@@ -240,7 +290,7 @@ func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
 		Requester: p.ourPublicAddress,
 		FromSeqNo: fromSeqNo,
 	}
-	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from sequencer", "fromSeqNo", batchRequest.FromSeqNo)
+	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from peers", "fromSeqNo", batchRequest.FromSeqNo)
 
 	encodedBatchRequest, err := rlp.EncodeToBytes(batchRequest)
 	if err != nil {
@@ -248,21 +298,24 @@ func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
 	}
 
 	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatchRequest, Contents: encodedBatchRequest}
-	// todo (#718) - allow missing batches to be requested from peers other than sequencer?
-	sequencer, err := p.getSequencer()
+	source, err := p.batchSource()
 	if err != nil {
-		return fmt.Errorf("failed to find sequencer - %w", err)
+		return fmt.Errorf("failed to find peer to request batches from - %w", err)
 	}
-	return p.send(msg, sequencer)
+	return p.send(msg, source)
 }
 
 func (p *Service) RespondToBatchRequest(requestID string, batches []*common.ExtBatch) error {
 	if p.isIncomingP2PDisabled {
 		return nil
 	}
-	if !p.isSequencer {
+	if !p.isSequencer && !p.isGossipEnabled {
 		return errors.New("only sequencer can respond to batch requests")
 	}
+	// the requester address is not authenticated, so we only ever connect to the peers on the peer list
+	if !p.isKnownPeer(requestID) {
+		return fmt.Errorf("batches requested by unknown peer %s", requestID)
+	}
 	batchMsg := &host.BatchMsg{
 		Batches: batches,
 		IsLive:  false,
@@ -317,20 +370,44 @@ func (p *Service) handleConnections() {
 	}
 }
 
-// Receives and decodes a P2P message, and pushes it to the correct channel.
+// Receives the P2P messages sent by a peer on the connection, until the connection is closed or goes idle.
 func (p *Service) handle(conn net.Conn) {
 	if conn != nil {
 		defer conn.Close()
 	}
 
-	encodedMsg, err := io.ReadAll(conn)
+	peerConn, err := p.runHandshake(conn, newSecureServerConn)
 	if err != nil {
-		p.logger.Debug("Failed to read message from peer", log.ErrKey, err)
+		p.logger.Debug("Failed to authenticate peer", "remoteAddress", conn.RemoteAddr(), log.ErrKey, err)
 		return
 	}
+	peerID := peerConn.PeerID()
+	receivedBytes := gethmetrics.GetOrRegisterMeter(fmt.Sprintf("p2p/peer/%s/received/bytes", peerID.Hex()), p.metricsRegistry)
+	receivedMessages := gethmetrics.GetOrRegisterMeter(fmt.Sprintf("p2p/peer/%s/received/messages", peerID.Hex()), p.metricsRegistry)
 
+	for p.running.Load() {
+		if err = conn.SetReadDeadline(time.Now().Add(idleTimeout)); err != nil {
+			return
+		}
+		encodedMsg, err := peerConn.ReadMsg()
+		if err != nil {
+			p.logger.Debug("Closing connection from peer", "peerID", peerID, log.ErrKey, err)
+			return
+		}
+		// empty messages are keepalives
+		if len(encodedMsg) == 0 {
+			continue
+		}
+		receivedMessages.Mark(1)
+		receivedBytes.Mark(int64(len(encodedMsg)))
+		p.handleMsg(peerID, encodedMsg)
+	}
+}
+
+// Decodes a P2P message, and pushes it to the correct channel.
+func (p *Service) handleMsg(peerID gethcommon.Address, encodedMsg []byte) {
 	msg := message{}
-	err = rlp.DecodeBytes(encodedMsg, &msg)
+	err := rlp.DecodeBytes(encodedMsg, &msg)
 	if err != nil {
 		p.logger.Debug("Failed to decode message received from peer: ", log.ErrKey, err)
 		return
@@ -358,19 +435,33 @@ func (p *Service) handle(conn net.Conn) {
 			// nothing to send to subscribers
 			break
 		}
-		// todo - check the batch signature
+		if err := p.verifyBatchSignatures(batchMsg.Batches); err != nil {
+			p.logger.Warn("rejected batches received from peer", "peerID", peerID, log.ErrKey, err)
+			break
+		}
+		if p.isGossipEnabled && batchMsg.IsLive {
+			msgID := batchMsgID(msg.Contents)
+			if found, _ := p.seenBatchMsgs.ContainsOrAdd(msgID, struct{}{}); found {
+				// we already handled and relayed these batches when they arrived from another peer
+				break
+			}
+			relayMsg := message{Sender: p.ourPublicAddress, Type: msgTypeBatches, Contents: msg.Contents}
+			if err := p.gossip(relayMsg, &peerID); err != nil {
+				p.logger.Debug("Could not relay batches to peers", log.ErrKey, err)
+			}
+		}
 		for _, batchSubs := range p.batchSubscribers.Subscribers() {
 			go batchSubs.HandleBatches(batchMsg.Batches, batchMsg.IsLive)
 		}
 	case msgTypeBatchRequest:
-		if !p.isSequencer {
+		if !p.isSequencer && !p.isGossipEnabled {
 			p.logger.Error("received batch request from peer, but not a sequencer node")
 			return
 		}
 		// this is an incoming request, p2p service is responsible for finding the response and returning it
 		go p.handleBatchRequest(msg.Contents)
 	}
-	p.peerTracker.receivedPeerMsg(msg.Sender)
+	p.peerTracker.receivedPeerMsg(peerID.Hex())
 }
 
 // Broadcasts a message to all peers.
@@ -389,7 +480,7 @@ func (p *Service) broadcast(msg message) error {
 	for _, address := range currentAddresses {
 		closureAddr := address
 		go func() {
-			err := p.sendBytesWithRetry(closureAddr, msgEncoded)
+			err := p.peerConn(closureAddr).send(msgEncoded, p.p2pTimeout)
 			if err != nil {
 				p.logger.Debug("Could not send message to peer", "peer", closureAddr, log.ErrKey, err)
 			}
@@ -399,8 +490,70 @@ func (p *Service) broadcast(msg message) error {
 	return nil
 }
 
+// Sends a live batch message to a random subset of the validators, of at most gossipFanout peers.
+// The authenticated sender of the message, if any, is excluded, as it has the batches already.
+func (p *Service) gossip(msg message, senderID *gethcommon.Address) error {
+	msgEncoded, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode message to gossip to peers. Cause: %w", err)
+	}
+
+	for _, address := range p.gossipTargets(senderID) {
+		closureAddr := address
+		go func() {
+			err := p.peerConn(closureAddr).send(msgEncoded, p.p2pTimeout)
+			if err != nil {
+				p.logger.Debug("Could not gossip message to peer", "peer", closureAddr, log.ErrKey, err)
+			}
+		}()
+	}
+	return nil
+}
+
+// Picks the peers to gossip to. The claimed address of the sender can't be trusted, so the sender is recognised by
+// the host ID we authenticated when connecting to its address. If we never connected to it, it is not excluded, and
+// it ignores the batches it has seen already.
+func (p *Service) gossipTargets(senderID *gethcommon.Address) []string {
+	p.peerAddressesMutex.RLock()
+	addresses := make([]string, 0, len(p.peerAddresses))
+	for i, address := range p.peerAddresses {
+		// the sequencer is the first peer for validators, and it does not accept batches
+		if i == 0 && !p.isSequencer {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	p.peerAddressesMutex.RUnlock()
+
+	targets := make([]string, 0, len(addresses))
+	p.peerConnsMutex.Lock()
+	for _, address := range addresses {
+		if conn, found := p.peerConns[address]; found && senderID != nil {
+			if peerID := conn.hostID(); peerID != nil && *peerID == *senderID {
+				continue
+			}
+		}
+		targets = append(targets, address)
+	}
+	p.peerConnsMutex.Unlock()
+
+	rand.Shuffle(len(targets), func(i, j int) { targets[i], targets[j] = targets[j], targets[i] }) //nolint:gosec
+	if len(targets) > p.gossipFanout {
+		targets = targets[:p.gossipFanout]
+	}
+	return targets
+}
+
 // Sends a message to the provided address.
 func (p *Service) send(msg message, to string) error {
+	msgEncoded, err := p.encode(msg)
+	if err != nil {
+		return err
+	}
+	return p.peerConn(to).send(msgEncoded, p.p2pTimeout)
+}
+
+func (p *Service) encode(msg message) ([]byte, error) {
 	// sanity check the message to discover bugs
 	if !(msg.Type >= 1 && msg.Type <= 3) {
 		p.logger.Error(fmt.Sprintf("Sending message with wrong message type: %v", msg))
@@ -414,42 +567,53 @@ func (p *Service) send(msg message, to string) error {
 
 	msgEncoded, err := rlp.EncodeToBytes(msg)
 	if err != nil {
-		return fmt.Errorf("could not encode message to send to sequencer. Cause: %w", err)
+		return nil, fmt.Errorf("could not encode message to send to peer. Cause: %w", err)
 	}
-	err = p.sendBytesWithRetry(to, msgEncoded)
-	if err != nil {
-		return err
-	}
-	return nil
+	return msgEncoded, nil
 }
 
-// Sends the bytes to the provided address.
-// Until introducing libp2p (or equivalent), we have a simple retry
-func (p *Service) sendBytesWithRetry(address string, msgEncoded []byte) error {
-	// retry for about 2 seconds
-	err := retry.Do(func() error {
-		return p.sendBytes(address, msgEncoded)
-	}, retry.NewDoublingBackoffStrategy(100*time.Millisecond, 5))
-	return err
+// Returns whether the address is on the peer list
+func (p *Service) isKnownPeer(address string) bool {
+	p.peerAddressesMutex.RLock()
+	defer p.peerAddressesMutex.RUnlock()
+	for _, peerAddress := range p.peerAddresses {
+		if peerAddress == address {
+			return true
+		}
+	}
+	return false
 }
 
-// Sends the bytes to the provided address.
-func (p *Service) sendBytes(address string, tx []byte) error {
-	conn, err := net.DialTimeout(tcp, address, p.p2pTimeout)
-	if conn != nil {
-		defer conn.Close()
+// Returns the long-lived connection to the peer, creating it if this is the first message to the peer
+func (p *Service) peerConn(address string) *peerConn {
+	p.peerConnsMutex.Lock()
+	defer p.peerConnsMutex.Unlock()
+
+	conn, found := p.peerConns[address]
+	if !found {
+		conn = newPeerConn(address, p.dialPeer, p.peerTracker, p.metricsRegistry, p.logger)
+		p.peerConns[address] = conn
 	}
-	if err != nil {
-		p.logger.Debug(fmt.Sprintf("could not connect to peer on address %s", address), log.ErrKey, err)
-		return err
+	return conn
+}
+
+// Closes the connections to the peers that are not in the given list (or to all peers if the list is nil)
+func (p *Service) closePeerConns(keep []string) {
+	keepSet := make(map[string]bool, len(keep))
+	for _, address := range keep {
+		keepSet[address] = true
 	}
 
-	_, err = conn.Write(tx)
-	if err != nil {
-		p.logger.Debug(fmt.Sprintf("could not send message to peer on address %s", address), log.ErrKey, err)
-		return err
+	p.peerConnsMutex.Lock()
+	defer p.peerConnsMutex.Unlock()
+	for address, conn := range p.peerConns {
+		if keepSet[address] {
+			continue
+		}
+		// the connection drops the metrics and the tracked state of the peer when it stops
+		conn.stop()
+		delete(p.peerConns, address)
 	}
-	return nil
 }
 
 // Retrieves the sequencer's address.
@@ -464,6 +628,96 @@ func (p *Service) getSequencer() (string, error) {
 	return p.peerAddresses[0], nil
 }
 
+// Runs the handshake with the peer on the other side of the connection, within the P2P timeout
+func (p *Service) runHandshake(conn net.Conn, handshake func(net.Conn, *ecdsa.PrivateKey, peerAuthorizer) (*secureConn, error)) (*secureConn, error) {
+	if err := conn.SetDeadline(time.Now().Add(p.p2pTimeout)); err != nil {
+		return nil, err
+	}
+	peerConn, err := handshake(conn, p.hostKey, p.authorizePeer)
+	if err != nil {
+		return nil, err
+	}
+	// the messages themselves can be large, so they are not subject to the handshake deadline
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	return peerConn, nil
+}
+
+// Only hosts that were attested by the management contract are allowed to communicate with us.
+// Attestations are never revoked, so only the positive results are cached.
+func (p *Service) authorizePeer(hostID gethcommon.Address) error {
+	p.attestedHostsMutex.RLock()
+	attested := p.attestedHosts[hostID]
+	p.attestedHostsMutex.RUnlock()
+	if attested {
+		return nil
+	}
+
+	attested, err := p.sl.L1Publisher().IsHostAttested(hostID)
+	if err != nil {
+		return fmt.Errorf("could not check whether host is attested. Cause: %w", err)
+	}
+	if !attested {
+		return fmt.Errorf("host %s is not attested", hostID)
+	}
+
+	p.attestedHostsMutex.Lock()
+	defer p.attestedHostsMutex.Unlock()
+	p.attestedHosts[hostID] = true
+	return nil
+}
+
+// Rejects the batches unless they are all signed by the sequencer, so they are not passed on to the subscribers
+func (p *Service) verifyBatchSignatures(batches []*common.ExtBatch) error {
+	for _, batch := range batches {
+		if err := p.sigValidator.CheckSequencerSignature(batch.Hash(), batch.Header.R, batch.Header.S); err != nil {
+			return fmt.Errorf("invalid signature on batch %s. Cause: %w", batch.Hash(), err)
+		}
+	}
+	return nil
+}
+
+// The host does not have access to the attested keys, so it retrieves the sequencer keys from the enclave
+func (p *Service) fetchSequencerKeys(_ gethcommon.Address) ([]*ecdsa.PublicKey, error) {
+	publicCfg, err := p.sl.Enclaves().GetEnclaveClient().EnclavePublicConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch enclave public config. Cause: %w", err)
+	}
+	if len(publicCfg.SequencerPublicKeys) == 0 {
+		return nil, errors.New("sequencer keys are not known to the enclave yet")
+	}
+	keys := make([]*ecdsa.PublicKey, len(publicCfg.SequencerPublicKeys))
+	for i, key := range publicCfg.SequencerPublicKeys {
+		keys[i], err = gethcrypto.DecompressPubkey(key)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse sequencer key. Cause: %w", err)
+		}
+	}
+	return keys, nil
+}
+
+// Returns the peer to request missing batches from. In gossip mode any peer can serve batches, so the requests are
+// spread over all the peers in turn, otherwise they are sent to the sequencer.
+func (p *Service) batchSource() (string, error) {
+	if !p.isGossipEnabled {
+		return p.getSequencer()
+	}
+
+	p.peerAddressesMutex.RLock()
+	defer p.peerAddressesMutex.RUnlock()
+	if len(p.peerAddresses) == 0 {
+		return "", errors.New("no known peers")
+	}
+	idx := p.nextBatchSource.Add(1) % uint32(len(p.peerAddresses))
+	return p.peerAddresses[idx], nil
+}
+
+// The ID of a live batch message, used to deduplicate the gossip
+func batchMsgID(encodedBatchMsg []byte) gethcommon.Hash {
+	return gethcrypto.Keccak256Hash(encodedBatchMsg)
+}
+
 func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequest) {
 	var batchRequest *common.BatchRequest
 	err := rlp.DecodeBytes(encodedBatchRequest, &batchRequest)
@@ -471,6 +725,11 @@ func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequ
 		p.logger.Warn("unable to decode batch request received from peer using RLP", log.ErrKey, err)
 		return
 	}
+	// no point in loading the batches if we won't send them
+	if !p.isKnownPeer(batchRequest.Requester) {
+		p.logger.Debug("Ignoring batch request from unknown peer", "requester", batchRequest.Requester)
+		return
+	}
 
 	// todo (@matt) should this response be synchronous?
 	for _, requestHandler := range p.batchReqHandlers.Subscribers() {
@@ -0,0 +1,230 @@
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/subscription"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	sequencerAddress = "sequencer:10000"
+	validatorA       = "validatorA:10000"
+	validatorB       = "validatorB:10000"
+	validatorC       = "validatorC:10000"
+)
+
+var testSequencerKey, _ = gethcrypto.GenerateKey()
+
+func TestGossipIsLimitedToTheFanout(t *testing.T) {
+	p := newGossipService(t, 2, gossipDedupCacheSize)
+
+	for i := 0; i < 20; i++ {
+		targets := p.gossipTargets(nil)
+		if len(targets) != 2 {
+			t.Fatalf("expected to gossip to 2 peers, got %d", len(targets))
+		}
+		for _, target := range targets {
+			if target == sequencerAddress {
+				t.Fatal("expected the sequencer not to receive gossiped batches")
+			}
+		}
+	}
+}
+
+func TestGossipExcludesTheAuthenticatedSender(t *testing.T) {
+	p := newGossipService(t, 3, gossipDedupCacheSize)
+	senderID := connectTestPeer(t, p, validatorA, make(chan string, 10))
+
+	for i := 0; i < 20; i++ {
+		targets := p.gossipTargets(&senderID)
+		if len(targets) != 2 {
+			t.Fatalf("expected to gossip to the 2 peers other than the sender, got %v", targets)
+		}
+		for _, target := range targets {
+			if target == validatorA {
+				t.Fatal("expected the sender not to receive its own batches back")
+			}
+		}
+	}
+}
+
+func TestGossipRelaysEachBatchMessageOnce(t *testing.T) {
+	p := newGossipService(t, 3, 2)
+	received := make(chan string, 10)
+	senderID := connectTestPeer(t, p, validatorA, received)
+	connectTestPeer(t, p, validatorB, received)
+	connectTestPeer(t, p, validatorC, received)
+
+	relay := func(msg message) {
+		p.handleMsg(senderID, encodeTestMsg(t, msg))
+	}
+	msg := liveBatchMsg(t, 1)
+	relay(msg)
+	relay(msg)
+	assertRelayedTo(t, received, validatorB, validatorC)
+
+	// the message is relayed again once it was evicted from the cache by newer messages
+	relay(liveBatchMsg(t, 2))
+	relay(liveBatchMsg(t, 3))
+	assertRelayedTo(t, received, validatorB, validatorC, validatorB, validatorC)
+	relay(msg)
+	assertRelayedTo(t, received, validatorB, validatorC)
+}
+
+func TestBatchSourceRoundRobinsOverPeersWhenGossiping(t *testing.T) {
+	p := newGossipService(t, 2, gossipDedupCacheSize)
+
+	requested := map[string]int{}
+	for i := 0; i < 8; i++ {
+		source, err := p.batchSource()
+		if err != nil {
+			t.Fatal(err)
+		}
+		requested[source]++
+	}
+	for _, address := range p.peerAddresses {
+		if requested[address] != 2 {
+			t.Errorf("expected 2 requests to %s, got %d", address, requested[address])
+		}
+	}
+
+	// without gossip, only the sequencer serves batches
+	p.isGossipEnabled = false
+	for i := 0; i < 4; i++ {
+		source, err := p.batchSource()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if source != sequencerAddress {
+			t.Errorf("expected to request batches from the sequencer, got %s", source)
+		}
+	}
+}
+
+// newGossipService returns the P2P service of a validator gossiping with the given fanout and dedup cache size
+func newGossipService(t *testing.T, fanout int, dedupCacheSize int) *Service {
+	seenBatchMsgs, err := lru.New[gethcommon.Hash, struct{}](dedupCacheSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Service{
+		batchSubscribers: subscription.NewManager[host.P2PBatchHandler](),
+		peerAddresses:    []string{sequencerAddress, validatorA, validatorB, validatorC},
+		peerConns:        map[string]*peerConn{},
+		p2pTimeout:       time.Second,
+		isGossipEnabled:  true,
+		gossipFanout:     fanout,
+		seenBatchMsgs:    seenBatchMsgs,
+		peerTracker:      newPeerTracker(),
+		metricsRegistry:  gethmetrics.NewRegistry(),
+		logger:           gethlog.New(),
+	}
+	p.sigValidator = components.NewSignatureValidatorWithKeyFetcher(gethcommon.Address{}, func(gethcommon.Address) ([]*ecdsa.PublicKey, error) {
+		return []*ecdsa.PublicKey{&testSequencerKey.PublicKey}, nil
+	})
+	t.Cleanup(func() { p.closePeerConns(nil) })
+	return p
+}
+
+// connectTestPeer connects the service to an in-memory peer at the address, which reports the messages it receives
+// on the channel. It returns the host ID of the peer.
+func connectTestPeer(t *testing.T, p *Service, address string, received chan string) gethcommon.Address {
+	clientKey, _ := gethcrypto.GenerateKey()
+	serverKey, _ := gethcrypto.GenerateKey()
+	allowAll := func(gethcommon.Address) error { return nil }
+
+	dial := func(string) (*secureConn, error) {
+		clientSide, serverSide := net.Pipe()
+		go func() {
+			server, err := newSecureServerConn(serverSide, serverKey, allowAll)
+			if err != nil {
+				return
+			}
+			for {
+				msg, err := server.ReadMsg()
+				if err != nil {
+					return
+				}
+				if len(msg) > 0 {
+					received <- address
+				}
+			}
+		}()
+		return newSecureClientConn(clientSide, clientKey, allowAll)
+	}
+
+	conn := newPeerConn(address, dial, p.peerTracker, p.metricsRegistry, p.logger)
+	// the first message establishes the connection, and authenticates the peer
+	if err := conn.sendAndWait([]byte("hello"), 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	<-received
+	p.peerConns[address] = conn
+	return gethcrypto.PubkeyToAddress(serverKey.PublicKey)
+}
+
+// liveBatchMsg returns a live message with the batch at the height, signed by the sequencer
+func liveBatchMsg(t *testing.T, height int64) message {
+	batch := &common.ExtBatch{Header: &common.BatchHeader{Number: big.NewInt(height)}}
+	hash := batch.Hash()
+	var err error
+	batch.Header.R, batch.Header.S, err = ecdsa.Sign(rand.Reader, testSequencerKey, hash.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encodedBatchMsg, err := rlp.EncodeToBytes(host.BatchMsg{Batches: []*common.ExtBatch{batch}, IsLive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return message{Sender: validatorA, Type: msgTypeBatches, Contents: encodedBatchMsg}
+}
+
+func encodeTestMsg(t *testing.T, msg message) []byte {
+	encodedMsg, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encodedMsg
+}
+
+func assertRelayedTo(t *testing.T, received <-chan string, expected ...string) {
+	t.Helper()
+	counts := map[string]int{}
+	for range expected {
+		select {
+		case address := <-received:
+			counts[address]++
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the relayed messages, got %v", counts)
+		}
+	}
+	for _, address := range expected {
+		counts[address]--
+	}
+	for address, count := range counts {
+		if count != 0 {
+			t.Errorf("unexpected number of messages relayed to %s: %d", address, count)
+		}
+	}
+	select {
+	case address := <-received:
+		t.Errorf("unexpected message relayed to %s", address)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,295 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// the number of messages that can be queued for a peer before senders are blocked
+	peerQueueSize = 256
+	// the attempts to deliver a message before it is dropped
+	maxSendAttempts = 5
+	// the delay before reconnecting to a peer doubles with each consecutive failure, up to maxReconnectDelay
+	initialReconnectDelay = 100 * time.Millisecond
+	maxReconnectDelay     = 30 * time.Second
+	// an empty message is sent on idle connections, so the peer does not time them out
+	keepaliveInterval = 15 * time.Second
+	// inbound connections are closed if nothing is received for this long, not even a keepalive
+	idleTimeout = 3 * keepaliveInterval
+	// the time allowed to write a single message, which can be a large batch response
+	writeTimeout = 30 * time.Second
+)
+
+var errPeerConnClosed = errors.New("connection to peer was closed")
+
+// peerMetrics - the throughput and queue depth of the connection to a peer. Like all the per-peer state, the metrics
+// are keyed by the host ID of the peer, which is authenticated by the handshake.
+type peerMetrics struct {
+	sentBytes       gethmetrics.Meter
+	sentMessages    gethmetrics.Meter
+	droppedMessages gethmetrics.Meter
+	queueDepth      gethmetrics.Gauge
+}
+
+func newPeerMetrics(peerID string, registry gethmetrics.Registry) *peerMetrics {
+	return &peerMetrics{
+		sentBytes:       gethmetrics.GetOrRegisterMeter(fmt.Sprintf("p2p/peer/%s/sent/bytes", peerID), registry),
+		sentMessages:    gethmetrics.GetOrRegisterMeter(fmt.Sprintf("p2p/peer/%s/sent/messages", peerID), registry),
+		droppedMessages: gethmetrics.GetOrRegisterMeter(fmt.Sprintf("p2p/peer/%s/dropped/messages", peerID), registry),
+		queueDepth:      gethmetrics.GetOrRegisterGauge(fmt.Sprintf("p2p/peer/%s/queue", peerID), registry),
+	}
+}
+
+func (m *peerMetrics) unregister(peerID string, registry gethmetrics.Registry) {
+	if registry == nil {
+		registry = gethmetrics.DefaultRegistry
+	}
+	registry.Unregister(fmt.Sprintf("p2p/peer/%s/sent/bytes", peerID))
+	registry.Unregister(fmt.Sprintf("p2p/peer/%s/sent/messages", peerID))
+	registry.Unregister(fmt.Sprintf("p2p/peer/%s/dropped/messages", peerID))
+	registry.Unregister(fmt.Sprintf("p2p/peer/%s/queue", peerID))
+}
+
+// outboundMsg - a message queued for a peer. The result of the delivery is reported on the delivered channel, if set.
+type outboundMsg struct {
+	payload   []byte
+	delivered chan error
+}
+
+// peerConn - a long-lived outbound connection to a peer, on which all the messages to the peer are multiplexed.
+// Messages are queued and written by a single goroutine, which reconnects when the connection drops.
+// A message that can't be delivered after maxSendAttempts is dropped. Only the senders that wait for the delivery are
+// told, the others only see the drop in the logs and in the metrics of the peer.
+type peerConn struct {
+	address string
+	queue   chan *outboundMsg
+	dial    func(address string) (*secureConn, error)
+
+	id atomic.Pointer[gethcommon.Address] // the host ID of the peer, known after the first handshake
+
+	// the state below is only accessed by the goroutine writing the messages
+	peerID          string
+	metrics         *peerMetrics
+	metricsRegistry gethmetrics.Registry
+	connFailures    int // the number of consecutive failures to connect or write to the peer
+
+	tracker *peerTracker
+	logger  gethlog.Logger
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newPeerConn(address string, dial func(string) (*secureConn, error), tracker *peerTracker, metricsRegistry gethmetrics.Registry, logger gethlog.Logger) *peerConn {
+	c := &peerConn{
+		address:         address,
+		queue:           make(chan *outboundMsg, peerQueueSize),
+		dial:            dial,
+		tracker:         tracker,
+		metricsRegistry: metricsRegistry,
+		logger:          logger.New("peer", address),
+		stopCh:          make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+// send queues the message for the peer, blocking for up to the timeout if the queue is full
+func (c *peerConn) send(msg []byte, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	return c.enqueue(&outboundMsg{payload: msg}, timer)
+}
+
+// sendAndWait queues the message for the peer and waits until it is written to the connection, for up to the timeout
+func (c *peerConn) sendAndWait(msg []byte, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	outbound := &outboundMsg{payload: msg, delivered: make(chan error, 1)}
+	if err := c.enqueue(outbound, timer); err != nil {
+		return err
+	}
+	select {
+	case err := <-outbound.delivered:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("message to peer %s was not delivered in time", c.address)
+	case <-c.stopCh:
+		return errPeerConnClosed
+	}
+}
+
+func (c *peerConn) enqueue(msg *outboundMsg, timer *time.Timer) error {
+	select {
+	case c.queue <- msg:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("queue of messages to peer %s is full", c.address)
+	case <-c.stopCh:
+		return errPeerConnClosed
+	}
+}
+
+func (c *peerConn) stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+func (c *peerConn) run() {
+	var conn *secureConn
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer func() {
+		keepalive.Stop()
+		if conn != nil {
+			conn.Close()
+		}
+		// the peer is no longer on the peer list, or we are shutting down
+		if c.metrics != nil {
+			c.metrics.unregister(c.peerID, c.metricsRegistry)
+			c.tracker.forgetPeer(c.peerID)
+		}
+	}()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case msg := <-c.queue:
+			var err error
+			conn, err = c.deliver(conn, msg.payload)
+			if msg.delivered != nil {
+				msg.delivered <- err
+			}
+			if c.metrics != nil {
+				c.metrics.queueDepth.Update(int64(len(c.queue)))
+			}
+			keepalive.Reset(keepaliveInterval)
+		case <-keepalive.C:
+			if conn == nil {
+				continue
+			}
+			if err := c.write(conn, nil); err != nil {
+				c.logger.Debug("Keepalive to peer failed", log.ErrKey, err)
+				conn.Close()
+				conn = nil
+			}
+		}
+	}
+}
+
+// deliver writes the message, reconnecting if needed. It returns the connection to use for the next message, which
+// is nil if the peer could not be reached, and the error if the message was dropped.
+func (c *peerConn) deliver(conn *secureConn, msg []byte) (*secureConn, error) {
+	var err error
+	for attempt := 0; attempt < maxSendAttempts; attempt++ {
+		if conn == nil {
+			if !c.waitForReconnect() {
+				return nil, errPeerConnClosed
+			}
+			conn, err = c.dial(c.address)
+			if err != nil {
+				c.connFailures++
+				continue
+			}
+			c.identify(conn.PeerID())
+		}
+
+		if err = c.write(conn, msg); err == nil {
+			c.connFailures = 0
+			c.metrics.sentMessages.Mark(1)
+			c.metrics.sentBytes.Mark(int64(len(msg)))
+			return conn, nil
+		}
+		c.connFailures++
+		conn.Close()
+		conn = nil
+	}
+	if c.metrics != nil {
+		c.metrics.droppedMessages.Mark(1)
+	}
+	c.logger.Warn(fmt.Sprintf("Dropped message to peer after %d attempts", maxSendAttempts), log.ErrKey, err)
+	return conn, fmt.Errorf("could not deliver message to peer %s. Cause: %w", c.address, err)
+}
+
+// identify registers the metrics of the peer the first time we connect to it, once its host ID is known
+func (c *peerConn) identify(peerID gethcommon.Address) {
+	if c.metrics != nil {
+		return
+	}
+	c.id.Store(&peerID)
+	c.peerID = peerID.Hex()
+	c.metrics = newPeerMetrics(c.peerID, c.metricsRegistry)
+}
+
+// hostID returns the authenticated host ID of the peer, or nil if we never connected to it
+func (c *peerConn) hostID() *gethcommon.Address {
+	return c.id.Load()
+}
+
+func (c *peerConn) write(conn *secureConn, msg []byte) error {
+	if err := conn.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteMsg(msg)
+}
+
+// waitForReconnect waits for the delay due to the previous failures. It returns false if the connection was stopped meanwhile.
+func (c *peerConn) waitForReconnect() bool {
+	delay := reconnectDelay(c.connFailures)
+	if delay == 0 {
+		return true
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-c.stopCh:
+		return false
+	}
+}
+
+// reconnectDelay returns how long to wait before reconnecting to a peer, based on the consecutive failures
+func reconnectDelay(failures int) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+	delay := initialReconnectDelay
+	for i := 1; i < failures && delay < maxReconnectDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxReconnectDelay {
+		return maxReconnectDelay
+	}
+	return delay
+}
+
+// dialPeer opens a connection to the peer and runs the handshake
+func (p *Service) dialPeer(address string) (*secureConn, error) {
+	conn, err := net.DialTimeout(tcp, address, p.p2pTimeout)
+	if err != nil {
+		p.logger.Debug(fmt.Sprintf("could not connect to peer on address %s", address), log.ErrKey, err)
+		return nil, err
+	}
+
+	peerConn, err := p.runHandshake(conn, newSecureClientConn)
+	if err != nil {
+		conn.Close()
+		p.logger.Debug(fmt.Sprintf("could not authenticate peer on address %s", address), log.ErrKey, err)
+		return nil, err
+	}
+	return peerConn, nil
+}
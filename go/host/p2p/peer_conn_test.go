@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+func TestPeerConnMultiplexesMessagesOnOneConnection(t *testing.T) {
+	clientKey, _ := gethcrypto.GenerateKey()
+	serverKey, _ := gethcrypto.GenerateKey()
+	allowAll := func(gethcommon.Address) error { return nil }
+
+	received := make(chan []byte, 10)
+	dials := 0
+	dial := func(string) (*secureConn, error) {
+		dials++
+		clientSide, serverSide := net.Pipe()
+		go func() {
+			server, err := newSecureServerConn(serverSide, serverKey, allowAll)
+			if err != nil {
+				return
+			}
+			for {
+				msg, err := server.ReadMsg()
+				if err != nil {
+					return
+				}
+				received <- msg
+			}
+		}()
+		return newSecureClientConn(clientSide, clientKey, allowAll)
+	}
+
+	registry := gethmetrics.NewRegistry()
+	conn := newPeerConn("peer", dial, newPeerTracker(), registry, gethlog.New())
+
+	for i := 0; i < 3; i++ {
+		if err := conn.send([]byte(fmt.Sprintf("msg%d", i)), time.Second); err != nil {
+			t.Fatalf("could not queue message. Cause: %s", err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case msg := <-received:
+			if string(msg) != fmt.Sprintf("msg%d", i) {
+				t.Errorf("expected msg%d, got %s", i, msg)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+	}
+	if dials != 1 {
+		t.Errorf("expected a single connection to the peer, got %d", dials)
+	}
+
+	// the metrics are keyed by the authenticated host ID of the peer, like the metrics of the received messages
+	sentMessages := fmt.Sprintf("p2p/peer/%s/sent/messages", gethcrypto.PubkeyToAddress(serverKey.PublicKey).Hex())
+	if registry.Get(sentMessages) == nil {
+		t.Errorf("expected metric %s to be registered", sentMessages)
+	}
+	conn.stop()
+	time.Sleep(100 * time.Millisecond)
+	if registry.Get(sentMessages) != nil {
+		t.Errorf("expected metric %s to be unregistered when the connection stops", sentMessages)
+	}
+}
+
+func TestPeerConnReportsUndeliveredMessages(t *testing.T) {
+	dial := func(string) (*secureConn, error) {
+		return nil, errors.New("peer is down")
+	}
+	conn := newPeerConn("peer", dial, newPeerTracker(), gethmetrics.NewRegistry(), gethlog.New())
+	defer conn.stop()
+
+	// the message is dropped after the last attempt, and the sender that waits for the delivery is told
+	err := conn.sendAndWait([]byte("msg"), 10*time.Second)
+	if err == nil {
+		t.Fatal("expected the delivery to fail")
+	}
+	if !strings.Contains(err.Error(), "peer is down") {
+		t.Errorf("expected the cause of the failure, got %s", err)
+	}
+}
+
+func TestOnlyKnownPeersReceiveBatches(t *testing.T) {
+	p := &Service{
+		isSequencer:   true,
+		peerAddresses: []string{"known:10000"},
+		peerConns:     map[string]*peerConn{},
+		logger:        gethlog.New(),
+	}
+	if err := p.RespondToBatchRequest("unknown:10000", nil); err == nil {
+		t.Error("expected the response to an unknown peer to be refused")
+	}
+	if len(p.peerConns) != 0 {
+		t.Error("expected no connection to an unknown peer")
+	}
+}
+
+func TestReconnectDelayBacksOff(t *testing.T) {
+	if delay := reconnectDelay(0); delay != 0 {
+		t.Errorf("expected no delay before the first failure, got %s", delay)
+	}
+	if delay := reconnectDelay(2); delay != 2*initialReconnectDelay {
+		t.Errorf("expected delay to double after the second failure, got %s", delay)
+	}
+	if delay := reconnectDelay(22); delay != maxReconnectDelay {
+		t.Errorf("expected delay to be capped, got %s", delay)
+	}
+}
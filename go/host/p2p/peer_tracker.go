@@ -5,7 +5,7 @@ import (
 	"time"
 )
 
-// peerTracker tracks the last message received from different peers
+// peerTracker tracks the last message received from different peers, by the host ID of the peer
 type peerTracker struct {
 	lock                      sync.RWMutex
 	lastReceivedMessageByPeer map[string]time.Time
@@ -18,10 +18,10 @@ func newPeerTracker() *peerTracker {
 	}
 }
 
-func (s *peerTracker) receivedPeerMsg(peer string) {
+func (s *peerTracker) receivedPeerMsg(peerID string) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	s.lastReceivedMessageByPeer[peer] = time.Now()
+	s.lastReceivedMessageByPeer[peerID] = time.Now()
 }
 
 func (s *peerTracker) receivedMessagesByPeer() map[string]time.Time {
@@ -34,3 +34,10 @@ func (s *peerTracker) receivedMessagesByPeer() map[string]time.Time {
 	}
 	return newMap
 }
+
+// forgetPeer drops the state of a peer that is no longer on the peer list
+func (s *peerTracker) forgetPeer(peerID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.lastReceivedMessageByPeer, peerID)
+}
@@ -0,0 +1,259 @@
+package p2p
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	handshakeDomain = "ten-p2p-handshake-v1"
+	initiatorRole   = "initiator"
+	responderRole   = "responder"
+
+	framePrefixSize = 4
+	// batch responses can be large, but anything bigger than this is not a legitimate message
+	maxFrameSize = 128 * 1024 * 1024
+)
+
+// peerAuthorizer returns an error if the host with the given ID is not allowed to connect to us
+type peerAuthorizer func(hostID gethcommon.Address) error
+
+// handshakeMsg - a step of the handshake. Both sides send a fresh ephemeral key, and sign the keys of both sides with
+// their host key, which proves their host ID and binds it to the session keys.
+type handshakeMsg struct {
+	EphemeralKey []byte // the compressed ephemeral public key, only set in the first message of each side
+	Signature    []byte // the signature of the handshake transcript with the host key, empty in the first message
+}
+
+// secureConn - an authenticated and encrypted connection to a peer. Messages are length-prefixed frames sealed with
+// AES-GCM, using a separate key and nonce counter for each direction.
+type secureConn struct {
+	conn   net.Conn
+	peerID gethcommon.Address
+
+	sendCipher cipher.AEAD
+	recvCipher cipher.AEAD
+	sendNonce  uint64
+	recvNonce  uint64
+}
+
+// newSecureClientConn - runs the handshake as the side that dialled the connection
+func newSecureClientConn(conn net.Conn, hostKey *ecdsa.PrivateKey, authorize peerAuthorizer) (*secureConn, error) {
+	ephemeralKey, err := gethcrypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate ephemeral key. Cause: %w", err)
+	}
+	ourEphemeral := gethcrypto.CompressPubkey(&ephemeralKey.PublicKey)
+	if err = writeHandshakeMsg(conn, &handshakeMsg{EphemeralKey: ourEphemeral}); err != nil {
+		return nil, err
+	}
+
+	response, err := readHandshakeMsg(conn)
+	if err != nil {
+		return nil, err
+	}
+	peerID, err := recoverPeerID(responderRole, ourEphemeral, response.EphemeralKey, response.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err = authorize(peerID); err != nil {
+		return nil, fmt.Errorf("peer %s is not authorised. Cause: %w", peerID, err)
+	}
+
+	signature, err := signTranscript(hostKey, initiatorRole, ourEphemeral, response.EphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeHandshakeMsg(conn, &handshakeMsg{Signature: signature}); err != nil {
+		return nil, err
+	}
+
+	return newSecureConn(conn, peerID, ephemeralKey, response.EphemeralKey, ourEphemeral, response.EphemeralKey, true)
+}
+
+// newSecureServerConn - runs the handshake as the side that accepted the connection
+func newSecureServerConn(conn net.Conn, hostKey *ecdsa.PrivateKey, authorize peerAuthorizer) (*secureConn, error) {
+	hello, err := readHandshakeMsg(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralKey, err := gethcrypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate ephemeral key. Cause: %w", err)
+	}
+	ourEphemeral := gethcrypto.CompressPubkey(&ephemeralKey.PublicKey)
+	signature, err := signTranscript(hostKey, responderRole, hello.EphemeralKey, ourEphemeral)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeHandshakeMsg(conn, &handshakeMsg{EphemeralKey: ourEphemeral, Signature: signature}); err != nil {
+		return nil, err
+	}
+
+	finish, err := readHandshakeMsg(conn)
+	if err != nil {
+		return nil, err
+	}
+	peerID, err := recoverPeerID(initiatorRole, hello.EphemeralKey, ourEphemeral, finish.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err = authorize(peerID); err != nil {
+		return nil, fmt.Errorf("peer %s is not authorised. Cause: %w", peerID, err)
+	}
+
+	return newSecureConn(conn, peerID, ephemeralKey, hello.EphemeralKey, hello.EphemeralKey, ourEphemeral, false)
+}
+
+func newSecureConn(conn net.Conn, peerID gethcommon.Address, ourKey *ecdsa.PrivateKey, peerEphemeral []byte, initiatorEphemeral []byte, responderEphemeral []byte, isInitiator bool) (*secureConn, error) {
+	peerKey, err := gethcrypto.DecompressPubkey(peerEphemeral)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ephemeral key from peer. Cause: %w", err)
+	}
+	shared, err := ecies.ImportECDSA(ourKey).GenerateShared(ecies.ImportECDSAPublic(peerKey), 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("could not derive shared secret. Cause: %w", err)
+	}
+
+	transcript := transcriptHash(handshakeDomain, initiatorEphemeral, responderEphemeral)
+	initiatorCipher, err := newSessionCipher(shared, transcript, initiatorRole)
+	if err != nil {
+		return nil, err
+	}
+	responderCipher, err := newSessionCipher(shared, transcript, responderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &secureConn{conn: conn, peerID: peerID, sendCipher: initiatorCipher, recvCipher: responderCipher}
+	if !isInitiator {
+		sc.sendCipher, sc.recvCipher = responderCipher, initiatorCipher
+	}
+	return sc, nil
+}
+
+// PeerID returns the authenticated host ID of the peer
+func (c *secureConn) PeerID() gethcommon.Address {
+	return c.peerID
+}
+
+func (c *secureConn) WriteMsg(msg []byte) error {
+	sealed := c.sendCipher.Seal(nil, nonceFor(c.sendNonce), msg, nil)
+	c.sendNonce++
+	return writeFrame(c.conn, sealed)
+}
+
+func (c *secureConn) ReadMsg() ([]byte, error) {
+	sealed, err := readFrame(c.conn)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := c.recvCipher.Open(nil, nonceFor(c.recvNonce), sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt message from peer %s. Cause: %w", c.peerID, err)
+	}
+	c.recvNonce++
+	return msg, nil
+}
+
+func (c *secureConn) Close() error {
+	return c.conn.Close()
+}
+
+func signTranscript(hostKey *ecdsa.PrivateKey, role string, initiatorEphemeral []byte, responderEphemeral []byte) ([]byte, error) {
+	signature, err := gethcrypto.Sign(transcriptHash(handshakeDomain, []byte(role), initiatorEphemeral, responderEphemeral), hostKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign handshake. Cause: %w", err)
+	}
+	return signature, nil
+}
+
+func recoverPeerID(role string, initiatorEphemeral []byte, responderEphemeral []byte, signature []byte) (gethcommon.Address, error) {
+	if len(initiatorEphemeral) == 0 || len(responderEphemeral) == 0 {
+		return gethcommon.Address{}, errors.New("missing ephemeral key in handshake")
+	}
+	pubKey, err := gethcrypto.SigToPub(transcriptHash(handshakeDomain, []byte(role), initiatorEphemeral, responderEphemeral), signature)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("invalid handshake signature. Cause: %w", err)
+	}
+	return gethcrypto.PubkeyToAddress(*pubKey), nil
+}
+
+func transcriptHash(domain string, parts ...[]byte) []byte {
+	return gethcrypto.Keccak256(append([][]byte{[]byte(domain)}, parts...)...)
+}
+
+func newSessionCipher(shared []byte, transcript []byte, direction string) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(gethcrypto.Keccak256(shared, transcript, []byte(direction)))
+	if err != nil {
+		return nil, fmt.Errorf("could not create session cipher. Cause: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func nonceFor(counter uint64) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], counter)
+	return nonce
+}
+
+func writeHandshakeMsg(conn net.Conn, msg *handshakeMsg) error {
+	encoded, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode handshake message. Cause: %w", err)
+	}
+	return writeFrame(conn, encoded)
+}
+
+func readHandshakeMsg(conn net.Conn) (*handshakeMsg, error) {
+	encoded, err := readFrame(conn)
+	if err != nil {
+		return nil, fmt.Errorf("could not read handshake message. Cause: %w", err)
+	}
+	msg := &handshakeMsg{}
+	if err = rlp.DecodeBytes(encoded, msg); err != nil {
+		return nil, fmt.Errorf("could not decode handshake message. Cause: %w", err)
+	}
+	return msg, nil
+}
+
+func writeFrame(w io.Writer, data []byte) error {
+	if len(data) > maxFrameSize {
+		return fmt.Errorf("message of %d bytes exceeds the maximum frame size", len(data))
+	}
+	prefix := make([]byte, framePrefixSize)
+	binary.BigEndian.PutUint32(prefix, uint32(len(data)))
+	if _, err := w.Write(append(prefix, data...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func readFrame(r io.Reader) ([]byte, error) {
+	prefix := make([]byte, framePrefixSize)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(prefix)
+	if size > maxFrameSize {
+		return nil, fmt.Errorf("frame of %d bytes exceeds the maximum frame size", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
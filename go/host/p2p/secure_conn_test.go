@@ -0,0 +1,80 @@
+package p2p
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"net"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+type handshakeResult struct {
+	conn *secureConn
+	err  error
+}
+
+func TestSecureConnAuthenticatesAndEncrypts(t *testing.T) {
+	clientKey, _ := gethcrypto.GenerateKey()
+	serverKey, _ := gethcrypto.GenerateKey()
+	allowAll := func(gethcommon.Address) error { return nil }
+
+	client, server := connect(t, clientKey, allowAll, serverKey, allowAll)
+	if client.err != nil || server.err != nil {
+		t.Fatalf("handshake failed. Client: %s, server: %s", client.err, server.err)
+	}
+	if client.conn.PeerID() != gethcrypto.PubkeyToAddress(serverKey.PublicKey) {
+		t.Errorf("client did not authenticate the server")
+	}
+	if server.conn.PeerID() != gethcrypto.PubkeyToAddress(clientKey.PublicKey) {
+		t.Errorf("server did not authenticate the client")
+	}
+
+	for _, msg := range [][]byte{[]byte("first"), []byte("second")} {
+		go func(msg []byte) {
+			_ = client.conn.WriteMsg(msg)
+		}(msg)
+		received, err := server.conn.ReadMsg()
+		if err != nil {
+			t.Fatalf("could not read message. Cause: %s", err)
+		}
+		if !bytes.Equal(received, msg) {
+			t.Errorf("expected message %s, got %s", msg, received)
+		}
+	}
+}
+
+func TestSecureConnRejectsUnauthorisedPeer(t *testing.T) {
+	clientKey, _ := gethcrypto.GenerateKey()
+	serverKey, _ := gethcrypto.GenerateKey()
+	allowAll := func(gethcommon.Address) error { return nil }
+	rejectAll := func(gethcommon.Address) error { return errors.New("not attested") }
+
+	_, server := connect(t, clientKey, allowAll, serverKey, rejectAll)
+	if server.err == nil {
+		t.Errorf("expected the server to reject the client")
+	}
+}
+
+func connect(t *testing.T, clientKey *ecdsa.PrivateKey, clientAuth peerAuthorizer, serverKey *ecdsa.PrivateKey, serverAuth peerAuthorizer) (handshakeResult, handshakeResult) {
+	t.Helper()
+	clientSide, serverSide := net.Pipe()
+	t.Cleanup(func() {
+		clientSide.Close()
+		serverSide.Close()
+	})
+
+	serverCh := make(chan handshakeResult)
+	go func() {
+		conn, err := newSecureServerConn(serverSide, serverKey, serverAuth)
+		if err != nil {
+			// unblocks the client, which is waiting for the end of the handshake
+			serverSide.Close()
+		}
+		serverCh <- handshakeResult{conn: conn, err: err}
+	}()
+	conn, err := newSecureClientConn(clientSide, clientKey, clientAuth)
+	return handshakeResult{conn: conn, err: err}, <-serverCh
+}
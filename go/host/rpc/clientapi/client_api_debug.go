@@ -2,32 +2,67 @@ package clientapi
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/responses"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
 )
 
 // NetworkDebug implements a subset of the Ethereum network JSON RPC operations.
 type NetworkDebug struct {
-	host host.Host
+	host   host.Host
+	logger gethlog.Logger
 }
 
-func NewNetworkDebug(host host.Host) *NetworkDebug {
+func NewNetworkDebug(host host.Host, logger gethlog.Logger) *NetworkDebug {
 	return &NetworkDebug{
-		host: host,
+		host:   host,
+		logger: logger,
 	}
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
-func (api *NetworkDebug) TraceTransaction(_ context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (interface{}, error) {
-	response, err := api.host.EnclaveClient().DebugTraceTransaction(hash, config)
-	if err != nil {
-		return "", err
+// and returns them as a JSON object, encrypted with the viewing key of the transaction sender.
+func (api *NetworkDebug) TraceTransaction(_ context.Context, encryptedParams common.EncryptedParamsDebugTraceTx) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugTraceTransaction(encryptedParams)
+	if sysError != nil {
+		return api.handleSysError("TraceTransaction", sysError)
 	}
-	return response, nil
+	return *enclaveResponse, nil
+}
+
+// TraceCall returns the structured logs created during the execution of the call on top of the state of the requested batch,
+// as a JSON object encrypted with the viewing key of the caller.
+func (api *NetworkDebug) TraceCall(_ context.Context, encryptedParams common.EncryptedParamsDebugTraceCall) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugTraceCall(encryptedParams)
+	if sysError != nil {
+		return api.handleSysError("TraceCall", sysError)
+	}
+	return *enclaveResponse, nil
+}
+
+// TraceBatchByNumber returns the traces of the transactions in the batch with the given number that were sent by the
+// viewing key account, encrypted with the viewing key of that account.
+func (api *NetworkDebug) TraceBatchByNumber(_ context.Context, encryptedParams common.EncryptedParamsDebugTraceBatch) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugTraceBatch(encryptedParams, common.BatchByNumber)
+	if sysError != nil {
+		return api.handleSysError("TraceBatchByNumber", sysError)
+	}
+	return *enclaveResponse, nil
+}
+
+// TraceBatchByHash is the same as TraceBatchByNumber, but for the batch with the given hash.
+func (api *NetworkDebug) TraceBatchByHash(_ context.Context, encryptedParams common.EncryptedParamsDebugTraceBatch) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugTraceBatch(encryptedParams, common.BatchByHash)
+	if sysError != nil {
+		return api.handleSysError("TraceBatchByHash", sysError)
+	}
+	return *enclaveResponse, nil
 }
 
 // EventLogRelevancy returns the events for a given transactions and the revelancy params
@@ -38,3 +73,10 @@ func (api *NetworkDebug) EventLogRelevancy(_ context.Context, hash gethcommon.Ha
 	}
 	return response, nil
 }
+
+func (api *NetworkDebug) handleSysError(function string, sysError common.SystemError) (responses.EnclaveResponse, error) {
+	api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", function), log.ErrKey, sysError)
+	return responses.EnclaveResponse{
+		Err: &responses.InternalErrMsg,
+	}, nil
+}
@@ -15,9 +15,17 @@ import (
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
 	gethlog "github.com/ethereum/go-ethereum/log"
 )
 
+const (
+	// maxFeeHistoryBatchCount is the maximum number of batches that can be requested in a single eth_feeHistory call
+	maxFeeHistoryBatchCount = 1024
+	// maxFeeHistoryPercentiles is the maximum number of reward percentiles that can be requested in eth_feeHistory
+	maxFeeHistoryPercentiles = 100
+)
+
 // EthereumAPI implements a subset of the Ethereum JSON RPC operations. All the method signatures are copied from the
 // corresponding Geth implementations.
 type EthereumAPI struct {
@@ -183,31 +191,86 @@ func (api *EthereumAPI) GetStorageAt(_ context.Context, encryptedParams common.E
 	return api.host.EnclaveClient().GetCustomQuery(encryptedParams)
 }
 
-// FeeHistory is a placeholder for an RPC method required by MetaMask/Remix.
-// rpc.DecimalOrHex -> []byte
-func (api *EthereumAPI) FeeHistory(context.Context, string, rpc.BlockNumber, []float64) (*FeeHistoryResult, error) {
-	// todo (#1621) - return a non-dummy fee history
-	header, err := api.host.DB().GetHeadBatchHeader()
+// FeeHistory returns the base fees, gas used ratios and priority fee percentiles of up to maxFeeHistoryBatchCount
+// batches ending with lastBlock. The rewards are computed by the enclave, as the batch transactions are encrypted.
+func (api *EthereumAPI) FeeHistory(_ context.Context, blockCount gethmath.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error) {
+	if blockCount == 0 {
+		return &FeeHistoryResult{OldestBlock: (*hexutil.Big)(big.NewInt(0)), GasUsedRatio: []float64{}}, nil
+	}
+	if len(rewardPercentiles) > maxFeeHistoryPercentiles {
+		return nil, fmt.Errorf("too many reward percentiles: %d, max %d", len(rewardPercentiles), maxFeeHistoryPercentiles)
+	}
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p <= rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f >= #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+
+	lastBatchHash, err := api.batchNumberToBatchHash(lastBlock)
 	if err != nil {
-		api.logger.Error("Unable to retrieve header for fee history.", log.ErrKey, err)
+		api.logger.Error("Unable to retrieve last batch hash for fee history.", log.ErrKey, err)
+		return nil, fmt.Errorf("unable to retrieve fee history")
+	}
+	lastHeader, err := api.host.DB().GetBatchHeader(*lastBatchHash)
+	if err != nil {
+		api.logger.Error("Unable to retrieve last batch header for fee history.", log.ErrKey, err)
 		return nil, fmt.Errorf("unable to retrieve fee history")
 	}
 
-	batches := make([]*common.BatchHeader, 0)
-	batches = append(batches, header)
+	count := uint64(blockCount)
+	if count > maxFeeHistoryBatchCount {
+		count = maxFeeHistoryBatchCount
+	}
+	if count > lastHeader.Number.Uint64()+1 {
+		count = lastHeader.Number.Uint64() + 1
+	}
 
-	feeHist := &FeeHistoryResult{
-		OldestBlock:  (*hexutil.Big)(header.Number),
-		Reward:       [][]*hexutil.Big{},
-		BaseFee:      []*hexutil.Big{},
-		GasUsedRatio: []float64{},
+	// walk back the chain from the last batch to collect the headers of the requested range
+	headers := make([]*common.BatchHeader, count)
+	headers[count-1] = lastHeader
+	for i := int(count) - 2; i >= 0; i-- {
+		headers[i], err = api.host.DB().GetBatchHeader(headers[i+1].ParentHash)
+		if err != nil {
+			api.logger.Error("Unable to retrieve batch header for fee history.", log.ErrKey, err)
+			return nil, fmt.Errorf("unable to retrieve fee history")
+		}
 	}
 
-	for _, header := range batches {
-		// 0.9 - This number represents how full the block is. As we dont have a dynamic base fee, we tell whomever is requesting that
-		// we expect the baseFee to increase, rather than decrease in order to avoid underpriced transactions.
-		feeHist.GasUsedRatio = append(feeHist.GasUsedRatio, 0.9)
+	feeHist := &FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(headers[0].Number),
+		BaseFee:      make([]*hexutil.Big, 0, count+1),
+		GasUsedRatio: make([]float64, 0, count),
+	}
+	batchHashes := make([]common.L2BatchHash, count)
+	for i, header := range headers {
+		batchHashes[i] = header.Hash()
 		feeHist.BaseFee = append(feeHist.BaseFee, (*hexutil.Big)(header.BaseFee))
+		gasUsedRatio := 0.0
+		if header.GasLimit > 0 {
+			gasUsedRatio = float64(header.GasUsed) / float64(header.GasLimit)
+		}
+		feeHist.GasUsedRatio = append(feeHist.GasUsedRatio, gasUsedRatio)
+	}
+	// the base fee of the next batch - the sequencer does not adjust the base fee, so it's the same as the last one
+	feeHist.BaseFee = append(feeHist.BaseFee, (*hexutil.Big)(lastHeader.BaseFee))
+
+	if len(rewardPercentiles) == 0 {
+		return feeHist, nil
+	}
+	rewards, sysError := api.host.EnclaveClient().GetBatchRewardPercentiles(batchHashes, rewardPercentiles)
+	if sysError != nil {
+		api.logger.Error("Unable to retrieve batch rewards for fee history.", log.ErrKey, sysError)
+		return nil, fmt.Errorf("unable to retrieve fee history")
+	}
+	feeHist.Reward = make([][]*hexutil.Big, len(rewards))
+	for i, batchRewards := range rewards {
+		feeHist.Reward[i] = make([]*hexutil.Big, len(batchRewards))
+		for j, reward := range batchRewards {
+			feeHist.Reward[i][j] = (*hexutil.Big)(reward)
+		}
 	}
 	return feeHist, nil
 }
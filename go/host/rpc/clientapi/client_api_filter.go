@@ -17,21 +17,39 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// the number of new heads buffered for a subscriber, before they are dropped
+const newHeadsBufferSize = 32
+
 // FilterAPI exposes a subset of Geth's PublicFilterAPI operations.
 type FilterAPI struct {
-	host   host.Host
-	logger gethlog.Logger
+	host           host.Host
+	pollingFilters *pollingFilters
+	logger         gethlog.Logger
 }
 
 func NewFilterAPI(host host.Host, logger gethlog.Logger) *FilterAPI {
-	return &FilterAPI{
-		host:   host,
-		logger: logger,
+	api := &FilterAPI{
+		host:           host,
+		pollingFilters: newPollingFilters(),
+		logger:         logger,
 	}
+	go api.uninstallExpiredFilters()
+	return api
 }
 
 // Logs returns a log subscription.
 func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (*rpc.Subscription, error) {
+	return api.encryptedSubscription(ctx, encryptedParams)
+}
+
+// NewPendingTransactions returns a subscription to the hashes of the pending transactions of the account of the viewing
+// key. The hashes are encrypted with the viewing key, like the logs.
+func (api *FilterAPI) NewPendingTransactions(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (*rpc.Subscription, error) {
+	return api.encryptedSubscription(ctx, encryptedParams)
+}
+
+// encryptedSubscription creates a subscription in the enclave, and forwards the events the enclave encrypts for it.
+func (api *FilterAPI) encryptedSubscription(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return nil, fmt.Errorf("creation of subscriptions is not supported")
@@ -41,7 +59,7 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 	logsFromSubscription := make(chan []byte)
 	err := api.host.Subscribe(subscription.ID, encryptedParams, logsFromSubscription)
 	if err != nil {
-		return nil, fmt.Errorf("could not subscribe for logs. Cause: %w", err)
+		return nil, fmt.Errorf("could not subscribe. Cause: %w", err)
 	}
 
 	// We send the ID of the newly-created subscription, before sending any log events. This is because the wallet
@@ -97,6 +115,33 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 	return subscription, nil
 }
 
+// NewHeads returns a subscription to the headers of the new head batches.
+func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	heads := make(chan *common.BatchHeader, newHeadsBufferSize)
+	unsubscribe := api.host.SubscribeNewHeads(heads)
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case header := <-heads:
+				if err := notifier.Notify(subscription.ID, header); err != nil {
+					api.logger.Debug("could not send new head to client on subscription", log.SubIDKey, subscription.ID, log.ErrKey, err)
+				}
+			case <-subscription.Err():
+				return
+			}
+		}
+	}()
+
+	return subscription, nil
+}
+
 // GetLogs returns the logs matching the filter.
 func (api *FilterAPI) GetLogs(_ context.Context, encryptedParams common.EncryptedParamsGetLogs) (responses.EnclaveResponse, error) {
 	enclaveResponse, sysError := api.host.EnclaveClient().GetLogs(encryptedParams)
@@ -0,0 +1,261 @@
+package clientapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+const (
+	// the filters that are not polled for this long are uninstalled, as in geth
+	filterTimeout = 5 * time.Minute
+	// the number of changes buffered for a filter between two polls, the oldest changes are dropped beyond it
+	maxFilterChanges = 1024
+	// the number of filters a client can have installed at once
+	maxFiltersPerClient = 256
+)
+
+// pollingFilter buffers the changes of a filter until they are polled with eth_getFilterChanges
+type pollingFilter struct {
+	client      string            // the client that created the filter, see filterClient
+	blocks      bool              // whether it is a block filter, or a log or pending transaction filter
+	encrypted   []hexutil.Bytes   // the changes of the log and pending transaction filters, encrypted with the viewing key
+	hashes      []gethcommon.Hash // the changes of the block filters
+	lastPoll    time.Time
+	unsubscribe func() // nil until the filter is subscribed
+}
+
+// pollingFilters are the filters polled by the clients that don't use subscriptions
+type pollingFilters struct {
+	filters          map[rpc.ID]*pollingFilter
+	filtersPerClient map[string]int
+	lock             sync.Mutex
+}
+
+// NewFilter creates a log filter, whose logs are returned by GetFilterChanges. The logs are filtered by the enclave
+// for the account of the viewing key of the params and encrypted with the viewing key, as for the log subscriptions.
+func (api *FilterAPI) NewFilter(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (rpc.ID, error) {
+	return api.newEncryptedFilter(ctx, encryptedParams)
+}
+
+// NewPendingTransactionFilter creates a filter of the hashes of the pending transactions of the account of the viewing
+// key, which are encrypted with the viewing key.
+func (api *FilterAPI) NewPendingTransactionFilter(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (rpc.ID, error) {
+	return api.newEncryptedFilter(ctx, encryptedParams)
+}
+
+// NewBlockFilter creates a filter of the hashes of the new head batches.
+func (api *FilterAPI) NewBlockFilter(ctx context.Context) (rpc.ID, error) {
+	id := rpc.NewID()
+	// the filter is added before it is subscribed, as the changes of the filters that are not added are dropped
+	if err := api.pollingFilters.add(id, &pollingFilter{client: filterClient(ctx), blocks: true, hashes: []gethcommon.Hash{}, lastPoll: time.Now()}); err != nil {
+		return "", err
+	}
+
+	heads := make(chan *common.BatchHeader, newHeadsBufferSize)
+	done := make(chan struct{})
+	unsubscribe := api.host.SubscribeNewHeads(heads)
+	go func() {
+		for {
+			select {
+			case header := <-heads:
+				api.pollingFilters.addChange(id, func(filter *pollingFilter) {
+					filter.hashes = appendChange(filter.hashes, header.Hash())
+				})
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	api.pollingFilters.subscribed(id, func() {
+		unsubscribe()
+		close(done)
+	})
+	return id, nil
+}
+
+// GetFilterChanges returns the changes of the filter since the last poll: the hashes of the new head batches for the
+// block filters, and the encrypted logs or pending transaction hashes for the other filters.
+func (api *FilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
+	api.pollingFilters.lock.Lock()
+	defer api.pollingFilters.lock.Unlock()
+
+	filter, found := api.pollingFilters.filters[id]
+	if !found {
+		return nil, fmt.Errorf("filter not found")
+	}
+	filter.lastPoll = time.Now()
+	if filter.blocks {
+		hashes := filter.hashes
+		filter.hashes = []gethcommon.Hash{}
+		return hashes, nil
+	}
+	encrypted := filter.encrypted
+	filter.encrypted = []hexutil.Bytes{}
+	return encrypted, nil
+}
+
+// UninstallFilter removes the filter, and returns whether it existed.
+func (api *FilterAPI) UninstallFilter(id rpc.ID) bool {
+	filter := api.pollingFilters.remove(id)
+	if filter == nil {
+		return false
+	}
+	if filter.unsubscribe != nil {
+		filter.unsubscribe()
+	}
+	return true
+}
+
+func (api *FilterAPI) newEncryptedFilter(ctx context.Context, encryptedParams common.EncryptedParamsLogSubscription) (rpc.ID, error) {
+	id := rpc.NewID()
+	// the filter is added before it is subscribed, as the changes of the filters that are not added are dropped
+	if err := api.pollingFilters.add(id, &pollingFilter{client: filterClient(ctx), encrypted: []hexutil.Bytes{}, lastPoll: time.Now()}); err != nil {
+		return "", err
+	}
+
+	changes := make(chan []byte)
+	if err := api.host.Subscribe(id, encryptedParams, changes); err != nil {
+		api.pollingFilters.remove(id)
+		return "", fmt.Errorf("could not create filter. Cause: %w", err)
+	}
+	// the channel is closed when the filter is unsubscribed
+	go func() {
+		for encryptedChange := range changes {
+			api.pollingFilters.addChange(id, func(filter *pollingFilter) {
+				filter.encrypted = appendChange(filter.encrypted, encryptedChange)
+			})
+		}
+	}()
+
+	api.pollingFilters.subscribed(id, func() {
+		api.host.Unsubscribe(id)
+	})
+	return id, nil
+}
+
+// uninstallExpiredFilters removes the filters that are not polled, until the host stops
+func (api *FilterAPI) uninstallExpiredFilters() {
+	ticker := time.NewTicker(filterTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			api.uninstall(api.pollingFilters.removeExpired(api.logger))
+		case <-api.host.Done():
+			return
+		}
+	}
+}
+
+// uninstall unsubscribes the removed filters. It is called without the lock, as the changes are added with it
+func (api *FilterAPI) uninstall(filters []*pollingFilter) {
+	for _, filter := range filters {
+		if filter.unsubscribe != nil {
+			filter.unsubscribe()
+		}
+	}
+}
+
+// filterClient identifies the client of an RPC call, to cap its number of filters. The websocket clients are
+// identified by their connection, and the HTTP clients by their IP, as their requests may use different connections.
+func filterClient(ctx context.Context) string {
+	peer := rpc.PeerInfoFromContext(ctx)
+	if peer.Transport == "http" {
+		if ip, _, err := net.SplitHostPort(peer.RemoteAddr); err == nil {
+			return ip
+		}
+	}
+	return peer.RemoteAddr
+}
+
+func newPollingFilters() *pollingFilters {
+	return &pollingFilters{filters: map[rpc.ID]*pollingFilter{}, filtersPerClient: map[string]int{}}
+}
+
+// add adds the filter, unless its client already has the maximum number of filters
+func (p *pollingFilters) add(id rpc.ID, filter *pollingFilter) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.filtersPerClient[filter.client] >= maxFiltersPerClient {
+		return fmt.Errorf("could not create filter as the maximum of %d filters per client is reached", maxFiltersPerClient)
+	}
+	p.filters[id] = filter
+	p.filtersPerClient[filter.client]++
+	return nil
+}
+
+// subscribed sets how the filter is unsubscribed, or unsubscribes it if it was removed while it was being subscribed
+func (p *pollingFilters) subscribed(id rpc.ID, unsubscribe func()) {
+	p.lock.Lock()
+	filter, found := p.filters[id]
+	if found {
+		filter.unsubscribe = unsubscribe
+	}
+	p.lock.Unlock()
+
+	if !found {
+		unsubscribe()
+	}
+}
+
+func (p *pollingFilters) remove(id rpc.ID) *pollingFilter {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	filter, found := p.filters[id]
+	if !found {
+		return nil
+	}
+	p.delete(id, filter)
+	return filter
+}
+
+// removeExpired removes and returns the filters that were not polled within the filter timeout
+func (p *pollingFilters) removeExpired(logger gethlog.Logger) []*pollingFilter {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var expired []*pollingFilter
+	for id, filter := range p.filters {
+		if time.Since(filter.lastPoll) > filterTimeout {
+			expired = append(expired, filter)
+			p.delete(id, filter)
+			logger.Debug("Uninstalling expired filter", log.SubIDKey, id)
+		}
+	}
+	return expired
+}
+
+// delete removes the filter, and must be called with the lock
+func (p *pollingFilters) delete(id rpc.ID, filter *pollingFilter) {
+	delete(p.filters, id)
+	p.filtersPerClient[filter.client]--
+	if p.filtersPerClient[filter.client] == 0 {
+		delete(p.filtersPerClient, filter.client)
+	}
+}
+
+// addChange applies the change to the filter, unless it was removed
+func (p *pollingFilters) addChange(id rpc.ID, apply func(filter *pollingFilter)) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if filter, found := p.filters[id]; found {
+		apply(filter)
+	}
+}
+
+func appendChange[T any](changes []T, change T) []T {
+	if len(changes) >= maxFilterChanges {
+		changes = changes[1:]
+	}
+	return append(changes, change)
+}
@@ -0,0 +1,65 @@
+package clientapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/responses"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// TenAPI implements the TEN-specific JSON RPC operations.
+type TenAPI struct {
+	host   host.Host
+	logger gethlog.Logger
+}
+
+func NewTenAPI(host host.Host, logger gethlog.Logger) *TenAPI {
+	return &TenAPI{
+		host:   host,
+		logger: logger,
+	}
+}
+
+// GetCrossChainProof returns the inclusion proof of an outbound message or value transfer in the cross chain tree of
+// the batch, which is what the management contract checks to relay it on the L1. The index counts the messages first,
+// in the order of the cross chain messages of the batch header, and then the value transfers, in the order they were
+// made in. The proofs are public, like the events of the message bus the messages and transfers come from.
+func (api *TenAPI) GetCrossChainProof(batchHash common.L2BatchHash, index hexutil.Uint64) (*common.CrossChainProof, error) {
+	proof, err := api.host.EnclaveClient().GetCrossChainProof(batchHash, uint64(index))
+	if err != nil {
+		if errors.Is(err, &syserr.InternalError{}) || errors.Is(err, &syserr.RPCError{}) {
+			api.logger.Error("Enclave System Error. Function GetCrossChainProof", log.ErrKey, err)
+			return nil, errors.New(responses.InternalErrMsg)
+		}
+		return nil, err
+	}
+	return proof, nil
+}
+
+// GetCrossChainMessageStatus returns how far the cross chain messages matching the query got, with the block, batch
+// and rollup of each stage they went through. The query is either the hash of the L1 or L2 transaction that published
+// the messages, or an object with the sender and sequence number of a message.
+func (api *TenAPI) GetCrossChainMessageStatus(query common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error) {
+	return api.host.EnclaveClient().GetCrossChainMessageStatus(&query)
+}
+
+// EstimateGasBreakdown returns the gas limit eth_estimateGas would return for the callMsg supplied params (encrypted),
+// split between the gas used by the execution and the gas charged for publishing the transaction on the L1, encrypted
+// with the viewing key corresponding to the `from` field.
+func (api *TenAPI) EstimateGasBreakdown(_ context.Context, encryptedParams common.EncryptedParamsEstimateGas) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().EstimateGasBreakdown(encryptedParams)
+	if sysError != nil {
+		api.logger.Error("Enclave System Error. Function EstimateGasBreakdown", log.ErrKey, sysError)
+		return responses.EnclaveResponse{
+			Err: &responses.InternalErrMsg,
+		}, nil
+	}
+	return *enclaveResponse, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -19,7 +20,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/responses"
 
@@ -41,21 +41,14 @@ type Client struct {
 }
 
 func NewClient(config *config.HostConfig, logger gethlog.Logger) common.Enclave {
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	connection, err := grpc.Dial(config.EnclaveRPCAddress, opts...)
-	if err != nil {
-		logger.Crit("Failed to connect to enclave RPC service.", log.ErrKey, err)
-	}
-	connection.Connect()
-	// perform an initial sleep because that Connect() method is not blocking and the retry immediately checks the status
-	time.Sleep(500 * time.Millisecond)
+	client := dial(config, config.EnclaveRPCAddress, logger)
 
 	// We wait for the RPC connection to be ready.
-	err = retry.Do(func() error {
-		currState := connection.GetState()
+	err := retry.Do(func() error {
+		currState := client.connection.GetState()
 		if currState != connectivity.Ready {
 			logger.Info("retrying connection until enclave is available", "status", currState.String(), "rpcAddr", config.EnclaveRPCAddress)
-			connection.Connect()
+			client.connection.Connect()
 			return fmt.Errorf("connection is not ready, status=%s", currState)
 		}
 		// connection is ready, break out of the loop
@@ -66,6 +59,26 @@ func NewClient(config *config.HostConfig, logger gethlog.Logger) common.Enclave
 		logger.Crit("failed to connect to enclave", log.ErrKey, err)
 	}
 
+	return client
+}
+
+// NewClientForAddress returns a client for one of the enclaves of a host with multiple enclaves. Unlike NewClient it
+// does not wait for the enclave to be available, so one enclave being down does not prevent the host from starting
+// (the guardian of the enclave waits for it instead).
+func NewClientForAddress(config *config.HostConfig, address string, logger gethlog.Logger) common.Enclave {
+	return dial(config, address, logger.New("rpcAddr", address))
+}
+
+func dial(config *config.HostConfig, address string, logger gethlog.Logger) *Client {
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	connection, err := grpc.Dial(address, opts...)
+	if err != nil {
+		logger.Crit("Failed to connect to enclave RPC service.", log.ErrKey, err)
+	}
+	connection.Connect()
+	// perform an initial sleep because that Connect() method is not blocking and the retry immediately checks the status
+	time.Sleep(500 * time.Millisecond)
+
 	return &Client{
 		protoClient: generated.NewEnclaveProtoClient(connection),
 		connection:  connection,
@@ -159,7 +172,7 @@ func (c *Client) EnclaveID() (common.EnclaveID, common.SystemError) {
 	return common.EnclaveID(response.EnclaveID), nil
 }
 
-func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLatest bool) (*common.BlockSubmissionResponse, common.SystemError) {
+func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, blobs common.L1Blobs, isLatest bool) (*common.BlockSubmissionResponse, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
@@ -173,7 +186,15 @@ func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLat
 		return nil, fmt.Errorf("could not encode receipts. Cause: %w", err)
 	}
 
-	response, err := c.protoClient.SubmitL1Block(timeoutCtx, &generated.SubmitBlockRequest{EncodedBlock: buffer.Bytes(), EncodedReceipts: serialized, IsLatest: isLatest})
+	var encodedBlobs []byte
+	if len(blobs) > 0 {
+		encodedBlobs, err = rlp.EncodeToBytes(blobs)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode blobs. Cause: %w", err)
+		}
+	}
+
+	response, err := c.protoClient.SubmitL1Block(timeoutCtx, &generated.SubmitBlockRequest{EncodedBlock: buffer.Bytes(), EncodedReceipts: serialized, EncodedBlobs: encodedBlobs, IsLatest: isLatest})
 	if err != nil {
 		return nil, fmt.Errorf("could not submit block. Cause: %w", err)
 	}
@@ -381,6 +402,23 @@ func (c *Client) EstimateGas(encryptedParams common.EncryptedParamsEstimateGas)
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
+func (c *Client) EstimateGasBreakdown(encryptedParams common.EncryptedParamsEstimateGas) (*responses.GasBreakdown, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.EstimateGasBreakdown(timeoutCtx, &generated.EstimateGasBreakdownRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
 func (c *Client) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -447,19 +485,26 @@ func (c *Client) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, common.Syste
 	return rpc.FromExtRollupMsg(response.Msg), nil
 }
 
-func (c *Client) DebugTraceTransaction(hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+func (c *Client) DebugTraceTransaction(encryptedParams common.EncryptedParamsDebugTraceTx) (*responses.DebugTraceTx, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
-	confBytes, err := json.Marshal(config)
+	response, err := c.protoClient.DebugTraceTransaction(timeoutCtx, &generated.DebugTraceTransactionRequest{EncryptedParams: encryptedParams})
 	if err != nil {
-		return nil, err
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	response, err := c.protoClient.DebugTraceTransaction(timeoutCtx, &generated.DebugTraceTransactionRequest{
-		TxHash: hash.Bytes(),
-		Config: confBytes,
-	})
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) DebugTraceCall(encryptedParams common.EncryptedParamsDebugTraceCall) (*responses.DebugTraceCall, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.DebugTraceCall(timeoutCtx, &generated.DebugTraceCallRequest{EncryptedParams: encryptedParams})
 	if err != nil {
 		return nil, syserr.NewRPCError(err)
 	}
@@ -467,7 +512,22 @@ func (c *Client) DebugTraceTransaction(hash gethcommon.Hash, config *tracers.Tra
 		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	return json.RawMessage(response.Msg), nil
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) DebugTraceBatch(encryptedParams common.EncryptedParamsDebugTraceBatch, batchID common.BatchIdentifier) (*responses.DebugTraceBatch, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.DebugTraceBatch(timeoutCtx, &generated.DebugTraceBatchRequest{EncryptedParams: encryptedParams, BatchIdentifier: uint32(batchID)})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
 func (c *Client) GetBatch(hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
@@ -598,6 +658,85 @@ func (c *Client) GetCustomQuery(encryptedParams common.EncryptedParamsGetStorage
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
+func (c *Client) GetBatchRewardPercentiles(batchHashes []common.L2BatchHash, percentiles []float64) ([][]*big.Int, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	encodedHashes := make([][]byte, len(batchHashes))
+	for i, batchHash := range batchHashes {
+		encodedHashes[i] = batchHash.Bytes()
+	}
+	response, err := c.protoClient.GetBatchRewardPercentiles(timeoutCtx, &generated.GetBatchRewardPercentilesRequest{
+		BatchHashes: encodedHashes,
+		Percentiles: percentiles,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	var rewards [][]*big.Int
+	err = json.Unmarshal(response.Rewards, &rewards)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("unable to unmarshal batch reward percentiles - %w", err))
+	}
+	return rewards, nil
+}
+
+func (c *Client) GetCrossChainProof(batchHash common.L2BatchHash, index uint64) (*common.CrossChainProof, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetCrossChainProof(timeoutCtx, &generated.GetCrossChainProofRequest{
+		BatchHash: batchHash.Bytes(),
+		Index:     index,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if response != nil && response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	var proof common.CrossChainProof
+	err = json.Unmarshal(response.Proof, &proof)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("unable to unmarshal cross chain proof - %w", err))
+	}
+	return &proof, nil
+}
+
+func (c *Client) GetCrossChainMessageStatus(query *common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	marshalledQuery, err := json.Marshal(query)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("unable to marshal cross chain message query - %w", err))
+	}
+	response, err := c.protoClient.GetCrossChainMessageStatus(timeoutCtx, &generated.GetCrossChainMessageStatusRequest{
+		Query: marshalledQuery,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	var statuses []*common.CrossChainMessageStatus
+	err = json.Unmarshal(response.Statuses, &statuses)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("unable to unmarshal cross chain message status - %w", err))
+	}
+	return statuses, nil
+}
+
 func (c *Client) GetPublicTransactionData(pagination *common.QueryPagination) (*common.TransactionListingResponse, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -637,5 +776,6 @@ func (c *Client) EnclavePublicConfig() (*common.EnclavePublicConfig, common.Syst
 	}
 	return &common.EnclavePublicConfig{
 		L2MessageBusAddress: gethcommon.BytesToAddress(response.L2MessageBusAddress),
+		SequencerPublicKeys: response.SequencerPublicKeys,
 	}, nil
 }
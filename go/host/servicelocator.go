@@ -59,3 +59,7 @@ func (s *ServicesRegistry) Enclaves() hostcommon.EnclaveService {
 func (s *ServicesRegistry) LogSubs() hostcommon.LogSubscriptionManager {
 	return s.getService(hostcommon.LogSubscriptionServiceName).(hostcommon.LogSubscriptionManager)
 }
+
+func (s *ServicesRegistry) NewHeads() hostcommon.NewHeadsManager {
+	return s.getService(hostcommon.NewHeadsServiceName).(hostcommon.NewHeadsManager)
+}
@@ -176,6 +176,17 @@ func (ac *AuthObsClient) EstimateGas(ctx context.Context, msg *ethereum.CallMsg)
 	return hexutil.DecodeUint64(result.String())
 }
 
+// EstimateGasBreakdown returns the gas limit EstimateGas returns for the message, split between the gas used by its
+// execution and the gas charged for publishing it on the L1
+func (ac *AuthObsClient) EstimateGasBreakdown(ctx context.Context, msg *ethereum.CallMsg) (*common.GasEstimateBreakdown, error) {
+	var result common.GasEstimateBreakdown
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.EstimateGasBreakdown, ToCallArg(*msg))
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func (ac *AuthObsClient) EstimateGasAndGasPrice(txData types.TxData) types.TxData {
 	unEstimatedTx := types.NewTx(txData)
 
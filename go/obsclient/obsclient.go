@@ -159,6 +159,28 @@ func (oc *ObsClient) GetBlockListing(pagination *common.QueryPagination) (*commo
 	return &result, nil
 }
 
+// GetCrossChainProof returns the inclusion proof of the outbound message or value transfer at the index of the cross
+// chain tree of the batch, to relay it on the L1
+func (oc *ObsClient) GetCrossChainProof(batchHash gethcommon.Hash, index uint64) (*common.CrossChainProof, error) {
+	var result common.CrossChainProof
+	err := oc.rpcClient.Call(&result, rpc.GetCrossChainProof, batchHash, hexutil.Uint64(index))
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCrossChainMessageStatus returns the lifecycle stages reached by the cross chain messages published by a
+// transaction on either layer, or by the message with the sequence number of a sender
+func (oc *ObsClient) GetCrossChainMessageStatus(query common.CrossChainMessageQuery) ([]*common.CrossChainMessageStatus, error) {
+	var result []*common.CrossChainMessageStatus
+	err := oc.rpcClient.Call(&result, rpc.GetCrossChainMessageStatus, query)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetConfig returns the network config for obscuro
 func (oc *ObsClient) GetConfig() (*common.ObscuroNetworkInfo, error) {
 	var result common.ObscuroNetworkInfo
@@ -35,9 +35,13 @@ type (
 	TxByHash             = EnclaveResponse // As above, but for an RPC getTransactionByHash request.
 	TxCount              = EnclaveResponse // As above, but for an RPC getTransactionCount request.
 	Gas                  = EnclaveResponse // As above, but for an RPC estimateGas response.
+	GasBreakdown         = EnclaveResponse // As above, but for an RPC ten_estimateGasBreakdown response.
 	Logs                 = EnclaveResponse
 	Receipts             = EnclaveResponse
 	PrivateQueryResponse = EnclaveResponse
+	DebugTraceTx         = EnclaveResponse // As above, but for an RPC debug_traceTransaction response.
+	DebugTraceCall       = EnclaveResponse // As above, but for an RPC debug_traceCall response.
+	DebugTraceBatch      = EnclaveResponse // As above, but for an RPC debug_traceBatchByNumber or debug_traceBatchByHash response.
 )
 
 // Data Types
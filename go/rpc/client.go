@@ -24,20 +24,37 @@ const (
 	GetStorageAt          = "eth_getStorageAt"
 	GasPrice              = "eth_gasPrice"
 
+	NewFilter                   = "eth_newFilter"
+	NewBlockFilter              = "eth_newBlockFilter"
+	NewPendingTransactionFilter = "eth_newPendingTransactionFilter"
+	GetFilterChanges            = "eth_getFilterChanges"
+	UninstallFilter             = "eth_uninstallFilter"
+
+	DebugTraceTransaction   = "debug_traceTransaction"
+	DebugTraceCall          = "debug_traceCall"
+	DebugTraceBatchByNumber = "debug_traceBatchByNumber"
+	DebugTraceBatchByHash   = "debug_traceBatchByHash"
+
 	Health = "obscuro_health"
 	Config = "obscuro_config"
 
-	GetBlockHeaderByHash = "tenscan_getBlockHeaderByHash"
-	GetBatch             = "tenscan_getBatch"
-	GetBatchForTx        = "tenscan_getBatchForTx"
-	GetLatestTxs         = "tenscan_getLatestTransactions"
-	GetTotalTxs          = "tenscan_getTotalTransactions"
-	Attestation          = "tenscan_attestation"
-	StopHost             = "test_stopHost"
-	Subscribe            = "eth_subscribe"
-	Unsubscribe          = "eth_unsubscribe"
-	SubscribeNamespace   = "eth"
-	SubscriptionTypeLogs = "logs"
+	GetCrossChainProof         = "ten_getCrossChainProof"
+	GetCrossChainMessageStatus = "ten_getCrossChainMessageStatus"
+	EstimateGasBreakdown       = "ten_estimateGasBreakdown"
+
+	GetBlockHeaderByHash       = "tenscan_getBlockHeaderByHash"
+	GetBatch                   = "tenscan_getBatch"
+	GetBatchForTx              = "tenscan_getBatchForTx"
+	GetLatestTxs               = "tenscan_getLatestTransactions"
+	GetTotalTxs                = "tenscan_getTotalTransactions"
+	Attestation                = "tenscan_attestation"
+	StopHost                   = "test_stopHost"
+	Subscribe                  = "eth_subscribe"
+	Unsubscribe                = "eth_unsubscribe"
+	SubscribeNamespace         = "eth"
+	SubscriptionTypeLogs       = "logs"
+	SubscriptionTypeNewHeads   = "newHeads"
+	SubscriptionTypePendingTxs = "newPendingTransactions"
 
 	// GetL1RollupHeaderByHash  = "scan_getL1RollupHeaderByHash"
 	// GetActiveNodeCount       = "scan_getActiveNodeCount"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
@@ -40,8 +41,13 @@ var SensitiveMethods = []string{
 	GetTransactionReceipt,
 	SendRawTransaction,
 	EstimateGas,
+	EstimateGasBreakdown,
 	GetLogs,
 	GetStorageAt,
+	DebugTraceTransaction,
+	DebugTraceCall,
+	DebugTraceBatchByNumber,
+	DebugTraceBatchByHash,
 }
 
 // EncRPCClient is a Client wrapper that implements Client but also has extra functionality for managing viewing key registration and decryption
@@ -96,8 +102,15 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, _ interface{}, namespace s
 	}
 
 	subscriptionType := args[0]
-	if subscriptionType != SubscriptionTypeLogs {
-		return nil, fmt.Errorf("only subscriptions of type %s are supported", SubscriptionTypeLogs)
+	switch subscriptionType {
+	case SubscriptionTypeNewHeads:
+		// the heads are public, so they are not encrypted
+		return c.obscuroClient.Subscribe(ctx, nil, namespace, ch, subscriptionType)
+	case SubscriptionTypePendingTxs:
+		return c.subscribePendingTxs(ctx, namespace, ch)
+	case SubscriptionTypeLogs:
+	default:
+		return nil, fmt.Errorf("only subscriptions of type %s, %s and %s are supported", SubscriptionTypeLogs, SubscriptionTypeNewHeads, SubscriptionTypePendingTxs)
 	}
 
 	logSubscription, err := c.createAuthenticatedLogSubscription(args)
@@ -131,6 +144,155 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, _ interface{}, namespace s
 	return subscriptionToObscuro, nil
 }
 
+// subscribePendingTxs subscribes to the hashes of the pending transactions of the account, which are sent to ch (a
+// `chan common.IDAndTxHash`)
+func (c *EncRPCClient) subscribePendingTxs(ctx context.Context, namespace string, ch interface{}) (*gethrpc.ClientSubscription, error) {
+	txHashCh, ok := ch.(chan common.IDAndTxHash)
+	if !ok {
+		return nil, fmt.Errorf("expected a channel of type `chan common.IDAndTxHash`, got %T", ch)
+	}
+
+	encryptedParams, err := c.encryptLogSubscription(c.pendingTxsSubscription())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt args for subscription in namespace %s - %w", namespace, err)
+	}
+
+	clientChannel := make(chan common.IDAndEncLog)
+	subscriptionToObscuro, err := c.obscuroClient.Subscribe(ctx, nil, namespace, clientChannel, SubscriptionTypePendingTxs, encryptedParams)
+	if err != nil {
+		return nil, err
+	}
+
+	go c.forwardPendingTxs(clientChannel, txHashCh, subscriptionToObscuro)
+
+	return subscriptionToObscuro, nil
+}
+
+// NewLogFilter creates a polling filter of the logs matching the filter criteria (in the JSON-RPC format) that the
+// account can see, and returns its ID
+func (c *EncRPCClient) NewLogFilter(ctx context.Context, filterCriteria interface{}) (string, error) {
+	logSubscription, err := c.createAuthenticatedLogSubscription([]interface{}{SubscriptionTypeLogs, filterCriteria})
+	if err != nil {
+		return "", err
+	}
+	return c.newEncryptedFilter(ctx, NewFilter, logSubscription)
+}
+
+// NewPendingTxFilter creates a polling filter of the pending transactions of the account, and returns its ID
+func (c *EncRPCClient) NewPendingTxFilter(ctx context.Context) (string, error) {
+	return c.newEncryptedFilter(ctx, NewPendingTransactionFilter, c.pendingTxsSubscription())
+}
+
+// GetLogFilterChanges returns the logs of the log filter since it was last polled
+func (c *EncRPCClient) GetLogFilterChanges(ctx context.Context, filterID string) ([]*types.Log, error) {
+	var encryptedChanges []hexutil.Bytes
+	if err := c.executeRPCCall(ctx, &encryptedChanges, GetFilterChanges, filterID); err != nil {
+		return nil, err
+	}
+	allLogs := []*types.Log{}
+	for _, encryptedLogs := range encryptedChanges {
+		jsonLogs, err := c.decryptResponse(encryptedLogs)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt logs of filter - %w", err)
+		}
+		var logs []*types.Log
+		if err = json.Unmarshal(jsonLogs, &logs); err != nil {
+			return nil, fmt.Errorf("could not unmarshal logs of filter - %w", err)
+		}
+		allLogs = append(allLogs, logs...)
+	}
+	return allLogs, nil
+}
+
+// GetPendingTxFilterChanges returns the hashes of the pending transactions of the pending transaction filter since it
+// was last polled
+func (c *EncRPCClient) GetPendingTxFilterChanges(ctx context.Context, filterID string) ([]gethcommon.Hash, error) {
+	var encryptedChanges []hexutil.Bytes
+	if err := c.executeRPCCall(ctx, &encryptedChanges, GetFilterChanges, filterID); err != nil {
+		return nil, err
+	}
+	txHashes := make([]gethcommon.Hash, 0, len(encryptedChanges))
+	for _, encryptedTxHash := range encryptedChanges {
+		jsonTxHash, err := c.decryptResponse(encryptedTxHash)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt pending transaction of filter - %w", err)
+		}
+		var txHash gethcommon.Hash
+		if err = json.Unmarshal(jsonTxHash, &txHash); err != nil {
+			return nil, fmt.Errorf("could not unmarshal pending transaction of filter - %w", err)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	return txHashes, nil
+}
+
+func (c *EncRPCClient) newEncryptedFilter(ctx context.Context, method string, logSubscription *common.LogSubscription) (string, error) {
+	encryptedParams, err := c.encryptLogSubscription(logSubscription)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt args for %s call - %w", method, err)
+	}
+	var filterID string
+	if err = c.executeRPCCall(ctx, &filterID, method, encryptedParams); err != nil {
+		return "", err
+	}
+	return filterID, nil
+}
+
+func (c *EncRPCClient) pendingTxsSubscription() *common.LogSubscription {
+	return &common.LogSubscription{
+		ViewingKey: &viewingkey.RPCSignedViewingKey{
+			PublicKey:               c.viewingKey.PublicKey,
+			SignatureWithAccountKey: c.viewingKey.SignatureWithAccountKey,
+			Account:                 c.Account(),
+		},
+		// the filter is not used, but RLP decoding fails on the enclave side without a block hash
+		Filter:     &filters.FilterCriteria{BlockHash: &gethcommon.Hash{}},
+		PendingTxs: true,
+	}
+}
+
+// encryptLogSubscription encodes the subscription with RLP, and encrypts it with the enclave key
+func (c *EncRPCClient) encryptLogSubscription(logSubscription *common.LogSubscription) ([]byte, error) {
+	// We use RLP instead of JSON marshaling here, as for some reason the filter criteria doesn't unmarshal correctly from JSON.
+	encodedLogSubscription, err := rlp.EncodeToBytes(logSubscription)
+	if err != nil {
+		return nil, err
+	}
+	return c.encryptParamBytes(encodedLogSubscription)
+}
+
+func (c *EncRPCClient) forwardPendingTxs(clientChannel chan common.IDAndEncLog, txHashCh chan common.IDAndTxHash, subscription *gethrpc.ClientSubscription) {
+	for {
+		select {
+		case idAndEncTx := <-clientChannel:
+			// the first notification only carries the subscription ID
+			if idAndEncTx.EncLog == nil {
+				continue
+			}
+			jsonTxHash, err := c.decryptResponse(idAndEncTx.EncLog)
+			if err != nil {
+				c.logger.Error("could not decrypt pending transaction received from subscription.", log.ErrKey, err)
+				continue
+			}
+
+			var txHash gethcommon.Hash
+			if err = json.Unmarshal(jsonTxHash, &txHash); err != nil {
+				c.logger.Error(fmt.Sprintf("could not unmarshal pending transaction hash from JSON. Received data: %s.", string(jsonTxHash)), log.ErrKey, err)
+				continue
+			}
+			txHashCh <- common.IDAndTxHash{SubID: idAndEncTx.SubID, TxHash: txHash}
+
+		case err := <-subscription.Err():
+			if err != nil {
+				c.logger.Info("subscription to obscuro node closed with error", log.ErrKey, err)
+			} else {
+				c.logger.Info("subscription to obscuro node closed")
+			}
+			return
+		}
+	}
+}
+
 func (c *EncRPCClient) forwardLogs(clientChannel chan common.IDAndEncLog, logCh chan common.IDAndLog, subscription *gethrpc.ClientSubscription) {
 	for {
 		select {
@@ -252,7 +414,7 @@ func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interfac
 	if decodedError != nil {
 		// EstimateGas and Call methods return EVM Errors that are json objects
 		// and contain multiple keys that normally do not get serialized
-		if method == EstimateGas || method == Call {
+		if method == EstimateGas || method == EstimateGasBreakdown || method == Call {
 			var result errutil.EVMSerialisableError
 			err = json.Unmarshal([]byte(decodedError.Error()), &result)
 			if err != nil {
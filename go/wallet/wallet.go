@@ -69,11 +69,11 @@ func NewInMemoryWalletFromConfig(pkStr string, l1ChainID int64, logger gethlog.L
 
 // SignTransaction returns a signed transaction
 func (m *inMemoryWallet) SignTransaction(tx types.TxData) (*types.Transaction, error) {
-	return types.SignNewTx(m.prvKey, types.NewLondonSigner(m.chainID), tx)
+	return types.SignNewTx(m.prvKey, types.NewCancunSigner(m.chainID), tx)
 }
 
 func (m *inMemoryWallet) SignTransactionForChainID(tx types.TxData, chainID *big.Int) (*types.Transaction, error) {
-	return types.SignNewTx(m.prvKey, types.NewLondonSigner(chainID), tx)
+	return types.SignNewTx(m.prvKey, types.NewCancunSigner(chainID), tx)
 }
 
 // Address returns the current wallet address
@@ -8,6 +8,8 @@ const (
 	StartPortTenGatewayUnitTest      = 16000
 	StartPortSimulationGethInMem     = 18000
 	StartPortSimulationInMem         = 22000
+	StartPortSimulationInMemBlobs    = 24000
+	StartPortSimulationInMemFailover = 25000
 	StartPortSimulationFullNetwork   = 26000
 	StartPortNetworkTests            = 28000
 	StartPortSmartContractTests      = 30000
@@ -16,6 +18,7 @@ const (
 	StartPortWalletExtensionUnitTest = 38000
 	StartPortFaucetUnitTest          = 42000
 	StartPortFaucetHTTPUnitTest      = 48000
+	StartPortRelayerUnitTest         = 49000
 
 	DefaultGethWSPortOffset         = 100
 	DefaultGethAUTHPortOffset       = 200
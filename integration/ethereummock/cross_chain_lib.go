@@ -0,0 +1,56 @@
+package ethereummock
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/crosschainlib"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type crossChainLib struct{}
+
+// NewCrossChainLibMock is an implementation of the crosschainlib.CrossChainLib
+func NewCrossChainLibMock() crosschainlib.CrossChainLib {
+	return &crossChainLib{}
+}
+
+func (c *crossChainLib) CreateRelayMessage(tx *ethadapter.L1RelayMessageTx) types.TxData {
+	return encodeTx(tx, relayMessageTxAddr)
+}
+
+func (c *crossChainLib) GetMessageTimeOfFinalityMsg(msg common.CrossChainMessage) (ethereum.CallMsg, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(msg); err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not encode message. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: &messageFinalityCallAddr, Data: buf.Bytes()}, nil
+}
+
+func (c *crossChainLib) DecodeMessageTimeOfFinalityResponse(callResponse []byte) (uint64, error) {
+	return new(big.Int).SetBytes(callResponse).Uint64(), nil
+}
+
+// decodeFinalityCall returns the message of a call created by GetMessageTimeOfFinalityMsg, or false if the call is
+// not a finality query
+func decodeFinalityCall(msg ethereum.CallMsg) (common.CrossChainMessage, bool, error) {
+	var message common.CrossChainMessage
+	if msg.To == nil || *msg.To != messageFinalityCallAddr {
+		return message, false, nil
+	}
+	if err := gob.NewDecoder(bytes.NewBuffer(msg.Data)).Decode(&message); err != nil {
+		return message, true, fmt.Errorf("could not decode message. Cause: %w", err)
+	}
+	return message, true, nil
+}
+
+func encodeFinalityResponse(timeOfFinality uint64) []byte {
+	return gethcommon.BigToHash(new(big.Int).SetUint64(timeOfFinality)).Bytes()
+}
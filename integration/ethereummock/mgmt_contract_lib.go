@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/gob"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/integration/datagenerator"
 
 	"github.com/ten-protocol/go-ten/go/ethadapter"
@@ -22,6 +23,11 @@ var (
 	storeSecretTxAddr      = datagenerator.RandomAddress()
 	requestSecretTxAddr    = datagenerator.RandomAddress()
 	initializeSecretTxAddr = datagenerator.RandomAddress()
+	// the withdrawals are not relevant to the enclave, so their address is not one of the MgmtContractAddresses
+	withdrawalTxAddr = datagenerator.RandomAddress()
+	// the relays and finality queries of cross chain messages are issued by the relayer, not the host
+	relayMessageTxAddr      = datagenerator.RandomAddress()
+	messageFinalityCallAddr = datagenerator.RandomAddress()
 	// MgmtContractAddresses make all these addresses available for the host to know what receipts will be forwarded to the enclave
 	MgmtContractAddresses = []gethcommon.Address{
 		depositTxAddr,
@@ -59,6 +65,29 @@ func (m *mockContractLib) CreateRollup(tx *ethadapter.L1RollupTx) types.TxData {
 	return encodeTx(tx, rollupTxAddr)
 }
 
+func (m *mockContractLib) CreateBlobRollup(tx *ethadapter.L1RollupTx) (types.TxData, error) {
+	decodedRollup, err := common.DecodeRollup(tx.Rollup)
+	if err != nil {
+		return nil, err
+	}
+	strippedRollup, blobs, err := ethadapter.SplitRollupForBlobs(decodedRollup)
+	if err != nil {
+		return nil, err
+	}
+	sidecar, err := ethadapter.MakeSidecar(blobs)
+	if err != nil {
+		return nil, err
+	}
+
+	legacyTx := encodeTx(&ethadapter.L1RollupTx{Rollup: strippedRollup}, rollupTxAddr).(*types.LegacyTx)
+	return &types.BlobTx{
+		To:         *legacyTx.To,
+		Data:       legacyTx.Data,
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}, nil
+}
+
 func (m *mockContractLib) CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData {
 	return encodeTx(tx, requestSecretTxAddr)
 }
@@ -71,6 +100,10 @@ func (m *mockContractLib) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	return encodeTx(tx, initializeSecretTxAddr)
 }
 
+func (m *mockContractLib) CreateWithdrawal(tx *ethadapter.L1WithdrawalTx) types.TxData {
+	return encodeTx(tx, withdrawalTxAddr)
+}
+
 func (m *mockContractLib) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{}, nil
 }
@@ -79,6 +112,14 @@ func (m *mockContractLib) DecodeHostAddressesResponse([]byte) ([]string, error)
 	return []string{""}, nil
 }
 
+func (m *mockContractLib) GetAttestedMsg(gethcommon.Address) (ethereum.CallMsg, error) {
+	return ethereum.CallMsg{}, nil
+}
+
+func (m *mockContractLib) DecodeAttestedResponse([]byte) (bool, error) {
+	return true, nil
+}
+
 func (m *mockContractLib) GetImportantContractKeysMsg() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{}, nil
 }
@@ -123,6 +164,10 @@ func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 		t = &ethadapter.L1RequestSecretTx{}
 	case initializeSecretTxAddr.Hex():
 		t = &ethadapter.L1InitializeSecretTx{}
+	case withdrawalTxAddr.Hex():
+		t = &ethadapter.L1WithdrawalTx{}
+	case relayMessageTxAddr.Hex():
+		t = &ethadapter.L1RelayMessageTx{}
 	default:
 		panic("unexpected type")
 	}
@@ -131,6 +176,11 @@ func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 	if err := dec.Decode(t); err != nil {
 		panic(err)
 	}
+
+	// the blob hashes are not part of the encoded tx, they are taken from the blob transaction
+	if rollupTx, ok := t.(*ethadapter.L1RollupTx); ok && tx.Type() == types.BlobTxType {
+		rollupTx.BlobHashes = tx.BlobHashes()
+	}
 	return t
 }
 
@@ -2,6 +2,7 @@ package ethereummock
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -12,10 +13,15 @@ var MockGenesisBlock = NewBlock(nil, common.HexToAddress("0x0"), []*types.Transa
 
 func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transaction) *types.Block {
 	var parentHash common.Hash
-	var height uint64
+	var height, blockTime uint64
 	if parent != nil {
 		parentHash = parent.Hash()
 		height = parent.NumberU64() + 1
+		// the timestamps only move forward, like on the L1
+		blockTime = uint64(time.Now().Unix())
+		if blockTime < parent.Time() {
+			blockTime = parent.Time()
+		}
 	}
 
 	header := types.Header{
@@ -30,7 +36,7 @@ func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transacti
 		Number:      big.NewInt(int64(height)),
 		GasLimit:    0,
 		GasUsed:     0,
-		Time:        0,
+		Time:        blockTime,
 		Extra:       nil,
 		MixDigest:   common.Hash{},
 		Nonce:       types.BlockNonce{},
@@ -18,6 +18,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
 	"github.com/ten-protocol/go-ten/go/common/gethutil"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
@@ -29,12 +30,17 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	ethclient_ethereum "github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/erc20contractlib"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 )
 
+// ChallengePeriod is the challenge period in seconds of the rollups on the mock L1, after which the value transfers of
+// their batches can be withdrawn. It is kept short, as the management contract's is set by its owner.
+const ChallengePeriod = 2
+
 type L1Network interface {
 	// BroadcastBlock - send the block and the parent to make sure there are no gaps
 	BroadcastBlock(b common.EncodedL1Block, p common.EncodedL1Block)
@@ -83,10 +89,20 @@ type Node struct {
 	erc20ContractLib erc20contractlib.ERC20ContractLib
 	mgmtContractLib  mgmtcontractlib.MgmtContractLib
 
+	// the blobs of the blob transactions seen by the node, by versioned hash - they play the role of the beacon node
+	blobs   map[gethcommon.Hash]*kzg4844.Blob
+	blobsMu sync.RWMutex
+
 	logger gethlog.Logger
 }
 
 func (m *Node) PrepareTransactionToSend(txData types.TxData, _ gethcommon.Address, nonce uint64) (types.TxData, error) {
+	if blobTx, ok := txData.(*types.BlobTx); ok {
+		preparedTx := *blobTx
+		preparedTx.Nonce = nonce
+		return &preparedTx, nil
+	}
+
 	tx := types.NewTx(txData)
 	return &types.LegacyTx{
 		Nonce:    nonce,
@@ -103,6 +119,10 @@ func (m *Node) PrepareTransactionToRetry(txData types.TxData, from gethcommon.Ad
 }
 
 func (m *Node) SendTransaction(tx *types.Transaction) error {
+	// the network only gossips the tx to the other nodes
+	if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+		m.storeBlobs(sidecar)
+	}
 	m.Network.BroadcastTx(tx)
 	return nil
 }
@@ -114,6 +134,21 @@ func (m *Node) TransactionReceipt(_ gethcommon.Hash) (*types.Receipt, error) {
 	}, nil
 }
 
+func (m *Node) BlobsByHashes(_ *types.Block, blobHashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	m.blobsMu.RLock()
+	defer m.blobsMu.RUnlock()
+
+	blobs := make([]*kzg4844.Blob, len(blobHashes))
+	for i, blobHash := range blobHashes {
+		blob, found := m.blobs[blobHash]
+		if !found {
+			return nil, fmt.Errorf("blob %s not found", blobHash)
+		}
+		blobs[i] = blob
+	}
+	return blobs, nil
+}
+
 func (m *Node) Nonce(gethcommon.Address) (uint64, error) {
 	return 0, nil
 }
@@ -151,6 +186,117 @@ func (m *Node) FetchLastBatchSeqNo(gethcommon.Address) (*big.Int, error) {
 	return big.NewInt(int64(common.L2GenesisSeqNo)), nil
 }
 
+// CrossChainWithdrawals replays the rollups and withdrawals of the canonical chain the way the management contract
+// processes them. It returns the cross chain roots whose challenge period is over at the head, and the value transfers
+// paid out, which are the ones claimed against a root after its challenge period, with a valid proof, and not withdrawn
+// before.
+func (m *Node) CrossChainWithdrawals() (map[gethcommon.Hash]bool, []common.ValueTransferMessage, error) {
+	head, err := m.FetchHeadBlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the timestamp from which each root can be withdrawn against
+	withdrawableFrom := make(map[gethcommon.Hash]uint64)
+	withdrawnLeaves := make(map[gethcommon.Hash]bool)
+	var withdrawn []common.ValueTransferMessage
+	for _, block := range m.BlocksBetween(MockGenesisBlock, head) {
+		for _, tx := range block.Transactions() {
+			switch l1tx := m.mgmtContractLib.DecodeTx(tx).(type) {
+			case *ethadapter.L1RollupTx:
+				r, err := common.DecodeRollup(l1tx.Rollup)
+				if err != nil {
+					continue
+				}
+				for _, root := range r.Header.CrossChainRoots {
+					if _, found := withdrawableFrom[root]; !found {
+						withdrawableFrom[root] = block.Time() + ChallengePeriod
+					}
+				}
+			case *ethadapter.L1WithdrawalTx:
+				leaf, err := merkle.ValueTransferLeaf(l1tx.Transfer)
+				if err != nil || withdrawnLeaves[leaf] || !merkle.Verify(l1tx.Root, leaf, l1tx.Proof) {
+					continue
+				}
+				if from, found := withdrawableFrom[l1tx.Root]; !found || from > block.Time() {
+					continue
+				}
+				withdrawnLeaves[leaf] = true
+				withdrawn = append(withdrawn, l1tx.Transfer)
+			}
+		}
+	}
+	withdrawableRoots := make(map[gethcommon.Hash]bool)
+	for root, from := range withdrawableFrom {
+		if from <= head.Time() {
+			withdrawableRoots[root] = true
+		}
+	}
+	return withdrawableRoots, withdrawn, nil
+}
+
+// messageTimesOfFinality replays the rollups of the canonical chain the way the message bus stores their cross chain
+// messages. It returns the timestamp from which each message is final, by message hash.
+func (m *Node) messageTimesOfFinality(blocks []*types.Block) map[gethcommon.Hash]uint64 {
+	timesOfFinality := make(map[gethcommon.Hash]uint64)
+	for _, block := range blocks {
+		for _, tx := range block.Transactions() {
+			l1tx, ok := m.mgmtContractLib.DecodeTx(tx).(*ethadapter.L1RollupTx)
+			if !ok {
+				continue
+			}
+			r, err := common.DecodeRollup(l1tx.Rollup)
+			if err != nil {
+				continue
+			}
+			for _, msg := range r.Header.CrossChainMessages {
+				msgHash, err := merkle.MessageLeaf(msg)
+				if err != nil {
+					continue
+				}
+				if _, found := timesOfFinality[msgHash]; !found {
+					// the management contract stores the messages as final one second after the rollup
+					timesOfFinality[msgHash] = block.Time() + 1
+				}
+			}
+		}
+	}
+	return timesOfFinality
+}
+
+// RelayedMessages replays the relay transactions of the canonical chain the way the cross chain messenger processes
+// them. It returns the messages delivered so far, which are the ones relayed after they became final, and only once.
+func (m *Node) RelayedMessages() ([]common.CrossChainMessage, error) {
+	head, err := m.FetchHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := m.BlocksBetween(MockGenesisBlock, head)
+	timesOfFinality := m.messageTimesOfFinality(blocks)
+	consumed := make(map[gethcommon.Hash]bool)
+	var relayed []common.CrossChainMessage
+	for _, block := range blocks {
+		for _, tx := range block.Transactions() {
+			l1tx, ok := m.mgmtContractLib.DecodeTx(tx).(*ethadapter.L1RelayMessageTx)
+			if !ok {
+				continue
+			}
+			msgHash, err := merkle.MessageLeaf(l1tx.Message)
+			if err != nil {
+				continue
+			}
+			timeOfFinality, found := timesOfFinality[msgHash]
+			if !found || timeOfFinality > block.Time() || consumed[msgHash] {
+				continue
+			}
+			consumed[msgHash] = true
+			relayed = append(relayed, l1tx.Message)
+		}
+	}
+	return relayed, nil
+}
+
 // BlockListener provides stream of latest mock head headers as they are created
 func (m *Node) BlockListener() (chan *types.Header, ethereum.Subscription) {
 	id := uuid.New()
@@ -445,9 +591,24 @@ func (m *Node) P2PGossipTx(tx *types.Transaction) {
 		return
 	}
 
+	// the blobs are kept aside, like on a beacon node, and the tx is included in the block without them
+	if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+		m.storeBlobs(sidecar)
+		tx = tx.WithoutBlobTxSidecar()
+	}
+
 	m.mempoolCh <- tx
 }
 
+func (m *Node) storeBlobs(sidecar *types.BlobTxSidecar) {
+	m.blobsMu.Lock()
+	defer m.blobsMu.Unlock()
+	for i, blobHash := range sidecar.BlobHashes() {
+		blob := sidecar.Blobs[i]
+		m.blobs[blobHash] = &blob
+	}
+}
+
 func (m *Node) BroadcastTx(tx types.TxData) {
 	m.Network.BroadcastTx(types.NewTx(tx))
 }
@@ -486,8 +647,25 @@ func (m *Node) BlocksBetween(blockA *types.Block, blockB *types.Block) []*types.
 	return result
 }
 
-func (m *Node) CallContract(ethereum.CallMsg) ([]byte, error) {
-	return nil, nil
+// CallContract only answers the queries of the time of finality of cross chain messages, other calls return nothing
+func (m *Node) CallContract(msg ethereum.CallMsg) ([]byte, error) {
+	message, isFinalityCall, err := decodeFinalityCall(msg)
+	if !isFinalityCall || err != nil {
+		return nil, err
+	}
+	head, err := m.FetchHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+	msgHash, err := merkle.MessageLeaf(message)
+	if err != nil {
+		return nil, err
+	}
+	timeOfFinality, found := m.messageTimesOfFinality(m.BlocksBetween(MockGenesisBlock, head))[msgHash]
+	if !found {
+		return nil, errors.New("execution reverted: This message was never submitted")
+	}
+	return encodeFinalityResponse(timeOfFinality), nil
 }
 
 func (m *Node) EthClient() *ethclient_ethereum.Client {
@@ -533,6 +711,7 @@ func NewMiner(
 		headOutCh:        make(chan *types.Block),
 		erc20ContractLib: NewERC20ContractLibMock(),
 		mgmtContractLib:  NewMgmtContractLibMock(),
+		blobs:            map[gethcommon.Hash]*kzg4844.Blob{},
 		logger:           log.New(log.EthereumL1Cmp, int(gethlog.LvlInfo), cfg.LogFile, log.NodeIDKey, id),
 		subs:             map[uuid.UUID]*mockSubscription{},
 		subMu:            sync.Mutex{},
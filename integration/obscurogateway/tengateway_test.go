@@ -26,6 +26,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/httputil"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration"
 	integrationCommon "github.com/ten-protocol/go-ten/integration/common"
@@ -101,6 +102,7 @@ func TestTenGateway(t *testing.T) {
 		"testUnsubscribe":                      testUnsubscribe,
 		"testClosingConnectionWhileSubscribed": testClosingConnectionWhileSubscribed,
 		"testSubscriptionTopics":               testSubscriptionTopics,
+		"testNewHeadsAndPendingTxs":            testNewHeadsAndPendingTxs,
 	} {
 		t.Run(name, func(t *testing.T) {
 			test(t, httpURL, wsURL, w)
@@ -545,6 +547,45 @@ func testUnsubscribe(t *testing.T, httpURL, wsURL string, w wallet.Wallet) {
 	assert.Equal(t, 1, len(userLogs))
 }
 
+func testNewHeadsAndPendingTxs(t *testing.T, httpURL, wsURL string, w wallet.Wallet) {
+	// create a user with multiple accounts
+	user, err := NewUser([]wallet.Wallet{w, datagenerator.RandomWallet(integration.TenChainID)}, httpURL, wsURL)
+	require.NoError(t, err)
+	require.NoError(t, user.RegisterAccounts())
+
+	heads := make(chan *common.BatchHeader, 100)
+	headsSubscription, err := user.WSClient.Client().EthSubscribe(context.Background(), heads, rpc.SubscriptionTypeNewHeads)
+	require.NoError(t, err)
+	defer headsSubscription.Unsubscribe()
+
+	pendingTxs := make(chan gethcommon.Hash, 100)
+	pendingTxsSubscription, err := user.WSClient.Client().EthSubscribe(context.Background(), pendingTxs, rpc.SubscriptionTypePendingTxs)
+	require.NoError(t, err)
+	defer pendingTxsSubscription.Unsubscribe()
+
+	// a transfer between the accounts of the user is pending for both accounts, but it is only sent once
+	receipt, err := transferETHToAddress(user.HTTPClient, w, user.Wallets[1].Address(), 1)
+	require.NoError(t, err)
+
+	select {
+	case txHash := <-pendingTxs:
+		assert.Equal(t, receipt.TxHash, txHash)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no pending transaction received")
+	}
+	select {
+	case head := <-heads:
+		assert.NotNil(t, head.Number)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no new head received")
+	}
+	select {
+	case txHash := <-pendingTxs:
+		t.Errorf("unexpected pending transaction %s", txHash.Hex())
+	case <-time.After(time.Second):
+	}
+}
+
 func testClosingConnectionWhileSubscribed(t *testing.T, httpURL, wsURL string, w wallet.Wallet) {
 	// create a user with multiple accounts
 	user, err := NewUser([]wallet.Wallet{w, datagenerator.RandomWallet(integration.TenChainID)}, httpURL, wsURL)
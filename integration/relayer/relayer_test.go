@@ -0,0 +1,165 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/datagenerator"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
+	"github.com/ten-protocol/go-ten/integration/simulation/stats"
+	"github.com/ten-protocol/go-ten/tools/relayer/container"
+	"github.com/ten-protocol/go-ten/tools/relayer/relayer"
+	"github.com/ten-protocol/go-ten/tools/relayer/webserver"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
+)
+
+func init() { //nolint:gochecknoinits
+	testlog.Setup(&testlog.Cfg{
+		LogDir:      testLogs,
+		TestType:    "relayer",
+		TestSubtype: "test",
+		LogLevel:    log.LvlInfo,
+	})
+}
+
+const (
+	testLogs      = "../.build/relayer/"
+	blockDuration = 300 * time.Millisecond
+	nrL1Nodes     = 2
+)
+
+func TestRelayerDeliversFinalMessages(t *testing.T) {
+	l1 := createMockL1(t)
+	rollupPublisher := datagenerator.RandomWallet(integration.EthereumChainID)
+	relayerWallet := datagenerator.RandomWallet(integration.EthereumChainID)
+	healthURL := fmt.Sprintf("http://127.0.0.1:%d/health", integration.StartPortRelayerUnitTest)
+	dbPath := t.TempDir()
+
+	relayerContainer := startRelayer(t, l1, relayerWallet, dbPath)
+	messages := publishRollup(t, l1, rollupPublisher, 0, 3)
+	waitForRelays(t, l1, messages)
+
+	status := fetchHealth(t, healthURL)
+	require.True(t, status.Healthy, status.Error)
+	require.Zero(t, status.Waiting)
+	require.NotZero(t, status.NextHeight)
+	require.NoError(t, relayerContainer.Stop())
+
+	// the messages published while the relayer is down are relayed once it resumes from its persisted progress
+	messages = append(messages, publishRollup(t, l1, rollupPublisher, 3, 2)...)
+	relayerContainer = startRelayer(t, l1, relayerWallet, dbPath)
+	require.GreaterOrEqual(t, fetchHealth(t, healthURL).NextHeight, status.NextHeight)
+	waitForRelays(t, l1, messages)
+	require.NoError(t, relayerContainer.Stop())
+}
+
+// createMockL1 starts a network of mock L1 nodes that mine blocks until the test ends
+func createMockL1(t *testing.T) *ethereummock.Node {
+	s := stats.NewStats(nrL1Nodes)
+	nodes := make([]*ethereummock.Node, nrL1Nodes)
+	for i := range nodes {
+		network := ethereummock.NewMockEthNetwork(blockDuration, blockDuration/20, s)
+		miningCfg := ethereummock.MiningConfig{
+			PowTime: func() time.Duration { return testcommon.RndBtwTime(blockDuration/2, 2*blockDuration) },
+			LogFile: testlog.LogFile(),
+		}
+		nodes[i] = ethereummock.NewMiner(gethcommon.BigToAddress(big.NewInt(int64(i))), miningCfg, network, s)
+		network.CurrentNode = nodes[i]
+	}
+	for _, node := range nodes {
+		node.Network.(*ethereummock.MockEthNetwork).AllNodes = nodes
+		go node.Start()
+	}
+	t.Cleanup(func() {
+		for _, node := range nodes {
+			node.Stop()
+		}
+	})
+	time.Sleep(2 * blockDuration)
+	return nodes[0]
+}
+
+func startRelayer(t *testing.T, l1 *ethereummock.Node, relayerWallet wallet.Wallet, dbPath string) *container.RelayerContainer {
+	logger := testlog.Logger()
+	cfg := &relayer.Config{
+		L1ChainID:         integration.EthereumChainID,
+		DBPath:            dbPath,
+		PollInterval:      blockDuration / 2,
+		MaxWaitForReceipt: 10 * blockDuration,
+		HealthPort:        integration.StartPortRelayerUnitTest,
+	}
+	db, err := relayer.NewLevelDBBackedDB(dbPath, logger)
+	require.NoError(t, err)
+	relayerService := relayer.NewRelayer(cfg, l1, relayerWallet, ethereummock.NewMgmtContractLibMock(), ethereummock.NewCrossChainLibMock(), db, logger)
+	server := webserver.NewWebServer(relayerService, fmt.Sprintf(":%d", cfg.HealthPort), logger)
+
+	relayerContainer := container.NewRelayerContainer(relayerService, server, db)
+	require.NoError(t, relayerContainer.Start())
+	return relayerContainer
+}
+
+// publishRollup publishes a rollup carrying cross chain messages with consecutive sequence numbers
+func publishRollup(t *testing.T, l1 *ethereummock.Node, publisher wallet.Wallet, firstSequence uint64, count int) []common.CrossChainMessage {
+	messages := make([]common.CrossChainMessage, count)
+	for i := range messages {
+		messages[i] = common.CrossChainMessage{
+			Sender:   publisher.Address(),
+			Sequence: firstSequence + uint64(i),
+			Payload:  datagenerator.RandomBytes(32),
+		}
+	}
+	encodedRollup, err := common.EncodeRollup(&common.ExtRollup{Header: &common.RollupHeader{CrossChainMessages: messages}})
+	require.NoError(t, err)
+
+	txData := ethereummock.NewMgmtContractLibMock().CreateRollup(&ethadapter.L1RollupTx{Rollup: encodedRollup})
+	tx, err := l1.PrepareTransactionToSend(txData, publisher.Address(), publisher.GetNonceAndIncrement())
+	require.NoError(t, err)
+	signedTx, err := publisher.SignTransaction(tx)
+	require.NoError(t, err)
+	require.NoError(t, l1.SendTransaction(signedTx))
+	return messages
+}
+
+// waitForRelays waits until the L1 delivered all the messages, and no others
+func waitForRelays(t *testing.T, l1 *ethereummock.Node, messages []common.CrossChainMessage) {
+	var relayed []common.CrossChainMessage
+	deadline := time.Now().Add(60 * blockDuration)
+	for time.Now().Before(deadline) {
+		var err error
+		relayed, err = l1.RelayedMessages()
+		require.NoError(t, err)
+		if len(relayed) >= len(messages) {
+			break
+		}
+		time.Sleep(blockDuration)
+	}
+	require.ElementsMatch(t, messages, relayed)
+}
+
+func fetchHealth(t *testing.T, healthURL string) *relayer.Status {
+	resp, err := http.Get(healthURL) //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	status := new(relayer.Status)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(status))
+	if status.Healthy {
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	} else {
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	return status
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	obscurocommon "github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/host/container"
@@ -45,15 +46,21 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 	// dummyMgmtContractAddress := datagenerator.RandomAddress()
 	// params.MgmtContractLib
 
+	var sequencerEnclaves []obscurocommon.Enclave
 	for i := 0; i < params.NumberOfNodes; i++ {
 		isGenesis := i == 0
 
+		numberOfEnclaves := 1
+		if isGenesis && params.WithSequencerFailover {
+			numberOfEnclaves = 2
+		}
+
 		incomingP2PDisabled := !isGenesis && i == params.NodeWithInboundP2PDisabled
 
 		// create the in memory l1 and l2 node
 		miner := createMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
 
-		agg := createInMemObscuroNode(
+		agg, enclaves := createInMemObscuroNode(
 			int64(i),
 			isGenesis,
 			GetNodeType(i),
@@ -68,7 +75,12 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 			params.AvgBlockDuration/2,
 			incomingP2PDisabled,
 			params.AvgBlockDuration,
+			params.UseBlobsForRollups,
+			numberOfEnclaves,
 		)
+		if isGenesis {
+			sequencerEnclaves = enclaves
+		}
 		obscuroClient := p2p.NewInMemObscuroClient(agg)
 
 		n.ethNodes[i] = miner
@@ -113,10 +125,11 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 	walletClients := createAuthClientsPerWallet(n.l2Clients, params.Wallets)
 
 	return &RPCHandles{
-		EthClients:     l1Clients,
-		ObscuroClients: obscuroClients,
-		RPCClients:     n.l2Clients,
-		AuthObsClients: walletClients,
+		EthClients:        l1Clients,
+		ObscuroClients:    obscuroClients,
+		RPCClients:        n.l2Clients,
+		AuthObsClients:    walletClients,
+		SequencerEnclaves: sequencerEnclaves,
 	}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	obscurocommon "github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
@@ -42,6 +43,9 @@ type RPCHandles struct {
 	// map of owner addresses to RPC clients for that owner (one per L2 node)
 	// todo (@matt) - simplify this with a client per node when we have clients that can support multiple wallets
 	AuthObsClients map[string][]*obsclient.AuthObsClient
+
+	// the enclaves of the sequencer host, the first one being the active sequencer (only set by the in-memory networks)
+	SequencerEnclaves []obscurocommon.Enclave
 }
 
 func (n *RPCHandles) RndEthClient() ethadapter.EthClient {
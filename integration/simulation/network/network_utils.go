@@ -60,7 +60,9 @@ func createInMemObscuroNode(
 	batchInterval time.Duration,
 	incomingP2PDisabled bool,
 	l1BlockTime time.Duration,
-) *container.HostContainer {
+	useBlobsForRollups bool,
+	numberOfEnclaves int,
+) (*container.HostContainer, []common.Enclave) {
 	mgtContractAddress := mgmtContractLib.GetContractAddr()
 
 	hostConfig := &config.HostConfig{
@@ -76,6 +78,7 @@ func createInMemObscuroNode(
 		BatchInterval:             batchInterval,
 		IsInboundP2PDisabled:      incomingP2PDisabled,
 		L1BlockTime:               l1BlockTime,
+		UseBlobsForRollups:        useBlobsForRollups,
 	}
 
 	enclaveConfig := &config.EnclaveConfig{
@@ -98,16 +101,26 @@ func createInMemObscuroNode(
 		GasBatchExecutionLimit:    params.MaxGasLimit / 2,
 	}
 
-	enclaveLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.EnclaveCmp)
-	enclaveClient := enclave.NewEnclave(enclaveConfig, &genesis.TestnetGenesis, mgmtContractLib, enclaveLogger)
+	enclaveClients := make([]common.Enclave, numberOfEnclaves)
+	for i := range enclaveClients {
+		cfg := enclaveConfig
+		if i > 0 {
+			// the in-memory database is named after the host, so the other enclaves of the host use a temporary sqlite file
+			standbyConfig := *enclaveConfig
+			standbyConfig.UseInMemoryDB = false
+			cfg = &standbyConfig
+		}
+		enclaveLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.EnclaveCmp)
+		enclaveClients[i] = enclave.NewEnclave(cfg, &genesis.TestnetGenesis, mgmtContractLib, enclaveLogger)
+	}
 
 	// create an in memory obscuro node
 	hostLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.HostCmp)
 	metricsService := metrics.New(hostConfig.MetricsEnabled, hostConfig.MetricsHTTPPort, hostLogger)
 	l1Repo := l1.NewL1Repository(ethClient, ethereummock.MgmtContractAddresses, hostLogger)
-	currentContainer := container.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Repo, enclaveClient, mgmtContractLib, ethWallet, nil, hostLogger, metricsService)
+	currentContainer := container.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Repo, enclaveClients, mgmtContractLib, ethWallet, nil, hostLogger, metricsService)
 
-	return currentContainer
+	return currentContainer, enclaveClients
 }
 
 func defaultMockEthNodeCfg(nrNodes int, avgBlockDuration time.Duration) ethereummock.MiningConfig {
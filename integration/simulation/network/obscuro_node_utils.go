@@ -38,7 +38,7 @@ func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1C
 	for i := 0; i < params.NumberOfNodes; i++ {
 		isGenesis := i == 0
 
-		obscuroNodes[i] = createInMemObscuroNode(
+		obscuroNodes[i], _ = createInMemObscuroNode(
 			int64(i),
 			isGenesis,
 			GetNodeType(i),
@@ -53,6 +53,8 @@ func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1C
 			params.AvgBlockDuration/3,
 			true,
 			params.AvgBlockDuration,
+			params.UseBlobsForRollups,
+			1,
 		)
 		obscuroHosts[i] = obscuroNodes[i].Host()
 	}
@@ -35,6 +35,7 @@ type inMemObscuroClient struct {
 	filterAPI        *clientapi.FilterAPI
 	tenScanAPI       *clientapi.TenScanAPI
 	testAPI          *clientapi.TestAPI
+	tenAPI           *clientapi.TenAPI
 	enclavePublicKey *ecies.PublicKey
 }
 
@@ -53,6 +54,7 @@ func NewInMemObscuroClient(hostContainer *container.HostContainer) rpc.Client {
 		filterAPI:        clientapi.NewFilterAPI(hostContainer.Host(), logger),
 		tenScanAPI:       clientapi.NewTenScanAPI(hostContainer.Host()),
 		testAPI:          clientapi.NewTestAPI(hostContainer),
+		tenAPI:           clientapi.NewTenAPI(hostContainer.Host(), logger),
 		enclavePublicKey: enclPubKey,
 	}
 }
@@ -106,6 +108,15 @@ func (c *inMemObscuroClient) Call(result interface{}, method string, args ...int
 	case rpc.GetBatch:
 		return c.getBatch(result, args)
 
+	case rpc.Config:
+		return c.config(result)
+
+	case rpc.GetCrossChainProof:
+		return c.getCrossChainProof(result, args)
+
+	case rpc.GetCrossChainMessageStatus:
+		return c.getCrossChainMessageStatus(result, args)
+
 	default:
 		return fmt.Errorf("RPC method %s is unknown", method)
 	}
@@ -340,6 +351,60 @@ func (c *inMemObscuroClient) getBatch(result interface{}, args []interface{}) er
 	return nil
 }
 
+func (c *inMemObscuroClient) config(result interface{}) error {
+	cfg, err := c.obscuroAPI.Config()
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.Config, err)
+	}
+
+	// we marshal and unmarshal the config, since the API serves the addresses in their checksum format
+	cfgJSON, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s response to JSON. Cause: %w", rpc.Config, err)
+	}
+	return json.Unmarshal(cfgJSON, result)
+}
+
+func (c *inMemObscuroClient) getCrossChainProof(result interface{}, args []interface{}) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected 2 args to %s, got %d", rpc.GetCrossChainProof, len(args))
+	}
+	batchHash, ok := args[0].(gethcommon.Hash)
+	if !ok {
+		return fmt.Errorf("first arg to %s is of type %T, expected common.Hash", rpc.GetCrossChainProof, args[0])
+	}
+	index, ok := args[1].(hexutil.Uint64)
+	if !ok {
+		return fmt.Errorf("second arg to %s is of type %T, expected hexutil.Uint64", rpc.GetCrossChainProof, args[1])
+	}
+
+	proof, err := c.tenAPI.GetCrossChainProof(batchHash, index)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetCrossChainProof, err)
+	}
+
+	*result.(*common.CrossChainProof) = *proof
+	return nil
+}
+
+func (c *inMemObscuroClient) getCrossChainMessageStatus(result interface{}, args []interface{}) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected 1 arg to %s, got %d", rpc.GetCrossChainMessageStatus, len(args))
+	}
+	query, ok := args[0].(common.CrossChainMessageQuery)
+	if !ok {
+		return fmt.Errorf("first arg to %s is of type %T, expected common.CrossChainMessageQuery", rpc.GetCrossChainMessageStatus, args[0])
+	}
+
+	statuses, err := c.tenAPI.GetCrossChainMessageStatus(query)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetCrossChainMessageStatus, err)
+	}
+
+	*result.(*[]*common.CrossChainMessageStatus) = statuses
+	return nil
+}
+
 // getEncryptedBytes expects args to have a single element and it to be of type bytes (client doesn't know anything about what's getting passed through on sensitive methods)
 func getEncryptedBytes(args []interface{}, methodName string) ([]byte, error) {
 	if len(args) != 1 {
@@ -171,7 +171,7 @@ func (n *MockP2P) SubscribeForBatchRequests(handler host.P2PBatchRequestHandler)
 	return n.batchReqHandlers.Subscribe(handler)
 }
 
-func (n *MockP2P) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+func (n *MockP2P) RequestBatchesFromPeers(fromSeqNo *big.Int) error {
 	if n.isIncomingP2PDisabled {
 		return nil
 	}
@@ -39,6 +39,10 @@ type SimParams struct {
 	StoppingDelay              time.Duration // How long to wait between injection and verification
 	NodeWithInboundP2PDisabled int
 	WithPrefunding             bool
+	UseBlobsForRollups         bool // Whether the sequencer publishes the rollup payloads as blobs
+	// Whether the sequencer host has a standby enclave, which takes over when the active one is stopped halfway through
+	// the simulation (only supported by the in-memory networks)
+	WithSequencerFailover bool
 }
 
 type L1SetupData struct {
@@ -72,6 +72,9 @@ func (s *Simulation) Start() {
 	fmt.Printf("Starting injection\n")
 	testlog.Logger().Info("Starting injection")
 	go s.TxInjector.Start()
+	if s.Params.WithSequencerFailover {
+		go s.stopActiveSequencerEnclave((s.SimulationTime - s.Params.StoppingDelay) / 2)
+	}
 
 	// Allow for some time after tx injection was stopped so that the network can process all transactions, catch up
 	// on missed batches, etc.
@@ -93,6 +96,16 @@ func (s *Simulation) Stop() {
 	// nothing to do for now
 }
 
+// stopActiveSequencerEnclave stops the enclave producing the batches after the delay, so the standby enclave of the
+// sequencer host takes over for the rest of the simulation
+func (s *Simulation) stopActiveSequencerEnclave(delay time.Duration) {
+	time.Sleep(delay)
+	testlog.Logger().Info("Stopping the active sequencer enclave")
+	if err := s.RPCHandles.SequencerEnclaves[0].Stop(); err != nil {
+		panic(fmt.Errorf("could not stop the active sequencer enclave. Cause: %w", err))
+	}
+}
+
 func (s *Simulation) waitForObscuroGenesisOnL1() {
 	// grab an L1 client
 	client := s.RPCHandles.EthClients[0]
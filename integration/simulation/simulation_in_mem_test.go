@@ -17,7 +17,23 @@ import (
 // the CPU usage will be very high during the simulation which might give inconclusive results.
 func TestInMemoryMonteCarloSimulation(t *testing.T) {
 	setupSimTestLog("in-mem")
+	testInMemoryMonteCarloSimulation(t, integration.StartPortSimulationInMem, false, false)
+}
+
+// TestInMemoryMonteCarloSimulationWithBlobs runs the same simulation, with the rollups published as blobs
+func TestInMemoryMonteCarloSimulationWithBlobs(t *testing.T) {
+	setupSimTestLog("in-mem-blobs")
+	testInMemoryMonteCarloSimulation(t, integration.StartPortSimulationInMemBlobs, true, false)
+}
+
+// TestInMemoryMonteCarloSimulationWithSequencerFailover runs the same simulation, with the active sequencer enclave
+// stopped halfway through, so the validators must accept the batches of the standby enclave that takes over
+func TestInMemoryMonteCarloSimulationWithSequencerFailover(t *testing.T) {
+	setupSimTestLog("in-mem-failover")
+	testInMemoryMonteCarloSimulation(t, integration.StartPortSimulationInMemFailover, false, true)
+}
 
+func testInMemoryMonteCarloSimulation(t *testing.T, startPort int, useBlobsForRollups bool, withSequencerFailover bool) {
 	// todo (#718) - try increasing this back to 7 once faster-finality model is optimised
 	numberOfNodes := 5
 	numberOfSimWallets := 10
@@ -32,12 +48,14 @@ func TestInMemoryMonteCarloSimulation(t *testing.T) {
 		MgmtContractLib:            ethereummock.NewMgmtContractLibMock(),
 		ERC20ContractLib:           ethereummock.NewERC20ContractLibMock(),
 		Wallets:                    wallets,
-		StartPort:                  integration.StartPortSimulationInMem,
+		StartPort:                  startPort,
 		IsInMem:                    true,
 		L1SetupData:                &params.L1SetupData{},
 		ReceiptTimeout:             5 * time.Second,
 		StoppingDelay:              4 * time.Second,
 		NodeWithInboundP2PDisabled: 2,
+		UseBlobsForRollups:         useBlobsForRollups,
+		WithSequencerFailover:      withSequencerFailover,
 	}
 
 	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
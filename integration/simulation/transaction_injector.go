@@ -320,8 +320,53 @@ func (ti *TransactionInjector) issueRandomDeposits() {
 }
 
 // issueRandomWithdrawals creates and issues a number of transactions proportional to the simulation time, such that they can be processed
+// Each one sends native value to an L1 wallet through the L2 message bus, to be claimed on the L1 with its cross chain proof
 func (ti *TransactionInjector) issueRandomWithdrawals() {
-	// todo (@stefan) - rework this when old contract deployer is phased out?
+	cfg, err := ti.rpcHandles.ObscuroClients[0].GetConfig()
+	if err != nil {
+		panic(err)
+	}
+	busABI, err := MessageBus.MessageBusMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
+		fromWallet := ti.rndObsWallet()
+		toWallet := ti.wallets.SimEthWallets[rand.Intn(len(ti.wallets.SimEthWallets))] //nolint:gosec
+		obscuroClient := ti.rpcHandles.ObscuroWalletRndClient(fromWallet)
+		amount := big.NewInt(int64(testcommon.RndBtw(1, 100)))
+		data, err := busABI.Pack("sendValueToL2", toWallet.Address(), amount)
+		if err != nil {
+			panic(err)
+		}
+		txData := &types.LegacyTx{
+			Nonce:    fromWallet.GetNonceAndIncrement(),
+			Value:    amount,
+			Gas:      uint64(100_000),
+			GasPrice: gethcommon.Big1,
+			Data:     data,
+			To:       &cfg.L2MessageBusAddress,
+		}
+
+		tx := obscuroClient.EstimateGasAndGasPrice(txData)
+		signedTx, err := fromWallet.SignTransaction(tx)
+		if err != nil {
+			panic(err)
+		}
+		ti.logger.Info("Withdrawal transaction injected into L2.", log.TxKey, signedTx.Hash(), "fromAddress", fromWallet.Address(), "toAddress", toWallet.Address())
+
+		ti.stats.Withdrawal(amount)
+
+		err = obscuroClient.SendTransaction(ti.ctx, signedTx)
+		if err != nil {
+			ti.logger.Info("Failed to issue withdrawal via RPC.", log.ErrKey, err)
+			continue
+		}
+
+		go ti.TxTracker.trackWithdrawalL2Tx(signedTx)
+		sleepRndBtw(ti.avgBlockDuration/3, ti.avgBlockDuration)
+	}
 }
 
 // issueInvalidL2Txs creates and issues invalidly-signed L2 transactions proportional to the simulation time.
@@ -22,6 +22,7 @@ import (
 	"github.com/ten-protocol/go-ten/integration/simulation/network"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
 
 	"github.com/ten-protocol/go-ten/go/rpc"
 
@@ -57,6 +58,7 @@ func checkNetworkValidity(t *testing.T, s *Simulation) {
 	checkObscuroBlockchainValidity(t, s, l1MaxHeight)
 	checkReceivedLogs(t, s)
 	checkTenscan(t, s)
+	checkValueTransferWithdrawals(t, s)
 }
 
 // Ensures that L1 and L2 txs were actually issued.
@@ -393,6 +395,8 @@ func checkBlockchainOfObscuroNode(t *testing.T, rpcHandles *network.RPCHandles,
 		t.Errorf("Node %d: Logged withdrawals do not match!", nodeIdx)
 	}
 
+	checkCrossChainMessageStatus(t, obscuroClient, nodeIdx, minObscuroHeight, headBatchHeader)
+
 	injectorDepositedAmt := big.NewInt(0)
 	for _, tx := range s.TxInjector.TxTracker.GetL1Transactions() {
 		if depTx, ok := tx.(*ethadapter.L1DepositTx); ok {
@@ -471,6 +475,45 @@ func getLoggedWithdrawals(minObscuroHeight uint64, obscuroClient *obsclient.ObsC
 	return totalAmountLogged
 }
 
+// checkCrossChainMessageStatus checks that the messages published on the L2 are found by their sender and sequence
+// number, and by the transaction that published them, in the batch that published them
+func checkCrossChainMessageStatus(t *testing.T, obscuroClient *obsclient.ObsClient, nodeIdx int, minObscuroHeight uint64, currentHeader *common.BatchHeader) {
+	statuses, err := obscuroClient.GetCrossChainMessageStatus(common.CrossChainMessageQuery{TxHash: &gethcommon.Hash{}})
+	if err != nil || len(statuses) != 0 {
+		t.Errorf("Node %d: expected no cross chain messages for an unknown transaction, got %d. Cause: %v", nodeIdx, len(statuses), err)
+	}
+
+	for i := minObscuroHeight; i < currentHeader.Number.Uint64(); i++ {
+		header, err := obscuroClient.BatchHeaderByNumber(big.NewInt(int64(i)))
+		if err != nil {
+			t.Errorf("Node %d: could not retrieve batch %d. Cause: %s", nodeIdx, i, err)
+			return
+		}
+		for _, msg := range header.CrossChainMessages {
+			sender, sequence := msg.Sender, msg.Sequence
+			statuses, err := obscuroClient.GetCrossChainMessageStatus(common.CrossChainMessageQuery{Sender: &sender, Sequence: &sequence})
+			if err != nil {
+				t.Errorf("Node %d: could not retrieve the status of message %d of %s. Cause: %s", nodeIdx, sequence, sender, err)
+				continue
+			}
+			var published *common.CrossChainMessageStage
+			for _, status := range statuses {
+				if status.Direction == common.CrossChainL2ToL1 && *status.Stages[0].BatchHash == header.Hash() {
+					published = status.Stages[0]
+				}
+			}
+			if published == nil || published.L2TxHash == nil {
+				t.Errorf("Node %d: message %d of %s was not indexed with batch %s", nodeIdx, sequence, sender, header.Hash())
+				continue
+			}
+			byTx, err := obscuroClient.GetCrossChainMessageStatus(common.CrossChainMessageQuery{TxHash: published.L2TxHash})
+			if err != nil || len(byTx) == 0 {
+				t.Errorf("Node %d: message %d of %s was not found by its transaction %s", nodeIdx, sequence, sender, published.L2TxHash)
+			}
+		}
+	}
+}
+
 // FindNotIncludedL2Txs returns the number of transfers and withdrawals that were injected but are not present in the L2 blockchain.
 func FindNotIncludedL2Txs(ctx context.Context, nodeIdx int, rpcHandles *network.RPCHandles, txInjector *TransactionInjector) (int, int, int) {
 	transfers, withdrawals, nativeTransfers := txInjector.TxTracker.GetL2Transactions()
@@ -579,6 +622,139 @@ func extractWithdrawals(t *testing.T, obscuroClient *obsclient.ObsClient, nodeId
 	}
 }
 
+// checkValueTransferWithdrawals claims the value transfers of the withdrawal transactions on the mock L1, with the proofs
+// served by the node, and checks that each one is paid out exactly once, even when it is claimed twice
+func checkValueTransferWithdrawals(t *testing.T, s *Simulation) {
+	// only the mock L1 replays the withdrawals
+	l1Node, ok := s.RPCHandles.EthClients[0].(*ethereummock.Node)
+	if !ok {
+		return
+	}
+	obscuroClient := s.RPCHandles.ObscuroClients[0]
+
+	batches := make(map[gethcommon.Hash]bool)
+	for _, tx := range s.TxInjector.TxTracker.WithdrawalL2Transactions {
+		receipt, err := s.RPCHandles.ObscuroWalletClient(getSender(tx), 0).TransactionReceipt(s.ctx, tx.Hash())
+		if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		batches[receipt.BlockHash] = true
+	}
+	if len(batches) == 0 {
+		t.Errorf("no withdrawal transaction was successful")
+		return
+	}
+
+	claims := make(map[gethcommon.Hash]*ethadapter.L1WithdrawalTx)
+	for batchHash := range batches {
+		header, err := obscuroClient.BatchHeaderByHash(batchHash)
+		if err != nil {
+			t.Errorf("could not retrieve batch %s. Cause: %s", batchHash, err)
+			continue
+		}
+		// the value transfers come after the messages in the cross chain tree, and the proof of the index after the last
+		// leaf can't be retrieved
+		index := uint64(len(header.CrossChainMessages))
+		for ; ; index++ {
+			proof, err := obscuroClient.GetCrossChainProof(batchHash, index)
+			if err != nil {
+				break
+			}
+			if proof.ValueTransfer == nil || proof.Root != header.TransfersTree || !merkle.Verify(proof.Root, proof.Leaf, proof.Proof) {
+				t.Errorf("invalid cross chain proof of leaf %d of batch %s", index, batchHash)
+				continue
+			}
+			if leaf, err := merkle.ValueTransferLeaf(*proof.ValueTransfer); err != nil || leaf != proof.Leaf {
+				t.Errorf("the leaf %d of batch %s does not match its value transfer", index, batchHash)
+				continue
+			}
+			claims[proof.Leaf] = &ethadapter.L1WithdrawalTx{Transfer: *proof.ValueTransfer, Proof: proof.Proof, Root: proof.Root}
+		}
+		if index == uint64(len(header.CrossChainMessages)) {
+			t.Errorf("could not retrieve the proofs of the value transfers of batch %s", batchHash)
+		}
+	}
+
+	// the roots are published with the rollups, which can lag behind the batches, and can only be withdrawn against
+	// once their challenge period is over
+	var withdrawableRoots map[gethcommon.Hash]bool
+	deadline := time.Now().Add(20*s.Params.AvgBlockDuration + ethereummock.ChallengePeriod*time.Second)
+	for time.Now().Before(deadline) {
+		roots, _, err := l1Node.CrossChainWithdrawals()
+		if err != nil {
+			t.Errorf("could not replay the L1 withdrawals. Cause: %s", err)
+			return
+		}
+		withdrawableRoots = roots
+		if allRootsWithdrawable(claims, withdrawableRoots) {
+			break
+		}
+		time.Sleep(s.Params.AvgBlockDuration)
+	}
+
+	// every claim is submitted twice, and the second one must be rejected
+	nonce := uint64(0)
+	expected := make(map[gethcommon.Hash]bool)
+	for leaf, claim := range claims {
+		if !withdrawableRoots[claim.Root] {
+			continue
+		}
+		expected[leaf] = true
+		for i := 0; i < 2; i++ {
+			txData, err := l1Node.PrepareTransactionToSend(s.Params.MgmtContractLib.CreateWithdrawal(claim), gethcommon.Address{}, nonce)
+			if err != nil {
+				t.Errorf("could not prepare withdrawal. Cause: %s", err)
+				return
+			}
+			l1Node.BroadcastTx(txData)
+			nonce++
+		}
+	}
+	if len(expected) == 0 {
+		t.Errorf("none of the %d value transfers to withdraw had its root published and past its challenge period", len(claims))
+		return
+	}
+
+	var withdrawn []common.ValueTransferMessage
+	for i := 0; i < 20; i++ {
+		time.Sleep(s.Params.AvgBlockDuration)
+		_, transfers, err := l1Node.CrossChainWithdrawals()
+		if err != nil {
+			t.Errorf("could not replay the L1 withdrawals. Cause: %s", err)
+			return
+		}
+		withdrawn = transfers
+		if len(withdrawn) >= len(expected) {
+			break
+		}
+	}
+
+	paidOut := make(map[gethcommon.Hash]bool)
+	for _, transfer := range withdrawn {
+		leaf, err := merkle.ValueTransferLeaf(transfer)
+		if err != nil {
+			t.Errorf("could not hash withdrawn value transfer. Cause: %s", err)
+			return
+		}
+		if !expected[leaf] || paidOut[leaf] {
+			t.Errorf("value transfer %+v was paid out unexpectedly", transfer)
+		}
+		paidOut[leaf] = true
+	}
+	if len(paidOut) != len(expected) {
+		t.Errorf("only %d out of %d claimed value transfers were paid out on the L1", len(paidOut), len(expected))
+	}
+}
+
+func allRootsWithdrawable(claims map[gethcommon.Hash]*ethadapter.L1WithdrawalTx, withdrawableRoots map[gethcommon.Hash]bool) bool {
+	for _, claim := range claims {
+		if !withdrawableRoots[claim.Root] {
+			return false
+		}
+	}
+	return true
+}
+
 // Terminates all subscriptions and validates the received events.
 func checkReceivedLogs(t *testing.T, s *Simulation) {
 	logsFromSnapshots := 0
@@ -13,8 +13,11 @@ import (
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend/config"
 	"github.com/ten-protocol/go-ten/tools/tenscan/backend/container"
 
-	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
@@ -247,6 +250,18 @@ func issueTransactions(t *testing.T, hostWSAddr string, issuerWallet wallet.Wall
 	assert.Nil(t, err)
 	issuerWallet.SetNonce(nonce)
 
+	// the estimate is split between the execution and the L1 publishing cost of the transfer
+	issuerAddr := issuerWallet.Address()
+	transferMsg := &ethereum.CallMsg{From: issuerAddr, To: &issuerAddr, Value: big.NewInt(100)}
+	breakdown, err := authClient.EstimateGasBreakdown(ctx, transferMsg)
+	require.NoError(t, err)
+	require.Equal(t, gethparams.TxGas, uint64(breakdown.ExecutionGas))
+	require.NotZero(t, breakdown.L1DataSize)
+	require.Equal(t, breakdown.ExecutionGas+breakdown.PublishingGas, breakdown.GasLimit)
+	gasLimit, err := authClient.EstimateGas(ctx, transferMsg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(breakdown.GasLimit), gasLimit)
+
 	var receipts []gethcommon.Hash
 	for i := 0; i < numbTxs; i++ {
 		toAddr := datagenerator.RandomAddress()
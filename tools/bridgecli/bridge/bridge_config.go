@@ -0,0 +1,18 @@
+package bridge
+
+import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type Config struct {
+	L1NodeURL       string
+	L1ChainID       int64
+	L2NodeURL       string             // the RPC address of the TEN node, over HTTP
+	PK              string             // the key of the wallet that signs the transactions on both layers
+	L1BridgeAddress gethcommon.Address // taken from the important contracts of the network if empty
+	L2BridgeAddress gethcommon.Address // taken from the important contracts of the network if empty
+	ReceiptTimeout  time.Duration      // how long to wait for the receipt of a transaction
+	Wait            time.Duration      // how long to follow the cross chain messages of an operation, they are only reported once if zero
+}
@@ -0,0 +1,338 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/contracts/generated/ERC20"
+	"github.com/ten-protocol/go-ten/contracts/generated/EthereumBridge"
+	"github.com/ten-protocol/go-ten/contracts/generated/ObscuroBridge"
+	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/obsclient"
+	"github.com/ten-protocol/go-ten/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// the keys of the bridge contracts in the important contracts of the network
+	L1BridgeKey = "L1Bridge"
+	L2BridgeKey = "L2Bridge"
+
+	SendNativeMethod         = "sendNative"
+	SendERC20Method          = "sendERC20"
+	WhitelistTokenMethod     = "whitelistToken"
+	RemoveTokenMethod        = "removeToken"
+	SetRemoteBridgeMethod    = "setRemoteBridge"
+	RemoteToLocalTokenMethod = "remoteToLocalToken"
+	ApproveMethod            = "approve"
+	SymbolMethod             = "symbol"
+
+	l1RPCTimeout        = 15 * time.Second
+	receiptPollInterval = 2 * time.Second
+)
+
+// Client performs the bridge operations of a wallet. Deposits and admin operations are L1 transactions on the
+// ObscuroBridge, withdrawals are L2 transactions on the EthereumBridge. The cross chain messages of each operation are
+// reported with their status on the TEN network.
+type Client struct {
+	cfg         *Config
+	l1Client    ethadapter.EthClient
+	l2Client    *obsclient.AuthObsClient
+	l1Wallet    wallet.Wallet
+	l2Wallet    wallet.Wallet
+	l1Bridge    gethcommon.Address
+	l2Bridge    gethcommon.Address
+	l1BridgeABI *abi.ABI
+	l2BridgeABI *abi.ABI
+	erc20ABI    *abi.ABI
+	out         io.Writer
+}
+
+// Token is a whitelisted L1 token, with its wrapped counterpart on the L2
+type Token struct {
+	L1Address gethcommon.Address
+	L2Address gethcommon.Address // empty until the L2 bridge processed the whitelisting
+	Symbol    string
+}
+
+func NewClient(cfg *Config, out io.Writer, logger gethlog.Logger) (*Client, error) {
+	pk := strings.TrimPrefix(cfg.PK, "0x")
+	l1Wallet := wallet.NewInMemoryWalletFromConfig(pk, cfg.L1ChainID, logger)
+	l1Client, err := ethadapter.NewEthClientFromURL(cfg.L1NodeURL, l1RPCTimeout, l1Wallet.Address(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the L1 node. Cause: %w", err)
+	}
+
+	// the L2 wallet is only used to sign, so its chain ID is set once the network is known
+	l2Client, err := obsclient.DialWithAuth(cfg.L2NodeURL, wallet.NewInMemoryWalletFromConfig(pk, 0, logger), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the TEN node. Cause: %w", err)
+	}
+	l2ChainID, err := l2Client.ChainID()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the TEN chain ID. Cause: %w", err)
+	}
+
+	l1Bridge, l2Bridge := cfg.L1BridgeAddress, cfg.L2BridgeAddress
+	if l1Bridge == (gethcommon.Address{}) || l2Bridge == (gethcommon.Address{}) {
+		networkInfo, err := l2Client.GetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the bridge addresses from the network config. Cause: %w", err)
+		}
+		if l1Bridge == (gethcommon.Address{}) {
+			l1Bridge = networkInfo.ImportantContracts[L1BridgeKey]
+		}
+		if l2Bridge == (gethcommon.Address{}) {
+			l2Bridge = networkInfo.ImportantContracts[L2BridgeKey]
+		}
+	}
+
+	l1BridgeABI, err := ObscuroBridge.ObscuroBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	l2BridgeABI, err := EthereumBridge.EthereumBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	erc20ABI, err := ERC20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		cfg:         cfg,
+		l1Client:    l1Client,
+		l2Client:    l2Client,
+		l1Wallet:    l1Wallet,
+		l2Wallet:    wallet.NewInMemoryWalletFromPK(l2ChainID, l1Wallet.PrivateKey(), logger),
+		l1Bridge:    l1Bridge,
+		l2Bridge:    l2Bridge,
+		l1BridgeABI: l1BridgeABI,
+		l2BridgeABI: l2BridgeABI,
+		erc20ABI:    erc20ABI,
+		out:         out,
+	}, nil
+}
+
+// Address returns the address of the wallet, on both layers
+func (c *Client) Address() gethcommon.Address {
+	return c.l1Wallet.Address()
+}
+
+// L2Bridge returns the address of the EthereumBridge the client withdraws through
+func (c *Client) L2Bridge() gethcommon.Address {
+	return c.l2Bridge
+}
+
+func (c *Client) Stop() {
+	c.l1Client.Stop()
+	c.l2Client.Close()
+}
+
+// DepositNative sends the amount of the native currency of the L1 to the receiver on the L2
+func (c *Client) DepositNative(amount *big.Int, receiver gethcommon.Address) (gethcommon.Hash, error) {
+	data, err := c.l1BridgeABI.Pack(SendNativeMethod, receiver)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL1("deposit", c.l1Bridge, amount, data)
+}
+
+// DepositERC20 allows the bridge to take the amount of the token, then sends it to the receiver on the L2
+func (c *Client) DepositERC20(token gethcommon.Address, amount *big.Int, receiver gethcommon.Address) (gethcommon.Hash, error) {
+	approveData, err := c.erc20ABI.Pack(ApproveMethod, c.l1Bridge, amount)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	if _, err := c.sendL1("approve", token, nil, approveData); err != nil {
+		return gethcommon.Hash{}, err
+	}
+
+	data, err := c.l1BridgeABI.Pack(SendERC20Method, token, amount, receiver)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL1("deposit", c.l1Bridge, nil, data)
+}
+
+// WithdrawNative sends the amount of the native currency of the L2 back to the receiver on the L1
+func (c *Client) WithdrawNative(amount *big.Int, receiver gethcommon.Address) (gethcommon.Hash, error) {
+	data, err := c.l2BridgeABI.Pack(SendNativeMethod, receiver)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL2("withdrawal", c.l2Bridge, amount, data)
+}
+
+// WithdrawERC20 burns the amount of the wrapped token, and sends the L1 token back to the receiver on the L1
+func (c *Client) WithdrawERC20(wrappedToken gethcommon.Address, amount *big.Int, receiver gethcommon.Address) (gethcommon.Hash, error) {
+	data, err := c.l2BridgeABI.Pack(SendERC20Method, wrappedToken, amount, receiver)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL2("withdrawal", c.l2Bridge, nil, data)
+}
+
+// WhitelistToken allows deposits of the token, and asks the L2 bridge to create its wrapped counterpart
+func (c *Client) WhitelistToken(token gethcommon.Address, name string, symbol string) (gethcommon.Hash, error) {
+	data, err := c.l1BridgeABI.Pack(WhitelistTokenMethod, token, name, symbol)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL1("whitelisting", c.l1Bridge, nil, data)
+}
+
+// RemoveToken stops deposits of the token. Its wrapped counterpart is left on the L2.
+func (c *Client) RemoveToken(token gethcommon.Address) (gethcommon.Hash, error) {
+	data, err := c.l1BridgeABI.Pack(RemoveTokenMethod, token)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL1("removal", c.l1Bridge, nil, data)
+}
+
+// SetRemoteBridge sets the L2 bridge the L1 bridge sends its messages to
+func (c *Client) SetRemoteBridge(remoteBridge gethcommon.Address) (gethcommon.Hash, error) {
+	data, err := c.l1BridgeABI.Pack(SetRemoteBridgeMethod, remoteBridge)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return c.sendL1("remote bridge update", c.l1Bridge, nil, data)
+}
+
+// Tokens returns the tokens currently whitelisted on the L1 bridge, with their wrapped counterparts on the L2
+func (c *Client) Tokens() ([]*Token, error) {
+	logs, err := c.l1Client.GetLogs(ethereum.FilterQuery{
+		FromBlock: big.NewInt(0),
+		Addresses: []gethcommon.Address{c.l1Bridge},
+		Topics:    [][]gethcommon.Hash{{roleGrantedTopic, roleRevokedTopic}, {erc20TokenRole}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the role changes of the L1 bridge. Cause: %w", err)
+	}
+	addresses, err := whitelistedTokens(c.l1Bridge, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*Token, len(addresses))
+	for i, address := range addresses {
+		tokens[i] = &Token{L1Address: address, Symbol: c.symbol(address)}
+		if tokens[i].L2Address, err = c.wrappedToken(address); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
+
+// wrappedToken returns the L2 counterpart of the L1 token, or an empty address if there is none yet
+func (c *Client) wrappedToken(token gethcommon.Address) (gethcommon.Address, error) {
+	data, err := c.l2BridgeABI.Pack(RemoteToLocalTokenMethod, token)
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	response, err := c.l2Client.CallContract(context.Background(), ethereum.CallMsg{From: c.l2Wallet.Address(), To: &c.l2Bridge, Data: data}, nil)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("could not fetch the wrapped token of %s. Cause: %w", token, err)
+	}
+	unpacked, err := c.l2BridgeABI.Unpack(RemoteToLocalTokenMethod, response)
+	if err != nil || len(unpacked) != 1 {
+		return gethcommon.Address{}, fmt.Errorf("could not decode the wrapped token of %s. Cause: %w", token, err)
+	}
+	wrapped, ok := unpacked[0].(gethcommon.Address)
+	if !ok {
+		return gethcommon.Address{}, fmt.Errorf("could not decode the wrapped token of %s", token)
+	}
+	return wrapped, nil
+}
+
+// symbol returns the symbol of the L1 token, or an empty string if it doesn't have one
+func (c *Client) symbol(token gethcommon.Address) string {
+	data, err := c.erc20ABI.Pack(SymbolMethod)
+	if err != nil {
+		return ""
+	}
+	response, err := c.l1Client.CallContract(ethereum.CallMsg{To: &token, Data: data})
+	if err != nil {
+		return ""
+	}
+	unpacked, err := c.erc20ABI.Unpack(SymbolMethod, response)
+	if err != nil || len(unpacked) != 1 {
+		return ""
+	}
+	symbol, _ := unpacked[0].(string)
+	return symbol
+}
+
+// sendL1 signs and sends the transaction with the next nonce of the wallet, and waits for its successful receipt
+func (c *Client) sendL1(operation string, to gethcommon.Address, value *big.Int, data []byte) (gethcommon.Hash, error) {
+	nonce, err := c.l1Client.Nonce(c.l1Wallet.Address())
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not fetch the L1 nonce. Cause: %w", err)
+	}
+	tx, err := c.l1Client.PrepareTransactionToSend(&types.LegacyTx{To: &to, Value: value, Data: data}, c.l1Wallet.Address(), nonce)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not prepare the %s transaction. Cause: %w", operation, err)
+	}
+	signedTx, err := c.l1Wallet.SignTransaction(tx)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not sign the %s transaction. Cause: %w", operation, err)
+	}
+	if err := c.l1Client.SendTransaction(signedTx); err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not send the %s transaction. Cause: %w", operation, err)
+	}
+	fmt.Fprintf(c.out, "Sent the L1 %s transaction %s\n", operation, signedTx.Hash())
+
+	return signedTx.Hash(), c.awaitReceipt(operation, signedTx.Hash(), func() (*types.Receipt, error) {
+		return c.l1Client.TransactionReceipt(signedTx.Hash())
+	})
+}
+
+// sendL2 signs and sends the transaction with the next nonce of the wallet, and waits for its successful receipt
+func (c *Client) sendL2(operation string, to gethcommon.Address, value *big.Int, data []byte) (gethcommon.Hash, error) {
+	nonce, err := c.l2Client.NonceAt(context.Background(), nil)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not fetch the L2 nonce. Cause: %w", err)
+	}
+	tx := c.l2Client.EstimateGasAndGasPrice(&types.LegacyTx{Nonce: nonce, To: &to, Value: value, Data: data})
+	signedTx, err := c.l2Wallet.SignTransaction(tx)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not sign the %s transaction. Cause: %w", operation, err)
+	}
+	if err := c.l2Client.SendTransaction(context.Background(), signedTx); err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not send the %s transaction. Cause: %w", operation, err)
+	}
+	fmt.Fprintf(c.out, "Sent the L2 %s transaction %s\n", operation, signedTx.Hash())
+
+	return signedTx.Hash(), c.awaitReceipt(operation, signedTx.Hash(), func() (*types.Receipt, error) {
+		return c.l2Client.TransactionReceipt(context.Background(), signedTx.Hash())
+	})
+}
+
+func (c *Client) awaitReceipt(operation string, txHash gethcommon.Hash, fetchReceipt func() (*types.Receipt, error)) error {
+	var receipt *types.Receipt
+	err := retry.Do(func() error {
+		var err error
+		receipt, err = fetchReceipt()
+		return err
+	}, retry.NewTimeoutStrategy(c.cfg.ReceiptTimeout, receiptPollInterval))
+	if err != nil {
+		return fmt.Errorf("no receipt for the %s transaction %s. Cause: %w", operation, txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.New("the " + operation + " transaction " + txHash.Hex() + " was reverted")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package bridge
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const statusPollInterval = 5 * time.Second
+
+// FollowMessages reports the cross chain messages published by the transaction, on either layer, each time one of them
+// reaches a new stage. It returns once they all reached their last stage, or the configured wait elapsed.
+func (c *Client) FollowMessages(txHash gethcommon.Hash) error {
+	deadline := time.Now().Add(c.cfg.Wait)
+	reported := map[gethcommon.Hash]common.CrossChainStage{}
+	for {
+		statuses, err := c.l2Client.GetCrossChainMessageStatus(common.CrossChainMessageQuery{TxHash: &txHash})
+		if err != nil {
+			return fmt.Errorf("could not fetch the cross chain messages of tx %s. Cause: %w", txHash, err)
+		}
+		if len(statuses) == 0 && len(reported) == 0 {
+			fmt.Fprintf(c.out, "No cross chain message of tx %s is known to the TEN network yet\n", txHash)
+		}
+
+		done := len(statuses) > 0
+		for _, status := range statuses {
+			if reported[status.MessageHash] != status.Stage {
+				c.printStatus(status)
+				reported[status.MessageHash] = status.Stage
+			}
+			done = done && isLastStage(status)
+		}
+
+		if done || !time.Now().Add(statusPollInterval).Before(deadline) {
+			return nil
+		}
+		time.Sleep(statusPollInterval)
+	}
+}
+
+// isLastStage returns whether the message can be consumed on the layer it was sent to. An L1 message is consumed by the
+// synthetic transaction that relays it, and an L2 message can be relayed on the L1 once its rollup is final.
+func isLastStage(status *common.CrossChainMessageStatus) bool {
+	if status.Direction == common.CrossChainL1ToL2 {
+		return status.Stage == common.CrossChainRelayed
+	}
+	return status.Stage == common.CrossChainFinalised
+}
+
+func (c *Client) printStatus(status *common.CrossChainMessageStatus) {
+	fmt.Fprintf(c.out, "Message %s (%s, sequence %d): %s\n", status.MessageHash, status.Direction, status.Message.Sequence, status.Stage)
+	for _, stage := range status.Stages {
+		fmt.Fprintf(c.out, "  %-10s%s\n", stage.Stage, stageLocation(stage))
+	}
+}
+
+// stageLocation describes where the stage happened, with whichever of the L1 and L2 references it has
+func stageLocation(stage *common.CrossChainMessageStage) string {
+	location := ""
+	if stage.L1BlockNumber != nil {
+		location += fmt.Sprintf(" L1 block %d", *stage.L1BlockNumber)
+	}
+	if stage.L1TxHash != nil {
+		location += fmt.Sprintf(" L1 tx %s", stage.L1TxHash)
+	}
+	if stage.BatchSeqNo != nil {
+		location += fmt.Sprintf(" batch %d", *stage.BatchSeqNo)
+	}
+	if stage.L2TxHash != nil {
+		location += fmt.Sprintf(" L2 tx %s", stage.L2TxHash)
+	}
+	if stage.RollupHash != nil {
+		location += fmt.Sprintf(" rollup %s", stage.RollupHash)
+	}
+	return location
+}
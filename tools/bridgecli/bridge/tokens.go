@@ -0,0 +1,68 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/contracts/generated/ObscuroBridge"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// the ObscuroBridge whitelists a token by granting it the ERC20_TOKEN_ROLE, and removes it by revoking the role
+	erc20TokenRole   = crypto.Keccak256Hash([]byte("ERC20_TOKEN"))
+	roleGrantedTopic = crypto.Keccak256Hash([]byte("RoleGranted(bytes32,address,address)"))
+	roleRevokedTopic = crypto.Keccak256Hash([]byte("RoleRevoked(bytes32,address,address)"))
+)
+
+// whitelistedTokens replays the ERC20_TOKEN_ROLE grants and revocations of the bridge, in the order they happened, and
+// returns the tokens that hold the role at the end, in the order they were first whitelisted
+func whitelistedTokens(bridgeAddress gethcommon.Address, logs []types.Log) ([]gethcommon.Address, error) {
+	filterer, err := ObscuroBridge.NewObscuroBridgeFilterer(bridgeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []gethcommon.Address
+	whitelisted := map[gethcommon.Address]bool{}
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+		switch l.Topics[0] {
+		case roleGrantedTopic:
+			event, err := filterer.ParseRoleGranted(l)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode the RoleGranted event of tx %s. Cause: %w", l.TxHash, err)
+			}
+			if event.Role != erc20TokenRole {
+				continue
+			}
+			if _, seen := whitelisted[event.Account]; !seen {
+				order = append(order, event.Account)
+			}
+			whitelisted[event.Account] = true
+		case roleRevokedTopic:
+			event, err := filterer.ParseRoleRevoked(l)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode the RoleRevoked event of tx %s. Cause: %w", l.TxHash, err)
+			}
+			if event.Role != erc20TokenRole {
+				continue
+			}
+			if _, seen := whitelisted[event.Account]; seen {
+				whitelisted[event.Account] = false
+			}
+		}
+	}
+
+	tokens := make([]gethcommon.Address, 0, len(order))
+	for _, token := range order {
+		if whitelisted[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,47 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestWhitelistedTokensReplaysRoleChanges(t *testing.T) {
+	bridgeAddress := gethcommon.HexToAddress("0xb")
+	admin := gethcommon.HexToAddress("0xa")
+	tokenA := gethcommon.HexToAddress("0x1")
+	tokenB := gethcommon.HexToAddress("0x2")
+	tokenC := gethcommon.HexToAddress("0x3")
+	adminRole := gethcommon.HexToHash("0xad")
+
+	roleLog := func(topic gethcommon.Hash, role gethcommon.Hash, account gethcommon.Address) types.Log {
+		return types.Log{
+			Address: bridgeAddress,
+			Topics:  []gethcommon.Hash{topic, role, gethcommon.BytesToHash(account.Bytes()), gethcommon.BytesToHash(admin.Bytes())},
+		}
+	}
+	removedLog := roleLog(roleRevokedTopic, erc20TokenRole, tokenC)
+	removedLog.Removed = true
+
+	logs := []types.Log{
+		roleLog(roleGrantedTopic, adminRole, admin),
+		roleLog(roleGrantedTopic, erc20TokenRole, tokenA),
+		roleLog(roleGrantedTopic, erc20TokenRole, tokenB),
+		roleLog(roleGrantedTopic, erc20TokenRole, tokenC),
+		roleLog(roleRevokedTopic, erc20TokenRole, tokenA),
+		roleLog(roleRevokedTopic, adminRole, tokenB), // another role is revoked, the token stays whitelisted
+		removedLog, // the revocation was reorged out
+		roleLog(roleGrantedTopic, erc20TokenRole, tokenA),
+	}
+
+	tokens, err := whitelistedTokens(bridgeAddress, logs)
+	require.NoError(t, err)
+	require.Equal(t, []gethcommon.Address{tokenA, tokenB, tokenC}, tokens)
+
+	tokens, err = whitelistedTokens(bridgeAddress, logs[:5])
+	require.NoError(t, err)
+	require.Equal(t, []gethcommon.Address{tokenB, tokenC}, tokens)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/tools/bridgecli/bridge"
+)
+
+const (
+	// Commands.
+	depositCmd         = "deposit"
+	withdrawCmd        = "withdraw"
+	tokensCmd          = "tokens"
+	whitelistCmd       = "whitelist"
+	removeTokenCmd     = "remove-token"
+	setRemoteBridgeCmd = "set-remote-bridge"
+	statusCmd          = "status"
+
+	// Flag names, defaults and usages.
+	l1NodeURLName    = "l1NodeURL"
+	l1NodeURLDefault = "http://127.0.0.1:8025"
+	l1NodeURLUsage   = "The RPC address of the L1 node."
+
+	l1ChainIDName    = "l1ChainID"
+	l1ChainIDDefault = 1337
+	l1ChainIDUsage   = "The chain ID of the L1."
+
+	l2NodeURLName    = "l2NodeURL"
+	l2NodeURLDefault = "http://127.0.0.1:80"
+	l2NodeURLUsage   = "The HTTP RPC address of the TEN node."
+
+	pkName    = "pk"
+	pkDefault = ""
+	pkUsage   = "The private key of the wallet that signs the transactions on both layers. No default, must be set."
+
+	l1BridgeAddrName    = "l1BridgeAddress"
+	l1BridgeAddrDefault = ""
+	l1BridgeAddrUsage   = "The address of the ObscuroBridge on the L1. Taken from the network config if empty."
+
+	l2BridgeAddrName    = "l2BridgeAddress"
+	l2BridgeAddrDefault = ""
+	l2BridgeAddrUsage   = "The address of the EthereumBridge on the L2. Taken from the network config if empty."
+
+	receiptTimeoutName    = "receiptTimeout"
+	receiptTimeoutDefault = 2 * time.Minute
+	receiptTimeoutUsage   = "How long to wait for the receipt of each transaction."
+
+	waitName    = "wait"
+	waitDefault = time.Duration(0)
+	waitUsage   = "How long to follow the cross chain messages of the operation. Their status is reported once if zero."
+
+	amountName  = "amount"
+	amountUsage = "The amount to transfer, in the smallest unit of the asset. Must be set."
+
+	tokenName           = "token"
+	depositTokenUsage   = "The address of the L1 ERC20 token to deposit. The native currency is deposited if empty."
+	withdrawTokenUsage  = "The address of the L2 wrapped token to withdraw. The native currency is withdrawn if empty."
+	whitelistTokenUsage = "The address of the L1 ERC20 token. Must be set."
+
+	receiverName  = "receiver"
+	receiverUsage = "The address that receives the assets on the other layer. Defaults to the address of the wallet."
+
+	tokenNameName  = "name"
+	tokenNameUsage = "The name of the wrapped token created on the L2. Must be set."
+
+	symbolName  = "symbol"
+	symbolUsage = "The symbol of the wrapped token created on the L2. Must be set."
+
+	bridgeName  = "bridge"
+	bridgeUsage = "The address of the L2 bridge. Defaults to the L2 bridge of the network config."
+
+	txName  = "tx"
+	txUsage = "The hash of the L1 or L2 transaction whose cross chain messages are reported. Must be set."
+)
+
+// operation is the command to run, with its arguments
+type operation struct {
+	command  string
+	amount   *big.Int
+	token    gethcommon.Address
+	receiver gethcommon.Address
+	name     string
+	symbol   string
+	bridge   gethcommon.Address
+	txHash   gethcommon.Hash
+}
+
+func parseCLIArgs() (*bridge.Config, *operation) {
+	l1NodeURL := flag.String(l1NodeURLName, l1NodeURLDefault, l1NodeURLUsage)
+	l1ChainID := flag.Int64(l1ChainIDName, l1ChainIDDefault, l1ChainIDUsage)
+	l2NodeURL := flag.String(l2NodeURLName, l2NodeURLDefault, l2NodeURLUsage)
+	pk := flag.String(pkName, pkDefault, pkUsage)
+	l1BridgeAddr := flag.String(l1BridgeAddrName, l1BridgeAddrDefault, l1BridgeAddrUsage)
+	l2BridgeAddr := flag.String(l2BridgeAddrName, l2BridgeAddrDefault, l2BridgeAddrUsage)
+	receiptTimeout := flag.Duration(receiptTimeoutName, receiptTimeoutDefault, receiptTimeoutUsage)
+	wait := flag.Duration(waitName, waitDefault, waitUsage)
+	flag.Usage = usage
+	flag.Parse()
+
+	cfg := &bridge.Config{
+		L1NodeURL:       *l1NodeURL,
+		L1ChainID:       *l1ChainID,
+		L2NodeURL:       *l2NodeURL,
+		PK:              *pk,
+		L1BridgeAddress: gethcommon.HexToAddress(*l1BridgeAddr),
+		L2BridgeAddress: gethcommon.HexToAddress(*l2BridgeAddr),
+		ReceiptTimeout:  *receiptTimeout,
+		Wait:            *wait,
+	}
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	return cfg, parseOperation(flag.Arg(0), flag.Args()[1:])
+}
+
+func parseOperation(command string, args []string) *operation {
+	op := &operation{command: command}
+	cmdFlags := flag.NewFlagSet(command, flag.ExitOnError)
+	var amount, token, receiver, remoteBridge, txHash string
+
+	switch command {
+	case depositCmd, withdrawCmd:
+		tokenUsage := depositTokenUsage
+		if command == withdrawCmd {
+			tokenUsage = withdrawTokenUsage
+		}
+		cmdFlags.StringVar(&amount, amountName, "", amountUsage)
+		cmdFlags.StringVar(&token, tokenName, "", tokenUsage)
+		cmdFlags.StringVar(&receiver, receiverName, "", receiverUsage)
+	case whitelistCmd:
+		cmdFlags.StringVar(&token, tokenName, "", whitelistTokenUsage)
+		cmdFlags.StringVar(&op.name, tokenNameName, "", tokenNameUsage)
+		cmdFlags.StringVar(&op.symbol, symbolName, "", symbolUsage)
+	case removeTokenCmd:
+		cmdFlags.StringVar(&token, tokenName, "", whitelistTokenUsage)
+	case setRemoteBridgeCmd:
+		cmdFlags.StringVar(&remoteBridge, bridgeName, "", bridgeUsage)
+	case statusCmd:
+		cmdFlags.StringVar(&txHash, txName, "", txUsage)
+	case tokensCmd:
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", command)
+		usage()
+		os.Exit(2)
+	}
+	_ = cmdFlags.Parse(args) // exits on error
+
+	if amount != "" {
+		var ok bool
+		if op.amount, ok = new(big.Int).SetString(amount, 10); !ok || op.amount.Sign() <= 0 {
+			exitWithError(fmt.Errorf("invalid amount %q", amount))
+		}
+	}
+	op.token = gethcommon.HexToAddress(token)
+	op.receiver = gethcommon.HexToAddress(receiver)
+	op.bridge = gethcommon.HexToAddress(remoteBridge)
+	op.txHash = gethcommon.HexToHash(txHash)
+	return op
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: bridgecli [flags] <command> [command flags]
+
+Commands:
+  %-18s deposits native or ERC20 assets from the L1 to the L2
+  %-18s withdraws native or wrapped assets from the L2 back to the L1
+  %-18s lists the whitelisted L1 tokens and their L2 wrapped counterparts
+  %-18s whitelists an L1 token and creates its L2 wrapped counterpart (admin)
+  %-18s removes an L1 token from the whitelist (admin)
+  %-18s sets the L2 bridge the L1 bridge sends its messages to (admin)
+  %-18s reports the cross chain messages of a transaction
+
+Run 'bridgecli <command> -h' for the flags of a command.
+
+Flags:
+`, depositCmd, withdrawCmd, tokensCmd, whitelistCmd, removeTokenCmd, setRemoteBridgeCmd, statusCmd)
+	flag.PrintDefaults()
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/tools/bridgecli/bridge"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// local execution: go run . --pk <funded key> --wait 5m deposit --amount 1000000000000000000
+func main() {
+	cfg, op := parseCLIArgs()
+
+	if cfg.PK == "" {
+		exitWithError(fmt.Errorf("no key loaded"))
+	}
+
+	logger := log.New(log.BridgeCLICmp, int(gethlog.LvlError), log.SysOut)
+	client, err := bridge.NewClient(cfg, os.Stdout, logger)
+	if err != nil {
+		exitWithError(err)
+	}
+	defer client.Stop()
+
+	if err := run(client, op); err != nil {
+		client.Stop()
+		exitWithError(err)
+	}
+}
+
+func run(client *bridge.Client, op *operation) error {
+	if op.receiver == (gethcommon.Address{}) {
+		op.receiver = client.Address()
+	}
+
+	var txHash gethcommon.Hash
+	var err error
+	switch op.command {
+	case depositCmd, withdrawCmd:
+		if op.amount == nil {
+			return fmt.Errorf("the %s amount must be set", op.command)
+		}
+		txHash, err = transfer(client, op)
+	case whitelistCmd:
+		if op.token == (gethcommon.Address{}) || op.name == "" || op.symbol == "" {
+			return fmt.Errorf("the token, its name and its symbol must be set")
+		}
+		txHash, err = client.WhitelistToken(op.token, op.name, op.symbol)
+	case removeTokenCmd:
+		if op.token == (gethcommon.Address{}) {
+			return fmt.Errorf("the token must be set")
+		}
+		// the removal does not publish a cross chain message, so there is nothing to follow
+		_, err = client.RemoveToken(op.token)
+		return err
+	case setRemoteBridgeCmd:
+		if op.bridge == (gethcommon.Address{}) {
+			op.bridge = client.L2Bridge()
+		}
+		_, err = client.SetRemoteBridge(op.bridge)
+		return err
+	case tokensCmd:
+		return printTokens(client)
+	case statusCmd:
+		if op.txHash == (gethcommon.Hash{}) {
+			return fmt.Errorf("the transaction hash must be set")
+		}
+		txHash = op.txHash
+	}
+	if err != nil {
+		return err
+	}
+	return client.FollowMessages(txHash)
+}
+
+func transfer(client *bridge.Client, op *operation) (gethcommon.Hash, error) {
+	native := op.token == (gethcommon.Address{})
+	switch {
+	case op.command == depositCmd && native:
+		return client.DepositNative(op.amount, op.receiver)
+	case op.command == depositCmd:
+		return client.DepositERC20(op.token, op.amount, op.receiver)
+	case native:
+		return client.WithdrawNative(op.amount, op.receiver)
+	default:
+		return client.WithdrawERC20(op.token, op.amount, op.receiver)
+	}
+}
+
+func printTokens(client *bridge.Client) error {
+	tokens, err := client.Tokens()
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		fmt.Println("No token is whitelisted")
+		return nil
+	}
+	fmt.Printf("%-10s %-42s %s\n", "SYMBOL", "L1 TOKEN", "L2 WRAPPED TOKEN")
+	for _, token := range tokens {
+		wrapped := token.L2Address.Hex()
+		if token.L2Address == (gethcommon.Address{}) {
+			wrapped = "pending"
+		}
+		fmt.Printf("%-10s %-42s %s\n", token.Symbol, token.L1Address.Hex(), wrapped)
+	}
+	return nil
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+	os.Exit(1)
+}
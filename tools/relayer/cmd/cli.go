@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/tools/relayer/relayer"
+)
+
+const (
+	// Flag names, defaults and usages.
+	l1NodeURLName    = "l1NodeURL"
+	l1NodeURLDefault = "ws://127.0.0.1:9000"
+	l1NodeURLUsage   = "The RPC address of the L1 node the relayer submits the relay transactions to."
+
+	l1ChainIDName    = "l1ChainID"
+	l1ChainIDDefault = 1337
+	l1ChainIDUsage   = "The chain ID of the L1."
+
+	pkName    = "pk"
+	pkDefault = ""
+	pkUsage   = "The private key of the funded wallet that pays for the relay transactions. No default, must be set."
+
+	mgmtContractAddrName    = "managementContractAddress"
+	mgmtContractAddrDefault = ""
+	mgmtContractAddrUsage   = "The address of the management contract the rollups are published to. No default, must be set."
+
+	messageBusAddrName    = "messageBusAddress"
+	messageBusAddrDefault = ""
+	messageBusAddrUsage   = "The address of the L1 message bus. No default, must be set."
+
+	messengerAddrName    = "messengerAddress"
+	messengerAddrDefault = ""
+	messengerAddrUsage   = "The address of the L1 cross chain messenger the messages are relayed through. No default, must be set."
+
+	dbPathName    = "dbPath"
+	dbPathDefault = ""
+	dbPathUsage   = "The directory where the progress of the relayer is persisted. Progress is kept in memory if empty."
+
+	startBlockName    = "startBlock"
+	startBlockDefault = 0
+	startBlockUsage   = "The first L1 block scanned for rollups, when no progress was persisted."
+
+	l1ConfirmationsName    = "l1Confirmations"
+	l1ConfirmationsDefault = 6
+	l1ConfirmationsUsage   = "How many blocks must be built on top of an L1 block before its rollups are scanned."
+
+	pollIntervalName    = "pollInterval"
+	pollIntervalDefault = 12 * time.Second
+	pollIntervalUsage   = "How often the relayer scans the L1 and relays the final messages."
+
+	maxWaitForReceiptName    = "maxWaitForReceipt"
+	maxWaitForReceiptDefault = 2 * time.Minute
+	maxWaitForReceiptUsage   = "How long a relay transaction is given to be included before it is resubmitted with higher fees."
+
+	healthPortName    = "healthPort"
+	healthPortDefault = 80
+	healthPortUsage   = "The port the health endpoint binds to."
+
+	logPathName    = "logPath"
+	logPathDefault = "sys_out"
+	logPathUsage   = "The path to use for the relayer's log file."
+)
+
+func parseCLIArgs() *relayer.Config {
+	l1NodeURL := flag.String(l1NodeURLName, l1NodeURLDefault, l1NodeURLUsage)
+	l1ChainID := flag.Int64(l1ChainIDName, l1ChainIDDefault, l1ChainIDUsage)
+	pk := flag.String(pkName, pkDefault, pkUsage)
+	mgmtContractAddr := flag.String(mgmtContractAddrName, mgmtContractAddrDefault, mgmtContractAddrUsage)
+	messageBusAddr := flag.String(messageBusAddrName, messageBusAddrDefault, messageBusAddrUsage)
+	messengerAddr := flag.String(messengerAddrName, messengerAddrDefault, messengerAddrUsage)
+	dbPath := flag.String(dbPathName, dbPathDefault, dbPathUsage)
+	startBlock := flag.Uint64(startBlockName, startBlockDefault, startBlockUsage)
+	l1Confirmations := flag.Uint64(l1ConfirmationsName, l1ConfirmationsDefault, l1ConfirmationsUsage)
+	pollInterval := flag.Duration(pollIntervalName, pollIntervalDefault, pollIntervalUsage)
+	maxWaitForReceipt := flag.Duration(maxWaitForReceiptName, maxWaitForReceiptDefault, maxWaitForReceiptUsage)
+	healthPort := flag.Int(healthPortName, healthPortDefault, healthPortUsage)
+	logPath := flag.String(logPathName, logPathDefault, logPathUsage)
+	flag.Parse()
+
+	return &relayer.Config{
+		L1NodeURL:                 *l1NodeURL,
+		L1ChainID:                 *l1ChainID,
+		PK:                        *pk,
+		ManagementContractAddress: gethcommon.HexToAddress(*mgmtContractAddr),
+		MessageBusAddress:         gethcommon.HexToAddress(*messageBusAddr),
+		MessengerAddress:          gethcommon.HexToAddress(*messengerAddr),
+		DBPath:                    *dbPath,
+		StartBlock:                *startBlock,
+		L1Confirmations:           *l1Confirmations,
+		PollInterval:              *pollInterval,
+		MaxWaitForReceipt:         *maxWaitForReceipt,
+		HealthPort:                *healthPort,
+		LogPath:                   *logPath,
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ten-protocol/go-ten/tools/relayer/container"
+)
+
+// local execution: go run . --l1NodeURL ws://127.0.0.1:9000 --pk <funded key> --managementContractAddress <addr> --messageBusAddress <addr> --messengerAddress <addr>
+func main() {
+	cfg := parseCLIArgs()
+
+	if cfg.PK == "" {
+		panic("no key loaded")
+	}
+
+	relayerContainer, err := container.NewRelayerContainerFromConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	err = relayerContainer.Start()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("💡 Relayer started - the health endpoint is served on port %d.\n", cfg.HealthPort)
+
+	// Create a channel to receive signals
+	signalCh := make(chan os.Signal, 1)
+
+	// Notify the channel for interrupt signals
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+
+	// Wait for an interrupt signal
+	<-signalCh
+
+	fmt.Println("Shutting down")
+
+	err = relayerContainer.Stop()
+	if err != nil {
+		panic(err)
+	}
+}
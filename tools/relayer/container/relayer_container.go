@@ -0,0 +1,81 @@
+package container
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/crosschainlib"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/tools/relayer/relayer"
+	"github.com/ten-protocol/go-ten/tools/relayer/webserver"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const l1RPCTimeout = 15 * time.Second
+
+type RelayerContainer struct {
+	relayer   *relayer.Relayer
+	webServer *webserver.WebServer
+	db        *relayer.DB
+}
+
+func NewRelayerContainerFromConfig(cfg *relayer.Config) (*RelayerContainer, error) {
+	logger := log.New(log.RelayerCmp, int(gethlog.LvlInfo), cfg.LogPath)
+
+	relayerWallet := wallet.NewInMemoryWalletFromConfig(strings.TrimPrefix(cfg.PK, "0x"), cfg.L1ChainID, logger)
+	ethClient, err := ethadapter.NewEthClientFromURL(cfg.L1NodeURL, l1RPCTimeout, relayerWallet.Address(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the L1 node. Cause: %w", err)
+	}
+
+	var db *relayer.DB
+	if cfg.DBPath == "" {
+		logger.Warn("No DB path was set, the progress of the relayer will not be persisted")
+		db = relayer.NewInMemoryDB(logger)
+	} else if db, err = relayer.NewLevelDBBackedDB(cfg.DBPath, logger); err != nil {
+		return nil, err
+	}
+
+	relayerService := relayer.NewRelayer(
+		cfg,
+		ethClient,
+		relayerWallet,
+		mgmtcontractlib.NewMgmtContractLib(&cfg.ManagementContractAddress, logger),
+		crosschainlib.NewCrossChainLib(&cfg.MessageBusAddress, &cfg.MessengerAddress),
+		db,
+		logger,
+	)
+	server := webserver.NewWebServer(relayerService, fmt.Sprintf(":%d", cfg.HealthPort), logger)
+
+	return NewRelayerContainer(relayerService, server, db), nil
+}
+
+func NewRelayerContainer(relayerService *relayer.Relayer, webServer *webserver.WebServer, db *relayer.DB) *RelayerContainer {
+	return &RelayerContainer{
+		relayer:   relayerService,
+		webServer: webServer,
+		db:        db,
+	}
+}
+
+func (c *RelayerContainer) Start() error {
+	if err := c.relayer.Start(); err != nil {
+		return err
+	}
+	return c.webServer.Start()
+}
+
+func (c *RelayerContainer) Stop() error {
+	if err := c.webServer.Stop(); err != nil {
+		return err
+	}
+	if err := c.relayer.Stop(); err != nil {
+		return err
+	}
+	return c.db.Stop()
+}
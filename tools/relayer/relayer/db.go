@@ -0,0 +1,148 @@
+package relayer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethdb"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// Schema keys, in alphabetical order.
+var (
+	progressKey = []byte("p")
+	relayPrefix = []byte("r")
+)
+
+// Progress is the position of the relayer in the L1 chain
+type Progress struct {
+	NextHeight uint64          // the height of the next L1 block to scan for rollups
+	ParentHash gethcommon.Hash // the hash of the last scanned block, empty if it is unknown
+}
+
+// Relay is the state of the delivery of a cross chain message to the L1. It is stored from the moment the rollup
+// publishing the message is scanned, until the relay transaction is final.
+type Relay struct {
+	MessageHash gethcommon.Hash
+	Message     common.CrossChainMessage
+	// the L1 block that included the rollup publishing the message
+	RollupBlockHash   gethcommon.Hash
+	RollupBlockNumber uint64
+	TimeOfFinality    uint64 // the L1 timestamp from which the message can be relayed, zero until it is known
+	Nonce             uint64
+	TxHashes          []gethcommon.Hash // the transactions sent for the relay, they share the nonce so at most one is included
+	Retries           uint64
+	FirstSentAt       uint64 // unix timestamp of the first submission
+	LastSentAt        uint64 // unix timestamp of the latest submission
+	// the L1 block in which the transaction was included, empty while it is pending
+	BlockHash   gethcommon.Hash
+	BlockNumber uint64
+}
+
+// IsSent returns true if a relay transaction was sent for the message
+func (r *Relay) IsSent() bool {
+	return len(r.TxHashes) > 0
+}
+
+// IsIncluded returns true if the relay transaction was included in an L1 block
+func (r *Relay) IsIncluded() bool {
+	return r.BlockHash != (gethcommon.Hash{})
+}
+
+// DB persists the progress of the relayer, so it can resume after a restart
+type DB struct {
+	kvStore ethdb.KeyValueStore
+	logger  gethlog.Logger
+}
+
+// NewInMemoryDB returns a DB that does not survive restarts
+func NewInMemoryDB(logger gethlog.Logger) *DB {
+	return &DB{kvStore: gethdb.NewMemDB(), logger: logger}
+}
+
+// NewLevelDBBackedDB opens the DB at the path, creating it if it doesn't exist
+func NewLevelDBBackedDB(dbPath string, logger gethlog.Logger) (*DB, error) {
+	dbDesc := "new"
+	if _, err := os.Stat(dbPath); err == nil {
+		dbDesc = "existing"
+	}
+	db, err := leveldb.New(dbPath, 16, 16, "relayer", false)
+	if err != nil {
+		return nil, fmt.Errorf("could not create leveldb - %w", err)
+	}
+	logger.Info(fmt.Sprintf("Opened %s level db dir at %s", dbDesc, dbPath))
+	return &DB{kvStore: db, logger: logger}, nil
+}
+
+// Stop flushes and closes the DB
+func (db *DB) Stop() error {
+	db.logger.Info("Closing the relayer DB.")
+	return db.kvStore.Close()
+}
+
+// ReadProgress returns the stored progress, or nil if the relayer never scanned a block
+func (db *DB) ReadProgress() (*Progress, error) {
+	found, err := db.kvStore.Has(progressKey)
+	if err != nil || !found {
+		return nil, err
+	}
+	data, err := db.kvStore.Get(progressKey)
+	if err != nil {
+		return nil, err
+	}
+	progress := new(Progress)
+	if err := rlp.DecodeBytes(data, progress); err != nil {
+		return nil, fmt.Errorf("could not decode progress. Cause: %w", err)
+	}
+	return progress, nil
+}
+
+// WriteProgress replaces the stored progress
+func (db *DB) WriteProgress(progress *Progress) error {
+	data, err := rlp.EncodeToBytes(progress)
+	if err != nil {
+		return fmt.Errorf("could not encode progress. Cause: %w", err)
+	}
+	return db.kvStore.Put(progressKey, data)
+}
+
+// WriteRelay adds or updates the relay of a message
+func (db *DB) WriteRelay(relay *Relay) error {
+	data, err := rlp.EncodeToBytes(relay)
+	if err != nil {
+		return fmt.Errorf("could not encode relay. Cause: %w", err)
+	}
+	return db.kvStore.Put(relayKey(relay.MessageHash), data)
+}
+
+// DeleteRelay stops tracking the relay of a message
+func (db *DB) DeleteRelay(messageHash gethcommon.Hash) error {
+	return db.kvStore.Delete(relayKey(messageHash))
+}
+
+// GetRelays returns the relays of all the messages that were not delivered yet
+func (db *DB) GetRelays() ([]*Relay, error) {
+	it := db.kvStore.NewIterator(relayPrefix, nil)
+	defer it.Release()
+
+	var relays []*Relay
+	for it.Next() {
+		relay := new(Relay)
+		if err := rlp.DecodeBytes(it.Value(), relay); err != nil {
+			return nil, fmt.Errorf("could not decode relay. Cause: %w", err)
+		}
+		relays = append(relays, relay)
+	}
+	return relays, it.Error()
+}
+
+// relayKey = relayPrefix + message hash
+func relayKey(hash gethcommon.Hash) []byte {
+	return append(relayPrefix, hash.Bytes()...)
+}
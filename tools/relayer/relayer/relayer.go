@@ -0,0 +1,524 @@
+package relayer
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/merkle"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/crosschainlib"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// a relay transaction is final once it is this many blocks deep in the L1, after which it is no longer tracked
+	relayFinalityDepth = 64
+	// how far back the scanning restarts when the scanned chain was reorged
+	reorgRewindDepth = 64
+	// the number of L1 blocks scanned in a round at most, so a relayer catching up keeps relaying
+	maxBlocksPerRound = 1000
+	// the relay of a message is reported as unhealthy once it has been pending for this many receipt timeouts
+	maxRelayPendingTimeouts = 5
+
+	// the revert reason of the messenger for messages that were relayed already
+	alreadyConsumedReason = "Message already consumed"
+	revertedReason        = "execution reverted"
+)
+
+// Relayer delivers the cross chain messages published by the L2 to their targets on the L1. It scans the L1 for the
+// rollups carrying the messages, waits until the message bus considers each message final, and relays it through the
+// cross chain messenger. Relay transactions that are not included in time are resubmitted with higher fees.
+// The scanned height and the relays in progress are persisted, so the relayer resumes where it stopped after a restart.
+type Relayer struct {
+	cfg             *Config
+	ethClient       ethadapter.EthClient
+	wallet          wallet.Wallet
+	mgmtContractLib mgmtcontractlib.MgmtContractLib
+	crossChainLib   crosschainlib.CrossChainLib
+	db              *DB
+	logger          gethlog.Logger
+	stopControl     *stopcontrol.StopControl
+	wg              sync.WaitGroup
+
+	lock        sync.Mutex
+	progress    *Progress
+	relays      map[gethcommon.Hash]*Relay // the messages that were not delivered yet, by message hash
+	lastScanErr error                      // the cause of the latest failed scan, cleared by the next successful one
+	lastFailure error                      // the cause of the latest failed relay, cleared by the next delivery
+
+	status atomic.Pointer[Status] // refreshed after every round, so it can be served while a round is running
+}
+
+// Status is the state of the relayer reported by the health endpoint
+type Status struct {
+	Healthy    bool   `json:"healthy"`
+	Error      string `json:"error,omitempty"`
+	NextHeight uint64 `json:"nextHeight"` // the next L1 block to scan
+	Waiting    int    `json:"waiting"`    // the messages waiting to be final
+	Pending    int    `json:"pending"`    // the messages whose relay transaction is not final
+	Nonce      uint64 `json:"nonce"`
+}
+
+func NewRelayer(
+	cfg *Config,
+	ethClient ethadapter.EthClient,
+	wallet wallet.Wallet,
+	mgmtContractLib mgmtcontractlib.MgmtContractLib,
+	crossChainLib crosschainlib.CrossChainLib,
+	db *DB,
+	logger gethlog.Logger,
+) *Relayer {
+	return &Relayer{
+		cfg:             cfg,
+		ethClient:       ethClient,
+		wallet:          wallet,
+		mgmtContractLib: mgmtContractLib,
+		crossChainLib:   crossChainLib,
+		db:              db,
+		logger:          logger,
+		stopControl:     stopcontrol.New(),
+		relays:          map[gethcommon.Hash]*Relay{},
+	}
+}
+
+// Start restores the persisted progress and starts relaying
+func (r *Relayer) Start() error {
+	progress, err := r.db.ReadProgress()
+	if err != nil {
+		return fmt.Errorf("could not load progress. Cause: %w", err)
+	}
+	if progress == nil {
+		progress = &Progress{NextHeight: r.cfg.StartBlock}
+	}
+	relays, err := r.db.GetRelays()
+	if err != nil {
+		return fmt.Errorf("could not load relays. Cause: %w", err)
+	}
+
+	// the next nonce follows both the transactions known to the L1 and the relays that may still be in its mempool
+	nonce, err := r.ethClient.Nonce(r.wallet.Address())
+	if err != nil {
+		return fmt.Errorf("could not fetch the wallet nonce. Cause: %w", err)
+	}
+	for _, relay := range relays {
+		r.relays[relay.MessageHash] = relay
+		if relay.IsSent() && relay.Nonce >= nonce {
+			nonce = relay.Nonce + 1
+		}
+	}
+	r.wallet.SetNonce(nonce)
+	r.lock.Lock()
+	r.progress = progress
+	r.status.Store(r.currentStatus())
+	r.lock.Unlock()
+	r.logger.Info("Starting relayer", "nextHeight", progress.NextHeight, "relays", len(relays), "nonce", nonce,
+		"wallet", r.wallet.Address())
+
+	r.wg.Add(1)
+	go r.run()
+	return nil
+}
+
+func (r *Relayer) Stop() error {
+	r.stopControl.Stop()
+	r.wg.Wait()
+	return nil
+}
+
+// Status returns the state of the relayer at the end of the latest round
+func (r *Relayer) Status() *Status {
+	if status := r.status.Load(); status != nil {
+		return status
+	}
+	return &Status{Error: "relayer is not started"}
+}
+
+// currentStatus returns the state of the relayer, which is unhealthy if the L1 can't be scanned, the latest relay
+// failed, or a relay transaction has been pending for too long.
+// must be called with the lock held
+func (r *Relayer) currentStatus() *Status {
+	status := &Status{NextHeight: r.progress.NextHeight, Nonce: r.wallet.GetNonce()}
+	maxPending := maxRelayPendingTimeouts * r.cfg.MaxWaitForReceipt
+	var pendingErr error
+	for _, relay := range r.relays {
+		if !relay.IsSent() {
+			status.Waiting++
+			continue
+		}
+		status.Pending++
+		pendingFor := time.Since(time.Unix(int64(relay.FirstSentAt), 0))
+		if !relay.IsIncluded() && pendingFor > maxPending {
+			pendingErr = fmt.Errorf("relay of message %s has been pending for %s (%d retries)", relay.MessageHash, pendingFor.Round(time.Second), relay.Retries)
+		}
+	}
+
+	var err error
+	switch {
+	case r.lastScanErr != nil:
+		err = fmt.Errorf("could not scan the L1: %w", r.lastScanErr)
+	case r.lastFailure != nil:
+		err = fmt.Errorf("last relay failed: %w", r.lastFailure)
+	default:
+		err = pendingErr
+	}
+	status.Healthy = err == nil
+	if err != nil {
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func (r *Relayer) run() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopControl.Done():
+			return
+		case <-ticker.C:
+			r.lock.Lock()
+			r.scan()
+			r.relay()
+			r.status.Store(r.currentStatus())
+			r.lock.Unlock()
+		}
+	}
+}
+
+// scan queues the messages of the rollups in the L1 blocks that are deep enough since the last round.
+// must be called with the lock held
+func (r *Relayer) scan() {
+	head, err := r.ethClient.BlockNumber()
+	if err != nil {
+		r.lastScanErr = err
+		r.logger.Warn("Could not fetch the L1 head", log.ErrKey, err)
+		return
+	}
+	for i := 0; i < maxBlocksPerRound && r.progress.NextHeight+r.cfg.L1Confirmations <= head && !r.stopControl.IsStopping(); i++ {
+		block, err := r.ethClient.BlockByNumber(new(big.Int).SetUint64(r.progress.NextHeight))
+		if err != nil {
+			r.lastScanErr = err
+			r.logger.Warn("Could not fetch L1 block", log.BlockHeightKey, r.progress.NextHeight, log.ErrKey, err)
+			return
+		}
+		if r.progress.ParentHash != (gethcommon.Hash{}) && block.ParentHash() != r.progress.ParentHash {
+			r.rewind()
+			continue
+		}
+		for _, rollup := range r.rollupsOf(block) {
+			r.queue(rollup.Header.CrossChainMessages, block)
+		}
+		r.progress = &Progress{NextHeight: block.NumberU64() + 1, ParentHash: block.Hash()}
+		if err := r.db.WriteProgress(r.progress); err != nil {
+			r.logger.Error("Could not store progress", log.ErrKey, err)
+		}
+	}
+	r.lastScanErr = nil
+}
+
+// rewind restarts the scanning from before a reorg of the scanned chain. The messages of the rollups that are still
+// canonical are queued again, which is a no-op for the ones that are not delivered yet.
+// must be called with the lock held
+func (r *Relayer) rewind() {
+	from := r.cfg.StartBlock
+	if r.progress.NextHeight > from+reorgRewindDepth {
+		from = r.progress.NextHeight - reorgRewindDepth
+	}
+	r.logger.Warn("The scanned L1 chain was reorged, scanning again", "fromHeight", from, "toHeight", r.progress.NextHeight)
+	r.progress = &Progress{NextHeight: from}
+}
+
+// rollupsOf returns the rollups published in the block
+func (r *Relayer) rollupsOf(block *types.Block) []*common.ExtRollup {
+	var rollups []*common.ExtRollup
+	for _, tx := range block.Transactions() {
+		rollupTx, ok := r.mgmtContractLib.DecodeTx(tx).(*ethadapter.L1RollupTx)
+		if !ok {
+			continue
+		}
+		rollup, err := common.DecodeRollup(rollupTx.Rollup)
+		if err != nil {
+			r.logger.Warn("Could not decode rollup", log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+		rollups = append(rollups, rollup)
+	}
+	return rollups
+}
+
+// must be called with the lock held
+func (r *Relayer) queue(messages common.CrossChainMessages, block *types.Block) {
+	for _, msg := range messages {
+		msgHash, err := merkle.MessageLeaf(msg)
+		if err != nil {
+			r.logger.Error("Could not hash cross chain message", log.ErrKey, err)
+			continue
+		}
+		if _, found := r.relays[msgHash]; found {
+			continue
+		}
+		r.logger.Info("Queued cross chain message", "msgHash", msgHash, "sender", msg.Sender, "sequence", msg.Sequence,
+			log.BlockHeightKey, block.Number())
+		relay := &Relay{
+			MessageHash:       msgHash,
+			Message:           msg,
+			RollupBlockHash:   block.Hash(),
+			RollupBlockNumber: block.NumberU64(),
+		}
+		r.relays[msgHash] = relay
+		r.persist(relay)
+	}
+}
+
+// relay sends the relay transactions of the messages that became final, in the order they were published, and tracks
+// the transactions that were sent.
+// must be called with the lock held
+func (r *Relayer) relay() {
+	if len(r.relays) == 0 {
+		return
+	}
+	head, err := r.ethClient.FetchHeadBlock()
+	if err != nil {
+		r.logger.Warn("Could not fetch the L1 head", log.ErrKey, err)
+		return
+	}
+
+	relays := make([]*Relay, 0, len(r.relays))
+	for _, relay := range r.relays {
+		relays = append(relays, relay)
+	}
+	sort.Slice(relays, func(i, j int) bool {
+		if relays[i].RollupBlockNumber != relays[j].RollupBlockNumber {
+			return relays[i].RollupBlockNumber < relays[j].RollupBlockNumber
+		}
+		return relays[i].Message.Sequence < relays[j].Message.Sequence
+	})
+	for _, relay := range relays {
+		if r.stopControl.IsStopping() {
+			return
+		}
+		if relay.IsSent() {
+			r.checkRelay(relay, head.NumberU64())
+		} else if r.isFinal(relay, head) {
+			r.send(relay)
+		}
+	}
+}
+
+// isFinal returns true if the message can be relayed in the block following the head. Messages whose rollup was
+// reorged out of the L1 are dropped, they are queued again if the rollup is published again.
+// must be called with the lock held
+func (r *Relayer) isFinal(relay *Relay, head *types.Block) bool {
+	if relay.TimeOfFinality == 0 {
+		callMsg, err := r.crossChainLib.GetMessageTimeOfFinalityMsg(relay.Message)
+		if err != nil {
+			r.logger.Error("Could not create time of finality call", "msgHash", relay.MessageHash, log.ErrKey, err)
+			return false
+		}
+		response, err := r.ethClient.CallContract(callMsg)
+		if err != nil {
+			if !r.isCanonical(relay.RollupBlockHash, relay.RollupBlockNumber) {
+				r.logger.Info("Rollup of the message was reorged out of the L1", "msgHash", relay.MessageHash,
+					log.BlockHashKey, relay.RollupBlockHash)
+				r.drop(relay.MessageHash)
+				return false
+			}
+			r.logger.Debug("Time of finality of the message is not known yet", "msgHash", relay.MessageHash, log.ErrKey, err)
+			return false
+		}
+		timeOfFinality, err := r.crossChainLib.DecodeMessageTimeOfFinalityResponse(response)
+		if err != nil {
+			r.logger.Error("Could not decode time of finality", "msgHash", relay.MessageHash, log.ErrKey, err)
+			return false
+		}
+		relay.TimeOfFinality = timeOfFinality
+		r.persist(relay)
+	}
+	return head.Time() >= relay.TimeOfFinality
+}
+
+func (r *Relayer) isCanonical(blockHash gethcommon.Hash, blockNumber uint64) bool {
+	block, err := r.ethClient.BlockByNumber(new(big.Int).SetUint64(blockNumber))
+	return err == nil && block.Hash() == blockHash
+}
+
+// send sends the first relay transaction of the message with the next nonce of the wallet
+// must be called with the lock held
+func (r *Relayer) send(relay *Relay) {
+	nonce := r.wallet.GetNonceAndIncrement()
+	signedTx, err := r.signRelay(relay, nonce, 0)
+	if err == nil {
+		err = r.ethClient.SendTransaction(signedTx)
+	}
+	if err != nil {
+		r.wallet.SetNonce(nonce) // revert the wallet nonce because the transaction was not sent
+		r.onSendError(relay, err)
+		return
+	}
+
+	now := uint64(time.Now().Unix())
+	relay.Nonce = nonce
+	relay.TxHashes = []gethcommon.Hash{signedTx.Hash()}
+	relay.FirstSentAt = now
+	relay.LastSentAt = now
+	r.logger.Info("Relaying cross chain message", "msgHash", relay.MessageHash, log.TxKey, signedTx.Hash(), "nonce", nonce)
+	r.persist(relay)
+}
+
+// checkRelay checks the receipts of the transactions sent for the message. It resubmits the transaction with higher
+// fees if none was included in time, and stops tracking the message once the transaction is final.
+// must be called with the lock held
+func (r *Relayer) checkRelay(relay *Relay, head uint64) {
+	// any of the transactions sent for the message may be the one that was included
+	for _, txHash := range relay.TxHashes {
+		receipt, err := r.ethClient.TransactionReceipt(txHash)
+		if err == nil {
+			r.onReceipt(relay, txHash, receipt, head)
+			return
+		}
+	}
+
+	if relay.IsIncluded() {
+		// the node no longer knows the receipt, so the block that included the transaction is not canonical anymore
+		r.logger.Warn("Relay was reorged out of the L1, waiting for it to be included again", "msgHash", relay.MessageHash,
+			log.BlockHashKey, relay.BlockHash)
+		relay.BlockHash = gethcommon.Hash{}
+		relay.BlockNumber = 0
+		r.persist(relay)
+	}
+
+	if time.Since(time.Unix(int64(relay.LastSentAt), 0)) < r.cfg.MaxWaitForReceipt {
+		return // give the latest transaction time to be included
+	}
+
+	relay.Retries++
+	signedTx, err := r.signRelay(relay, relay.Nonce, int(relay.Retries))
+	if err == nil {
+		err = r.ethClient.SendTransaction(signedTx)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), core.ErrNonceTooLow.Error()) {
+			// another transaction used the nonce, and it wasn't ours (we have no receipt), so the message is sent again
+			r.logger.Warn("Relay transaction was replaced, relaying the message again", "msgHash", relay.MessageHash, "nonce", relay.Nonce)
+			r.resetNonce()
+			relay.TxHashes = nil
+			relay.Retries = 0
+			r.persist(relay)
+			return
+		}
+		r.onSendError(relay, err)
+		return
+	}
+	r.logger.Info("Resubmitted relay transaction with higher fees", "msgHash", relay.MessageHash, log.TxKey, signedTx.Hash(),
+		"retries", relay.Retries)
+	relay.TxHashes = append(relay.TxHashes, signedTx.Hash())
+	relay.LastSentAt = uint64(time.Now().Unix())
+	r.persist(relay)
+}
+
+// must be called with the lock held
+func (r *Relayer) onReceipt(relay *Relay, txHash gethcommon.Hash, receipt *types.Receipt, head uint64) {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		r.drop(relay.MessageHash)
+		r.lastFailure = fmt.Errorf("relay transaction %s of message %s was reverted", txHash, relay.MessageHash)
+		r.logger.Error("Relay transaction was reverted", "msgHash", relay.MessageHash, log.TxKey, txHash)
+		return
+	}
+	r.lastFailure = nil
+
+	// some L1s (e.g. the in-memory test L1) don't report the block of the receipt, so reorgs can't be detected
+	if receipt.BlockHash == (gethcommon.Hash{}) || receipt.BlockNumber == nil {
+		r.logger.Info("Cross chain message relayed", "msgHash", relay.MessageHash, log.TxKey, txHash)
+		r.drop(relay.MessageHash)
+		return
+	}
+
+	if relay.BlockHash != receipt.BlockHash {
+		r.logger.Info("Cross chain message relayed", "msgHash", relay.MessageHash, log.TxKey, txHash,
+			log.BlockHashKey, receipt.BlockHash, log.BlockHeightKey, receipt.BlockNumber)
+		relay.BlockHash = receipt.BlockHash
+		relay.BlockNumber = receipt.BlockNumber.Uint64()
+		r.persist(relay)
+	}
+	if head >= relay.BlockNumber+relayFinalityDepth {
+		r.logger.Debug("Relay is final", "msgHash", relay.MessageHash)
+		r.drop(relay.MessageHash)
+	}
+}
+
+// onSendError handles a relay transaction that could not be prepared or sent. The gas estimation of the L1 runs the
+// relay, so messages that were relayed by someone else, or whose delivery reverts, are detected before they are sent.
+// must be called with the lock held
+func (r *Relayer) onSendError(relay *Relay, err error) {
+	switch {
+	case strings.Contains(err.Error(), alreadyConsumedReason):
+		r.logger.Info("Cross chain message was already relayed", "msgHash", relay.MessageHash)
+		r.drop(relay.MessageHash)
+	case strings.Contains(err.Error(), revertedReason):
+		r.drop(relay.MessageHash)
+		r.lastFailure = fmt.Errorf("relay of message %s reverts: %w", relay.MessageHash, err)
+		r.logger.Error("Relay of cross chain message reverts, dropping it", "msgHash", relay.MessageHash, log.ErrKey, err)
+	case strings.Contains(err.Error(), core.ErrNonceTooLow.Error()):
+		r.logger.Warn("Wallet nonce is behind the L1, it will be reset", "msgHash", relay.MessageHash, log.ErrKey, err)
+		r.resetNonce()
+	default:
+		r.logger.Warn("Could not send relay transaction, it will be retried", "msgHash", relay.MessageHash, log.ErrKey, err)
+	}
+}
+
+// resetNonce takes the next nonce of the wallet from the L1, unless a relay transaction was sent with a later nonce
+// must be called with the lock held
+func (r *Relayer) resetNonce() {
+	nonce, err := r.ethClient.Nonce(r.wallet.Address())
+	if err != nil {
+		r.logger.Warn("Could not fetch the wallet nonce", log.ErrKey, err)
+		return
+	}
+	if nonce > r.wallet.GetNonce() {
+		r.wallet.SetNonce(nonce)
+	}
+}
+
+func (r *Relayer) signRelay(relay *Relay, nonce uint64, retries int) (*types.Transaction, error) {
+	txData := r.crossChainLib.CreateRelayMessage(&ethadapter.L1RelayMessageTx{Message: relay.Message})
+	tx, err := r.ethClient.PrepareTransactionToRetry(txData, r.wallet.Address(), nonce, retries)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare relay transaction: %w", err)
+	}
+	signedTx, err := r.wallet.SignTransaction(tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign relay transaction: %w", err)
+	}
+	return signedTx, nil
+}
+
+// must be called with the lock held
+func (r *Relayer) persist(relay *Relay) {
+	if err := r.db.WriteRelay(relay); err != nil {
+		// the relay is still tracked in memory, it is only lost if the relayer restarts
+		r.logger.Error("Could not store relay", "msgHash", relay.MessageHash, log.ErrKey, err)
+	}
+}
+
+// must be called with the lock held
+func (r *Relayer) drop(messageHash gethcommon.Hash) {
+	delete(r.relays, messageHash)
+	if err := r.db.DeleteRelay(messageHash); err != nil {
+		r.logger.Error("Could not delete relay", "msgHash", messageHash, log.ErrKey, err)
+	}
+}
@@ -0,0 +1,23 @@
+package relayer
+
+import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type Config struct {
+	L1NodeURL                 string
+	L1ChainID                 int64
+	PK                        string             // the key of the funded wallet that pays for the relay transactions
+	ManagementContractAddress gethcommon.Address // the contract the rollups are published to
+	MessageBusAddress         gethcommon.Address // the L1 message bus that stores the messages of the rollups
+	MessengerAddress          gethcommon.Address // the L1 cross chain messenger the messages are relayed through
+	DBPath                    string             // where the progress is persisted, the relayer uses an in-memory DB if empty
+	StartBlock                uint64             // the first L1 block scanned for rollups, if no progress was persisted
+	L1Confirmations           uint64             // how deep an L1 block must be before its rollups are scanned
+	PollInterval              time.Duration
+	MaxWaitForReceipt         time.Duration // how long a relay transaction is given to be included before it's resubmitted with higher fees
+	HealthPort                int
+	LogPath                   string
+}
@@ -0,0 +1,69 @@
+package webserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/tools/relayer/relayer"
+)
+
+// WebServer serves the health endpoint of the relayer
+type WebServer struct {
+	relayer *relayer.Relayer
+	server  *http.Server
+	logger  gethlog.Logger
+}
+
+func NewWebServer(relayerService *relayer.Relayer, bindAddress string, logger gethlog.Logger) *WebServer {
+	w := &WebServer{
+		relayer: relayerService,
+		logger:  logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", w.healthHandler)
+	w.server = &http.Server{
+		Addr:              bindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return w
+}
+
+// Start binds the server to its address, and serves the requests in the background
+func (w *WebServer) Start() error {
+	listener, err := net.Listen("tcp", w.server.Addr)
+	if err != nil {
+		return fmt.Errorf("could not bind the health server. Cause: %w", err)
+	}
+	go func() {
+		if err := w.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			w.logger.Error("Health server stopped unexpectedly", log.ErrKey, err)
+		}
+	}()
+	return nil
+}
+
+func (w *WebServer) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return w.server.Shutdown(ctx)
+}
+
+// healthHandler returns the status of the relayer, with a 503 status code if it is not healthy
+func (w *WebServer) healthHandler(resp http.ResponseWriter, _ *http.Request) {
+	status := w.relayer.Status()
+	resp.Header().Set("Content-Type", "application/json")
+	if !status.Healthy {
+		resp.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(resp).Encode(status); err != nil {
+		w.logger.Warn(fmt.Sprintf("Could not write health response: %s", err))
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ten-protocol/go-ten/go/common"
 
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
+	"github.com/ten-protocol/go-ten/tools/walletextension/pollingfilters"
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/subscriptions"
@@ -41,19 +43,21 @@ type AccountManager struct {
 	unauthedClient       rpc.Client
 	accountsMutex        sync.RWMutex
 	accountClientsHTTP   map[gethcommon.Address]*rpc.EncRPCClient // An encrypted RPC http client per registered account
-	hostRPCBindAddrWS    string
+	nodePool             *nodepool.Pool
 	subscriptionsManager *subscriptions.SubscriptionManager
+	filterManager        *pollingfilters.FilterManager
 	storage              storage.Storage
 	logger               gethlog.Logger
 }
 
-func NewAccountManager(userID string, unauthedClient rpc.Client, hostRPCBindAddressWS string, storage storage.Storage, logger gethlog.Logger) *AccountManager {
+func NewAccountManager(userID string, unauthedClient rpc.Client, nodePool *nodepool.Pool, storage storage.Storage, logger gethlog.Logger) *AccountManager {
 	return &AccountManager{
 		userID:               userID,
 		unauthedClient:       unauthedClient,
 		accountClientsHTTP:   make(map[gethcommon.Address]*rpc.EncRPCClient),
-		hostRPCBindAddrWS:    hostRPCBindAddressWS,
-		subscriptionsManager: subscriptions.New(logger),
+		nodePool:             nodePool,
+		subscriptionsManager: subscriptions.New(nodePool, logger),
+		filterManager:        pollingfilters.New(logger),
 		storage:              storage,
 		logger:               logger,
 	}
@@ -79,16 +83,16 @@ func (m *AccountManager) AddClient(address gethcommon.Address, client *rpc.EncRP
 }
 
 // ProxyRequest tries to identify the correct EncRPCClient to proxy the request to the Ten node, or it will attempt
-// the request with all clients until it succeeds
-func (m *AccountManager) ProxyRequest(rpcReq *wecommon.RPCRequest, rpcResp *interface{}, userConn userconn.UserConn) error {
+// the request with all clients until it succeeds. Only the accounts in the scope are used (all of them if it is empty).
+func (m *AccountManager) ProxyRequest(rpcReq *wecommon.RPCRequest, rpcResp *interface{}, userConn userconn.UserConn, scope []gethcommon.Address) error {
 	// We need to handle a special case for subscribing and unsubscribing from events,
 	// because we need to handle multiple accounts with a single user request
 	if rpcReq.Method == rpc.Subscribe {
-		clients, err := m.suggestSubscriptionClient(rpcReq)
+		connect, err := m.suggestSubscriptionClient(rpcReq, scope)
 		if err != nil {
 			return err
 		}
-		err = m.subscriptionsManager.HandleNewSubscriptions(clients, rpcReq, rpcResp, userConn)
+		err = m.subscriptionsManager.HandleNewSubscriptions(connect, rpcReq, rpcResp, userConn)
 		if err != nil {
 			m.logger.Error("Error subscribing to multiple clients")
 			return err
@@ -106,17 +110,49 @@ func (m *AccountManager) ProxyRequest(rpcReq *wecommon.RPCRequest, rpcResp *inte
 		m.subscriptionsManager.HandleUnsubscribe(subscriptionID, rpcResp)
 		return nil
 	}
-	return m.executeCall(rpcReq, rpcResp)
+	// the polling filters are kept by the node, so they are handled like the subscriptions
+	switch rpcReq.Method {
+	case rpc.NewFilter, rpc.NewBlockFilter, rpc.NewPendingTransactionFilter:
+		connect, err := m.suggestFilterClients(scope)
+		if err != nil {
+			return err
+		}
+		filterID, err := m.filterManager.NewFilter(connect, rpcReq)
+		if err != nil {
+			return err
+		}
+		*rpcResp = filterID
+		return nil
+	case rpc.GetFilterChanges, rpc.UninstallFilter:
+		if len(rpcReq.Params) != 1 {
+			return fmt.Errorf("one parameter (filterID) expected, %d parameters received", len(rpcReq.Params))
+		}
+		filterID, ok := rpcReq.Params[0].(string)
+		if !ok {
+			return fmt.Errorf("filterID needs to be a string. Got: %v", rpcReq.Params[0])
+		}
+		if rpcReq.Method == rpc.UninstallFilter {
+			*rpcResp = m.filterManager.UninstallFilter(filterID)
+			return nil
+		}
+		changes, err := m.filterManager.GetFilterChanges(filterID)
+		if err != nil {
+			return err
+		}
+		*rpcResp = changes
+		return nil
+	}
+	return m.executeCall(rpcReq, rpcResp, scope)
 }
 
 const emptyFilterCriteria = "[]" // This is the value that gets passed for an empty filter criteria.
 
-// suggestSubscriptionClient returns clients that should be used for the subscription request.
+// suggestSubscriptionClient returns a function creating the clients that should be used for the subscription request.
 // For other requests we use http clients, but for subscriptions ws clients are required, that is the reason for
 // creating ws clients here.
 // We only want to have the connections open for the duration of the subscription, so we create the clients here and
-// don't store them in the accountClients map.
-func (m *AccountManager) suggestSubscriptionClient(rpcReq *wecommon.RPCRequest) ([]rpc.Client, error) {
+// don't store them in the accountClients map. They are created again on another node if the node fails.
+func (m *AccountManager) suggestSubscriptionClient(rpcReq *wecommon.RPCRequest, scope []gethcommon.Address) (subscriptions.ConnectFunc, error) {
 	m.accountsMutex.RLock()
 	defer m.accountsMutex.RUnlock()
 
@@ -129,13 +165,15 @@ func (m *AccountManager) suggestSubscriptionClient(rpcReq *wecommon.RPCRequest)
 	if err != nil {
 		return nil, fmt.Errorf("error getting accounts for user: %s, %w", m.userID, err)
 	}
+	accounts = accountsInScope(accounts, scope)
 
 	userPrivateKey, err := m.storage.GetUserPrivateKey(userIDBytes)
 	if err != nil {
 		return nil, fmt.Errorf("error getting private key for user: %s, %w", m.userID, err)
 	}
 
-	if len(rpcReq.Params) > 1 {
+	// only the log subscriptions have a filter, whose topics can select the accounts
+	if len(rpcReq.Params) > 1 && rpcReq.Params[0] == rpc.SubscriptionTypeLogs {
 		filteredAccounts, err := m.filterAccounts(rpcReq, accounts)
 		if err != nil {
 			return nil, err
@@ -146,7 +184,41 @@ func (m *AccountManager) suggestSubscriptionClient(rpcReq *wecommon.RPCRequest)
 		}
 	}
 	// create clients for all accounts if we didn't find any clients that match the filter or if no topics were provided
-	return m.createClientsForAccounts(accounts, userPrivateKey)
+	return func() ([]rpc.Client, string, error) {
+		return m.createClientsForAccounts(accounts, userPrivateKey)
+	}, nil
+}
+
+// suggestFilterClients returns a function creating the clients of the accounts in the scope for a polling filter, all
+// connected to the same node, as the filters are kept by the node they are created on.
+func (m *AccountManager) suggestFilterClients(scope []gethcommon.Address) (pollingfilters.ConnectFunc, error) {
+	userIDBytes, err := wecommon.GetUserIDbyte(m.userID)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding string (%s), %w", m.userID, err)
+	}
+	accounts, err := m.storage.GetAccounts(userIDBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting accounts for user: %s, %w", m.userID, err)
+	}
+	accounts = accountsInScope(accounts, scope)
+	userPrivateKey, err := m.storage.GetUserPrivateKey(userIDBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting private key for user: %s, %w", m.userID, err)
+	}
+
+	return func() []*rpc.EncRPCClient {
+		nodeClient := m.nodePool.NodeClient()
+		clients := make([]*rpc.EncRPCClient, 0, len(accounts))
+		for _, account := range accounts {
+			encClient, err := wecommon.CreateEncClient(nodeClient, account.AccountAddress, userPrivateKey, account.Signature, m.logger)
+			if err != nil {
+				m.logger.Error(fmt.Errorf("error creating new client, %w", err).Error())
+				continue
+			}
+			clients = append(clients, encClient)
+		}
+		return clients
+	}, nil
 }
 
 // filterClients checks if any of the accounts match the filter criteria and returns those accounts
@@ -182,18 +254,26 @@ func (m *AccountManager) filterAccounts(rpcReq *wecommon.RPCRequest, accounts []
 	return filteredAccounts, nil
 }
 
-// createClientsForAllAccounts creates ws clients for all accounts for given user and returns them
-func (m *AccountManager) createClientsForAccounts(accounts []wecommon.AccountDB, userPrivateKey []byte) ([]rpc.Client, error) {
+// createClientsForAccounts creates ws clients for all accounts for given user, over a connection to a healthy node, and
+// returns them with the node
+func (m *AccountManager) createClientsForAccounts(accounts []wecommon.AccountDB, userPrivateKey []byte) ([]rpc.Client, string, error) {
+	wsClient, node, err := m.nodePool.NewSubscriptionClient()
+	if err != nil {
+		return nil, "", err
+	}
 	clients := make([]rpc.Client, 0, len(accounts))
 	for _, account := range accounts {
-		encClient, err := wecommon.CreateEncClient(m.hostRPCBindAddrWS, account.AccountAddress, userPrivateKey, account.Signature, m.logger)
+		encClient, err := wecommon.CreateEncClient(wsClient, account.AccountAddress, userPrivateKey, account.Signature, m.logger)
 		if err != nil {
 			m.logger.Error(fmt.Errorf("error creating new client, %w", err).Error())
 			continue
 		}
 		clients = append(clients, encClient)
 	}
-	return clients, nil
+	if len(clients) == 0 {
+		wsClient.Stop()
+	}
+	return clients, node, nil
 }
 
 // todo - better way
@@ -202,16 +282,20 @@ const notAuthorised = "not authorised"
 var platformAuthorisedCalls = map[string]bool{
 	rpc.GetBalance: true,
 	// rpc.GetCode, //todo
-	rpc.GetTransactionCount:   true,
-	rpc.GetTransactionReceipt: true,
-	rpc.GetLogs:               true,
+	rpc.GetTransactionCount:     true,
+	rpc.GetTransactionReceipt:   true,
+	rpc.GetLogs:                 true,
+	rpc.DebugTraceTransaction:   true,
+	rpc.DebugTraceBatchByNumber: true,
+	rpc.DebugTraceBatchByHash:   true,
 }
 
-func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *interface{}) error {
+func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *interface{}, scope []gethcommon.Address) error {
 	m.accountsMutex.RLock()
 	defer m.accountsMutex.RUnlock()
+	accountClients := m.clientsInScope(scope)
 	// for Ten RPC requests, it is important we know the sender account for the viewing key encryption/decryption
-	suggestedClient := m.suggestAccountClient(rpcReq, m.accountClientsHTTP)
+	suggestedClient := m.suggestAccountClient(rpcReq, accountClients)
 
 	switch {
 	case suggestedClient != nil: // use the suggested client if there is one
@@ -219,10 +303,10 @@ func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *inter
 		// 		The call data guessing won't often be wrong but there could be edge-cases there
 		return submitCall(suggestedClient, rpcReq, rpcResp)
 
-	case len(m.accountClientsHTTP) > 0: // try registered clients until there's a successful execution
-		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(m.accountClientsHTTP)))
+	case len(accountClients) > 0: // try registered clients until there's a successful execution
+		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(accountClients)))
 		var err error
-		for _, client := range m.accountClientsHTTP {
+		for _, client := range accountClients {
 			err = submitCall(client, rpcReq, rpcResp)
 			if err == nil || errors.Is(err, rpc.ErrNilResponse) {
 				// request didn't fail, we don't need to continue trying the other clients
@@ -245,6 +329,38 @@ func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *inter
 	}
 }
 
+// clientsInScope returns the clients of the accounts in the scope, or all the clients if the scope is empty. The caller
+// must hold the accounts lock.
+func (m *AccountManager) clientsInScope(scope []gethcommon.Address) map[gethcommon.Address]*rpc.EncRPCClient {
+	if len(scope) == 0 {
+		return m.accountClientsHTTP
+	}
+	clients := make(map[gethcommon.Address]*rpc.EncRPCClient, len(scope))
+	for _, address := range scope {
+		if client, ok := m.accountClientsHTTP[address]; ok {
+			clients[address] = client
+		}
+	}
+	return clients
+}
+
+// accountsInScope returns the accounts in the scope, or all the accounts if the scope is empty
+func accountsInScope(accounts []wecommon.AccountDB, scope []gethcommon.Address) []wecommon.AccountDB {
+	if len(scope) == 0 {
+		return accounts
+	}
+	var inScope []wecommon.AccountDB
+	for _, account := range accounts {
+		for _, address := range scope {
+			if bytes.Equal(account.AccountAddress, address.Bytes()) {
+				inScope = append(inScope, account)
+				break
+			}
+		}
+	}
+	return inScope
+}
+
 // suggestAccountClient works through various methods to try and guess which available client to use for a request, returns nil if none found
 func (m *AccountManager) suggestAccountClient(req *wecommon.RPCRequest, accClients map[gethcommon.Address]*rpc.EncRPCClient) *rpc.EncRPCClient {
 	if len(accClients) == 1 {
@@ -254,7 +370,7 @@ func (m *AccountManager) suggestAccountClient(req *wecommon.RPCRequest, accClien
 		}
 	}
 	switch req.Method {
-	case rpc.Call, rpc.EstimateGas:
+	case rpc.Call, rpc.EstimateGas, rpc.EstimateGasBreakdown, rpc.DebugTraceCall:
 		return m.handleEthCall(req, accClients)
 	case rpc.GetBalance:
 		return extractAddress(0, req.Params, accClients)
@@ -383,7 +499,7 @@ func searchDataFieldForAccount(callParams map[string]interface{}, accClients map
 }
 
 func submitCall(client *rpc.EncRPCClient, req *wecommon.RPCRequest, resp *interface{}) error {
-	if req.Method == rpc.Call || req.Method == rpc.EstimateGas {
+	if req.Method == rpc.Call || req.Method == rpc.EstimateGas || req.Method == rpc.EstimateGasBreakdown || req.Method == rpc.DebugTraceCall {
 		// Never modify the original request, as it might be reused.
 		req = req.Clone()
 
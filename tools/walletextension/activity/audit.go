@@ -0,0 +1,87 @@
+package activity
+
+import (
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// The auth events recorded in the audit log
+const (
+	EventJoin         = "join"
+	EventAuthenticate = "authenticate"
+	EventRevoke       = "revoke"
+	EventAdminDelete  = "admin_delete_user"
+)
+
+// userRefLen is the length of the user references, enough to tell the users apart
+const userRefLen = 8
+
+// AuditEvent is an auth event of a user
+type AuditEvent struct {
+	Type    string
+	UserID  []byte              // recorded as its reference, as the user ID is also the user's encryption token
+	Account *gethcommon.Address // the account the event is about, if any
+	IP      string
+	Err     error // only whether the event failed is recorded, as the errors can contain the request payloads
+}
+
+// AuditLog is a structured (JSON lines) log of the auth events, rotated by size. It records the users by their
+// reference and never records the tokens, signatures or keys of the requests.
+type AuditLog struct {
+	logger gethlog.Logger
+	closer io.Closer
+}
+
+// NewAuditLog creates an audit log writing to the file at path, which is rotated once it reaches maxSizeMB, keeping
+// maxBackups rotated files. If path is empty, the events are discarded.
+func NewAuditLog(path string, maxSizeMB int, maxBackups int) *AuditLog {
+	logger := gethlog.New()
+	if path == "" {
+		logger.SetHandler(gethlog.DiscardHandler())
+		return &AuditLog{logger: logger}
+	}
+
+	file := &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    maxSizeMB,
+		MaxBackups: maxBackups,
+	}
+	logger.SetHandler(gethlog.StreamHandler(file, gethlog.JSONFormat()))
+	return &AuditLog{logger: logger, closer: file}
+}
+
+// Record writes the event to the audit log
+func (l *AuditLog) Record(event AuditEvent) {
+	ctx := []interface{}{"user", UserRef(event.UserID), "ip", event.IP}
+	if event.Account != nil {
+		ctx = append(ctx, "account", event.Account.Hex())
+	}
+	result := "success"
+	if event.Err != nil {
+		result = "failure"
+	}
+	ctx = append(ctx, "result", result)
+	l.logger.Info(event.Type, ctx...)
+}
+
+// Close closes the log file
+func (l *AuditLog) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+// UserRef returns the reference of a user, which identifies the user in the audit log and the admin API without
+// revealing the user ID
+func UserRef(userID []byte) string {
+	if len(userID) == 0 {
+		return ""
+	}
+	return gethcommon.Bytes2Hex(crypto.Keccak256(userID)[:userRefLen])
+}
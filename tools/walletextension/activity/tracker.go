@@ -0,0 +1,84 @@
+package activity
+
+import (
+	"sync"
+	"time"
+)
+
+// UserActivity is the activity of a user since the gateway started
+type UserActivity struct {
+	Requests    uint64
+	Errors      uint64
+	LastRequest time.Time
+}
+
+// ErrorRate returns the share of the requests of the user that failed
+func (a UserActivity) ErrorRate() float64 {
+	if a.Requests == 0 {
+		return 0
+	}
+	return float64(a.Errors) / float64(a.Requests)
+}
+
+// Tracker counts the requests of the users, and how many of them failed. The counts are kept in memory, per gateway
+// instance.
+type Tracker struct {
+	users map[string]*UserActivity // by hex user ID
+	lock  sync.Mutex
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{users: map[string]*UserActivity{}}
+}
+
+// Record counts a request of the user, as an error if err is not nil
+func (t *Tracker) Record(hexUserID string, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	user, ok := t.users[hexUserID]
+	if !ok {
+		user = &UserActivity{}
+		t.users[hexUserID] = user
+	}
+	user.Requests++
+	if err != nil {
+		user.Errors++
+	}
+	user.LastRequest = time.Now()
+}
+
+// Get returns the activity of the user
+func (t *Tracker) Get(hexUserID string) UserActivity {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if user, ok := t.users[hexUserID]; ok {
+		return *user
+	}
+	return UserActivity{}
+}
+
+// Total returns the activity of all the users together
+func (t *Tracker) Total() UserActivity {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var total UserActivity
+	for _, user := range t.users {
+		total.Requests += user.Requests
+		total.Errors += user.Errors
+		if user.LastRequest.After(total.LastRequest) {
+			total.LastRequest = user.LastRequest
+		}
+	}
+	return total
+}
+
+// Delete forgets the activity of a deleted user
+func (t *Tracker) Delete(hexUserID string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.users, hexUserID)
+}
@@ -0,0 +1,119 @@
+package walletextension
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ten-protocol/go-ten/tools/walletextension/activity"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// UserSummary describes a user for the admin API, without revealing the user ID (which is also the user's token)
+type UserSummary struct {
+	User        string     `json:"user"` // the reference of the user, as in the audit log
+	Accounts    []string   `json:"accounts"`
+	Sessions    int        `json:"sessions"`
+	Requests    uint64     `json:"requests"`
+	Errors      uint64     `json:"errors"`
+	ErrorRate   float64    `json:"errorRate"`
+	LastRequest *time.Time `json:"lastRequest,omitempty"`
+}
+
+// GatewayStats are the totals of the gateway for the admin API. The request counts are since the gateway started.
+type GatewayStats struct {
+	Users             int    `json:"users"`
+	Accounts          int    `json:"accounts"`
+	Requests          uint64 `json:"requests"`
+	Errors            uint64 `json:"errors"`
+	AnonymousRequests uint64 `json:"anonymousRequests"` // the requests made without a token
+}
+
+// IsAdmin returns whether the key is the admin API key. The admin API is disabled if no key is configured.
+func (w *WalletExtension) IsAdmin(key string) bool {
+	if w.config.AdminAPIKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(key), []byte(w.config.AdminAPIKey)) == 1
+}
+
+// ListUsers returns the users of the gateway, with their accounts and activity
+func (w *WalletExtension) ListUsers() ([]UserSummary, error) {
+	userIDs, err := w.storage.GetAllUserIDs()
+	if err != nil {
+		return nil, fmt.Errorf("could not get users - %w", err)
+	}
+
+	summaries := make([]UserSummary, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if bytes.Equal(userID, []byte(common.DefaultUser)) {
+			continue
+		}
+		accounts, err := w.storage.GetAccounts(userID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get accounts of user %s - %w", activity.UserRef(userID), err)
+		}
+		sessions, err := w.storage.GetSessions(userID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get sessions of user %s - %w", activity.UserRef(userID), err)
+		}
+
+		userActivity := w.activity.Get(hex.EncodeToString(userID))
+		summary := UserSummary{
+			User:      activity.UserRef(userID),
+			Accounts:  make([]string, 0, len(accounts)),
+			Sessions:  len(sessions),
+			Requests:  userActivity.Requests,
+			Errors:    userActivity.Errors,
+			ErrorRate: userActivity.ErrorRate(),
+		}
+		if !userActivity.LastRequest.IsZero() {
+			summary.LastRequest = &userActivity.LastRequest
+		}
+		for _, account := range accounts {
+			summary.Accounts = append(summary.Accounts, gethcommon.BytesToAddress(account.AccountAddress).Hex())
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// Stats returns the totals of the gateway
+func (w *WalletExtension) Stats() (*GatewayStats, error) {
+	users, err := w.storage.CountUsers([]byte(common.DefaultUser))
+	if err != nil {
+		return nil, fmt.Errorf("could not count users - %w", err)
+	}
+	accounts, err := w.storage.CountAccounts([]byte(common.DefaultUser))
+	if err != nil {
+		return nil, fmt.Errorf("could not count accounts - %w", err)
+	}
+
+	total := w.activity.Total()
+	return &GatewayStats{
+		Users:             users,
+		Accounts:          accounts,
+		Requests:          total.Requests,
+		Errors:            total.Errors,
+		AnonymousRequests: w.activity.Get(hex.EncodeToString([]byte(common.DefaultUser))).Requests,
+	}, nil
+}
+
+// DeleteUserByRef deletes the user with the given reference (as listed by ListUsers), and returns the user ID
+func (w *WalletExtension) DeleteUserByRef(userRef string) ([]byte, error) {
+	userIDs, err := w.storage.GetAllUserIDs()
+	if err != nil {
+		return nil, fmt.Errorf("could not get users - %w", err)
+	}
+	for _, userID := range userIDs {
+		if activity.UserRef(userID) != userRef || bytes.Equal(userID, []byte(common.DefaultUser)) {
+			continue
+		}
+		return userID, w.DeleteUser(hex.EncodeToString(userID))
+	}
+	return nil, fmt.Errorf("user %s not found", userRef)
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/tools/walletextension"
+	"github.com/ten-protocol/go-ten/tools/walletextension/activity"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/userconn"
+)
+
+const bearerPrefix = "Bearer "
+
+// NewAdminRoutes returns the routes of the admin API, which require the admin API key as a bearer token
+func NewAdminRoutes(walletExt *walletextension.WalletExtension) []Route {
+	return []Route{
+		{
+			Name: common.PathAdminUsers,
+			Func: httpHandler(walletExt, adminHandler(listUsersRequestHandler)),
+		},
+		{
+			Name: common.PathAdminDeleteUser,
+			Func: httpHandler(walletExt, adminHandler(deleteUserRequestHandler)),
+		},
+		{
+			Name: common.PathAdminStats,
+			Func: httpHandler(walletExt, adminHandler(statsRequestHandler)),
+		},
+	}
+}
+
+// adminHandler only lets the requests with the admin API key through to the handler
+func adminHandler(fun func(walletExt *walletextension.WalletExtension, conn userconn.UserConn)) func(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	return func(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+		authorization := conn.GetHTTPRequest().Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, bearerPrefix) || !walletExt.IsAdmin(strings.TrimPrefix(authorization, bearerPrefix)) {
+			walletExt.Logger().Warn("Unauthorised admin request", "path", conn.GetHTTPRequest().URL.Path, "ip", walletExt.ClientIP(conn))
+			handleError(conn, walletExt.Logger(), fmt.Errorf("unauthorised"))
+			return
+		}
+		fun(walletExt, conn)
+	}
+}
+
+// Handles request to /admin/users endpoint. It lists the users with their accounts and activity.
+func listUsersRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	users, err := walletExt.ListUsers()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
+		walletExt.Logger().Error("unable to list users", log.ErrKey, err)
+		return
+	}
+	writeJSON(walletExt, conn, users)
+}
+
+// Handles request to /admin/users/delete endpoint. It deletes the user in the `user` query parameter (as listed by
+// /admin/users) with its accounts and sessions.
+func deleteUserRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	if conn.GetHTTPRequest().Method != http.MethodPost {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("users are deleted with a POST request"))
+		return
+	}
+	userRef, err := getQueryParameter(conn.ReadRequestParams(), common.AdminUserQueryParameter)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	userID, err := walletExt.DeleteUserByRef(userRef)
+	if userID != nil {
+		walletExt.RecordAuditEvent(conn, activity.EventAdminDelete, userID, nil, err)
+	}
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	err = conn.WriteResponse([]byte(common.SuccessMsg))
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
+// Handles request to /admin/stats endpoint. It returns the number of users, accounts and requests of the gateway.
+func statsRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	stats, err := walletExt.Stats()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
+		walletExt.Logger().Error("unable to get stats", log.ErrKey, err)
+		return
+	}
+	writeJSON(walletExt, conn, stats)
+}
+
+func writeJSON(walletExt *walletextension.WalletExtension, conn userconn.UserConn, value interface{}) {
+	msg, err := json.Marshal(value)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+	err = conn.WriteResponse(msg)
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
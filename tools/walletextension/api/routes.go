@@ -3,18 +3,31 @@ package api
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
 
 	"github.com/ten-protocol/go-ten/go/common/httputil"
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/tools/walletextension"
+	"github.com/ten-protocol/go-ten/tools/walletextension/activity"
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/ratelimiter"
 	"github.com/ten-protocol/go-ten/tools/walletextension/userconn"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// the number of requests of a JSON-RPC batch that are proxied concurrently
+	maxConcurrentBatchRequests = 10
+	// the JSON-RPC error code for requests that are not valid request objects
+	errCodeInvalidRequest = -32600
 )
 
 // Route defines the path plus handler for a given path
@@ -59,6 +72,18 @@ func NewHTTPRoutes(walletExt *walletextension.WalletExtension) []Route {
 			Name: common.APIVersion1 + common.PathRevoke,
 			Func: httpHandler(walletExt, revokeRequestHandler),
 		},
+		{
+			Name: common.APIVersion1 + common.PathSession,
+			Func: httpHandler(walletExt, createSessionRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathSessionRefresh,
+			Func: httpHandler(walletExt, refreshSessionRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathSessions,
+			Func: httpHandler(walletExt, listSessionsRequestHandler),
+		},
 		{
 			Name: common.PathHealth,
 			Func: httpHandler(walletExt, healthRequestHandler),
@@ -143,7 +168,8 @@ func wsRequestHandler(walletExt *walletextension.WalletExtension, resp http.Resp
 	}
 }
 
-// ethRequestHandler parses the user eth request, passes it on to the WE to proxy it and processes the response
+// ethRequestHandler parses the user eth request (or batch of requests), passes it on to the WE to proxy it and
+// processes the response
 func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
 	body, err := conn.ReadRequest()
 	if err != nil {
@@ -151,6 +177,11 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 		return
 	}
 
+	if isBatchRequest(body) {
+		batchRequestHandler(walletExt, conn, body)
+		return
+	}
+
 	request, err := parseRequest(body)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), err)
@@ -158,26 +189,14 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 	}
 	walletExt.Logger().Debug("REQUEST", "method", request.Method, "body", string(body))
 
-	if request.Method == rpc.Subscribe && !conn.SupportsSubscriptions() {
-		handleError(conn, walletExt.Logger(), fmt.Errorf("received an %s request but the connection does not support subscriptions", rpc.Subscribe))
-		return
-	}
-
-	// Get userID
-	// TODO: @ziga - after removing old wallet extension endpoints we should prevent users doing anything without valid encryption token
-	hexUserID, err := getUserID(conn, 1)
-	if err != nil || !walletExt.UserExists(hexUserID) {
-		walletExt.Logger().Info("user not found in the query params: %w. Using the default user", log.ErrKey, err)
-		hexUserID = hex.EncodeToString([]byte(common.DefaultUser)) // todo (@ziga) - this can be removed once old WE endpoints are removed
-	}
-
-	if len(hexUserID) < 3 {
-		handleError(conn, walletExt.Logger(), fmt.Errorf("encryption token length is incorrect"))
+	session, err := requestSession(walletExt, conn, request)
+	if err != nil {
+		handleEthError(request, conn, walletExt.Logger(), err)
 		return
 	}
 
 	// todo (@pedro) remove this conn dependency
-	response, err := walletExt.ProxyEthRequest(request, conn, hexUserID)
+	response, err := walletExt.ProxyEthRequest(request, conn, session)
 	if err != nil {
 		handleEthError(request, conn, walletExt.Logger(), err)
 		return
@@ -195,6 +214,116 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 	}
 }
 
+// batchRequestHandler proxies each request of a JSON-RPC batch independently, and responds with the responses (or
+// errors) in the order of the requests, leaving out the notifications
+func batchRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn, body []byte) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(body, &elements); err != nil {
+		handleInvalidRequest(conn, walletExt.Logger(), fmt.Errorf("could not unmarshal JSON-RPC batch request - %w", err))
+		return
+	}
+	if len(elements) == 0 {
+		handleInvalidRequest(conn, walletExt.Logger(), errors.New("empty JSON-RPC batch request"))
+		return
+	}
+	if len(elements) > walletExt.MaxBatchSize() {
+		handleInvalidRequest(conn, walletExt.Logger(), fmt.Errorf("JSON-RPC batch request has %d requests, the maximum is %d", len(elements), walletExt.MaxBatchSize()))
+		return
+	}
+	walletExt.Logger().Debug("BATCH REQUEST", "size", len(elements))
+
+	responses := make([]interface{}, len(elements))
+	var wg sync.WaitGroup
+	limiter := make(chan struct{}, maxConcurrentBatchRequests)
+	for i, element := range elements {
+		wg.Add(1)
+		limiter <- struct{}{}
+		go func(i int, element json.RawMessage) {
+			defer wg.Done()
+			defer func() { <-limiter }()
+			responses[i] = batchElementResponse(walletExt, conn, element)
+		}(i, element)
+	}
+	wg.Wait()
+
+	// the notifications get no response, and a batch of notifications gets no response at all
+	var batchResponses []interface{}
+	for _, response := range responses {
+		if response != nil {
+			batchResponses = append(batchResponses, response)
+		}
+	}
+	if len(batchResponses) == 0 {
+		return
+	}
+
+	rpcResponse, err := json.Marshal(batchResponses)
+	if err != nil {
+		handleEthError(nil, conn, walletExt.Logger(), err)
+		return
+	}
+	if err = conn.WriteResponse(rpcResponse); err != nil {
+		walletExt.Logger().Error("error writing batch response", log.ErrKey, err)
+	}
+}
+
+// batchElementResponse proxies a request of a batch, and returns its response or error, or nil if the request is a
+// notification (i.e. has no ID). Subscriptions are refused, as the requests of a batch run concurrently on the same
+// connection.
+func batchElementResponse(walletExt *walletextension.WalletExtension, conn userconn.UserConn, element json.RawMessage) interface{} {
+	request, err := parseRequest(element)
+	if err != nil {
+		return invalidRequestResponse(err)
+	}
+	response := batchRequestResponse(walletExt, conn, request)
+	if len(request.ID) == 0 {
+		return nil
+	}
+	return response
+}
+
+func batchRequestResponse(walletExt *walletextension.WalletExtension, conn userconn.UserConn, request *common.RPCRequest) interface{} {
+	if request.Method == rpc.Subscribe {
+		return ethErrorResponse(request, fmt.Errorf("%s requests are not supported in batch requests", rpc.Subscribe))
+	}
+	session, err := requestSession(walletExt, conn, request)
+	if err != nil {
+		return ethErrorResponse(request, err)
+	}
+	response, err := walletExt.ProxyEthRequest(request, conn, session)
+	if err != nil {
+		walletExt.Logger().Info(fmt.Sprintf("Forwarding %s error response in batch", request.Method), log.ErrKey, err)
+		return ethErrorResponse(request, err)
+	}
+	return response
+}
+
+// requestSession returns the session of the request's token, or the default user's session if the request has no
+// token. Requests with an unknown or expired token are rejected.
+func requestSession(walletExt *walletextension.WalletExtension, conn userconn.UserConn, request *common.RPCRequest) (*common.SessionDB, error) {
+	if request.Method == rpc.Subscribe && !conn.SupportsSubscriptions() {
+		return nil, fmt.Errorf("received an %s request but the connection does not support subscriptions", rpc.Subscribe)
+	}
+
+	// Get the session token
+	// TODO: @ziga - after removing old wallet extension endpoints we should prevent users doing anything without valid encryption token
+	hexToken, err := getUserID(conn, 1)
+	if err != nil {
+		walletExt.Logger().Info("token not found in the query params. Using the default user", log.ErrKey, err)
+		return walletExt.DefaultSession(), nil // todo (@ziga) - this can be removed once old WE endpoints are removed
+	}
+	return walletExt.ResolveSession(hexToken)
+}
+
+// querySession returns the active session of the token in the query parameters (or at the given position of the URL)
+func querySession(walletExt *walletextension.WalletExtension, conn userconn.UserConn, tokenPosition int) (*common.SessionDB, error) {
+	hexToken, err := getUserID(conn, tokenPosition)
+	if err != nil {
+		return nil, fmt.Errorf("encryption token ('token') not found in query parameters - %w", err)
+	}
+	return walletExt.ResolveSession(hexToken)
+}
+
 // readyRequestHandler is used to check whether the server is ready
 func readyRequestHandler(_ *walletextension.WalletExtension, _ userconn.UserConn) {}
 
@@ -264,18 +393,28 @@ func submitViewingKeyRequestHandler(walletExt *walletextension.WalletExtension,
 
 // This function handles request to /join endpoint. It is responsible to create new user (new key-pair) and store it to the db
 func joinRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
-	// todo (@ziga) add protection against DDOS attacks
 	_, err := conn.ReadRequest()
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
 		return
 	}
 
+	if err = walletExt.AllowEndpointRequest(conn, ratelimiter.JoinMethod); err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
 	// generate new key-pair and store it in the database
 	hexUserID, err := walletExt.GenerateAndStoreNewUser()
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("internal Error"))
 		walletExt.Logger().Error("error creating new user", log.ErrKey, err)
+		walletExt.RecordAuditEvent(conn, activity.EventJoin, nil, nil, err)
+		return
+	}
+	userID, err := hex.DecodeString(hexUserID)
+	if err == nil {
+		walletExt.RecordAuditEvent(conn, activity.EventJoin, userID, nil, nil)
 	}
 
 	// write hex encoded userID in the response
@@ -296,6 +435,12 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, conn
 		return
 	}
 
+	// recovering the signer and storing the account are costly, so the clients are limited by IP
+	if err = walletExt.AllowEndpointRequest(conn, ratelimiter.AuthenticateMethod); err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
 	// get the text that was signed and signature
 	var reqJSONMap map[string]string
 	err = json.Unmarshal(body, &reqJSONMap)
@@ -318,15 +463,22 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, conn
 		return
 	}
 
-	// read userID from query params
-	hexUserID, err := getUserID(conn, 2)
+	// read the session from query params, accounts can only be added with sessions that can use all the accounts
+	session, err := querySession(walletExt, conn, 2)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("malformed query: 'u' required - representing encryption token - %w", err))
 		return
 	}
+	if len(session.Accounts) > 0 || session.ReadOnly {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("accounts can't be added with a restricted session"))
+		return
+	}
+	hexUserID := hex.EncodeToString(session.UserID)
 
 	// check signature and add address and signature for that user
 	err = walletExt.AddAddressToUser(hexUserID, address, signature)
+	account := gethcommon.HexToAddress(address)
+	walletExt.RecordAuditEvent(conn, activity.EventAuthenticate, session.UserID, &account, err)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
 		walletExt.Logger().Error(fmt.Sprintf("error adding address: %s to user: %s with signature: %s", address, hexUserID, signature))
@@ -349,12 +501,13 @@ func queryRequestHandler(walletExt *walletextension.WalletExtension, conn userco
 		return
 	}
 
-	hexUserID, err := getUserID(conn, 2)
+	session, err := querySession(walletExt, conn, 2)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("user ('u') not found in query parameters"))
 		walletExt.Logger().Info("user not found in the query params", log.ErrKey, err)
 		return
 	}
+	hexUserID := hex.EncodeToString(session.UserID)
 	address, err := getQueryParameter(conn.ReadRequestParams(), common.AddressQueryParameter)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("address ('a') not found in query parameters"))
@@ -401,15 +554,21 @@ func revokeRequestHandler(walletExt *walletextension.WalletExtension, conn userc
 		return
 	}
 
-	hexUserID, err := getUserID(conn, 2)
+	session, err := querySession(walletExt, conn, 2)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("user ('u') not found in query parameters"))
 		walletExt.Logger().Info("user not found in the query params", log.ErrKey, err)
 		return
 	}
+	if len(session.Accounts) > 0 || session.ReadOnly {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("the user can't be revoked with a restricted session"))
+		return
+	}
+	hexUserID := hex.EncodeToString(session.UserID)
 
 	// delete user and accounts associated with it from the database
 	err = walletExt.DeleteUser(hexUserID)
+	walletExt.RecordAuditEvent(conn, activity.EventRevoke, session.UserID, nil, err)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
 		walletExt.Logger().Error("unable to delete user", "hexUserID", hexUserID, log.ErrKey, err)
@@ -422,6 +581,131 @@ func revokeRequestHandler(walletExt *walletextension.WalletExtension, conn userc
 	}
 }
 
+// This function handles request to /session endpoint.
+// It creates a session for the user of the session in the query parameters, restricted to the accounts in the request
+// (all the accounts of the current session if none), optionally read-only and with a TTL, and returns its token.
+func createSessionRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	body, err := conn.ReadRequest()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
+		return
+	}
+
+	session, err := querySession(walletExt, conn, 2)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	var req struct {
+		Accounts []gethcommon.Address `json:"accounts"`
+		ReadOnly bool                 `json:"readOnly"`
+		TTL      string               `json:"ttl"` // e.g. 24h, the session TTL if empty
+	}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, &req); err != nil {
+			handleError(conn, walletExt.Logger(), fmt.Errorf("could not unmarshal session request - %w", err))
+			return
+		}
+	}
+	var ttl time.Duration
+	if req.TTL != "" {
+		if ttl, err = time.ParseDuration(req.TTL); err != nil {
+			handleError(conn, walletExt.Logger(), fmt.Errorf("invalid ttl - %w", err))
+			return
+		}
+	}
+
+	hexToken, err := walletExt.CreateSession(session, req.Accounts, req.ReadOnly, ttl)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	err = conn.WriteResponse([]byte(hexToken))
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
+// This function handles request to /session/refresh endpoint.
+// In the request we receive the EIP-712 signature of the session's refresh challenge (see /sessions) by one of the
+// accounts of the session, and extend the session.
+func refreshSessionRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	body, err := conn.ReadRequest()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
+		return
+	}
+
+	var reqJSONMap map[string]string
+	err = json.Unmarshal(body, &reqJSONMap)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("could not unmarshal refresh request - %w", err))
+		return
+	}
+	signature, err := hexutil.Decode(reqJSONMap[common.JSONKeySignature])
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("unable to decode signature - %w", err))
+		return
+	}
+
+	hexToken, err := getUserID(conn, 3)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("encryption token ('token') not found in query parameters - %w", err))
+		return
+	}
+
+	expiresAt, err := walletExt.RefreshSession(hexToken, signature)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	msg, err := json.Marshal(map[string]interface{}{"expiresAt": expiresAt})
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+	err = conn.WriteResponse(msg)
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
+// This function handles request to /sessions endpoint.
+// It returns the active sessions of the user of the session in the query parameters, without their tokens.
+func listSessionsRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
+	_, err := conn.ReadRequest()
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
+		return
+	}
+
+	session, err := querySession(walletExt, conn, 2)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+
+	sessions, err := walletExt.ListSessions(session)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), fmt.Errorf("internal error"))
+		walletExt.Logger().Error("unable to list sessions", log.ErrKey, err)
+		return
+	}
+
+	msg, err := json.Marshal(sessions)
+	if err != nil {
+		handleError(conn, walletExt.Logger(), err)
+		return
+	}
+	err = conn.WriteResponse(msg)
+	if err != nil {
+		walletExt.Logger().Error("error writing success response", log.ErrKey, err)
+	}
+}
+
 // Handles request to /health endpoint.
 func healthRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn) {
 	// read the request
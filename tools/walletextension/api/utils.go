@@ -1,7 +1,9 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/ratelimiter"
 	"github.com/ten-protocol/go-ten/tools/walletextension/userconn"
 )
 
@@ -103,6 +106,30 @@ func getUserID(conn userconn.UserConn, userIDPosition int) (string, error) {
 }
 
 func handleEthError(req *common.RPCRequest, conn userconn.UserConn, logger gethlog.Logger, err error) {
+	writeJSONRPCError(conn, logger, ethErrorResponse(req, err))
+}
+
+// handleInvalidRequest responds with a JSON-RPC invalid request error, e.g. for malformed batches
+func handleInvalidRequest(conn userconn.UserConn, logger gethlog.Logger, err error) {
+	writeJSONRPCError(conn, logger, invalidRequestResponse(err))
+}
+
+func writeJSONRPCError(conn userconn.UserConn, logger gethlog.Logger, jsonRPRCError *common.JSONRPCMessage) {
+	errBytes, err := json.Marshal(jsonRPRCError)
+	if err != nil {
+		logger.Error("unable to marshal error - %w", log.ErrKey, err)
+		return
+	}
+
+	logger.Info(fmt.Sprintf("Forwarding %s error response from Obscuro node: %s", jsonRPRCError.Method, errBytes))
+
+	if err = conn.WriteResponse(errBytes); err != nil {
+		logger.Error("unable to write response back", log.ErrKey, err)
+	}
+}
+
+// ethErrorResponse returns the JSON-RPC error response for the request
+func ethErrorResponse(req *common.RPCRequest, err error) *common.JSONRPCMessage {
 	var method string
 	id := json.RawMessage("1")
 	if req != nil {
@@ -116,7 +143,7 @@ func handleEthError(req *common.RPCRequest, conn userconn.UserConn, logger gethl
 		Data:    nil,
 	}
 
-	jsonRPRCError := common.JSONRPCMessage{
+	jsonRPRCError := &common.JSONRPCMessage{
 		Version: "2.0",
 		ID:      id,
 		Method:  method,
@@ -130,19 +157,33 @@ func handleEthError(req *common.RPCRequest, conn userconn.UserConn, logger gethl
 		jsonRPRCError.Error.Code = evmError.ErrorCode()
 	}
 
-	errBytes, err := json.Marshal(jsonRPRCError)
-	if err != nil {
-		logger.Error("unable to marshal error - %w", log.ErrKey, err)
-		return
+	// e.g. the requests over the rate limits
+	var limitErr *ratelimiter.LimitExceededError
+	if errors.As(err, &limitErr) {
+		jsonRPRCError.Error.Data = limitErr.ErrorData()
+		jsonRPRCError.Error.Code = limitErr.ErrorCode()
 	}
+	return jsonRPRCError
+}
 
-	logger.Info(fmt.Sprintf("Forwarding %s error response from Obscuro node: %s", method, errBytes))
-
-	if err = conn.WriteResponse(errBytes); err != nil {
-		logger.Error("unable to write response back", log.ErrKey, err)
+// invalidRequestResponse returns the JSON-RPC error response for a request that could not be parsed, which has no ID
+func invalidRequestResponse(err error) *common.JSONRPCMessage {
+	return &common.JSONRPCMessage{
+		Version: "2.0",
+		ID:      json.RawMessage("null"),
+		Error: &common.JSONError{
+			Code:    errCodeInvalidRequest,
+			Message: err.Error(),
+		},
 	}
 }
 
+// isBatchRequest returns true if the body is a JSON-RPC batch (an array of requests)
+func isBatchRequest(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
 func handleError(conn userconn.UserConn, logger gethlog.Logger, err error) {
 	logger.Warn("error processing request - Forwarding response to user", log.ErrKey, err)
 
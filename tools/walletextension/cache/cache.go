@@ -4,42 +4,52 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
 )
 
 const (
-	longCacheTTL  = 5 * time.Hour
+	// the entries for the latest state are keyed to the head batch, this TTL only bounds them if no new batch arrives
+	headCacheTTL = 10 * time.Second
+	// the entries for the latest state expire after this TTL when the head batch is unknown
 	shortCacheTTL = 1 * time.Second
+	// the entries pinned to a batch never expire (but can be evicted)
+	pinnedCacheTTL = 0
+
+	latestBlockParam = -1 // the method has no block parameter, its result depends on the head batch
+	noBlockParam     = -2 // the method has no block parameter, its result never changes
 )
 
-// Define a struct to hold the cache TTL and auth requirement
+// RPCMethodCacheConfig holds how the results of a method are cached
 type RPCMethodCacheConfig struct {
-	CacheTTL     time.Duration
-	RequiresAuth bool
+	RequiresAuth bool // the result is private to the user
+	// the position of the block parameter (a missing parameter is the latest block), or latestBlockParam/noBlockParam
+	BlockParam int
+	// the pending state also changes when transactions are submitted, so it can't be keyed to the head batch
+	PendingNotCacheable bool
 }
 
-// CacheableRPCMethods is a map of Ethereum JSON-RPC methods that can be cached and their TTL
+// cacheableRPCMethods is a map of Ethereum JSON-RPC methods that can be cached and how they are cached
 var cacheableRPCMethods = map[string]RPCMethodCacheConfig{
-	// Ethereum JSON-RPC methods that can be cached long time
-	"eth_getBlockByNumber": {longCacheTTL, false},
-	"eth_getBlockByHash":   {longCacheTTL, false},
-	//"eth_getTransactionByHash": {longCacheTTL, true},
-	"eth_chainId": {longCacheTTL, false},
-
-	// Ethereum JSON-RPC methods that can be cached short time
-	"eth_blockNumber": {shortCacheTTL, false},
-	"eth_getCode":     {shortCacheTTL, true},
-	// "eth_getBalance":            {longCacheTTL, true},// excluded for test: gen_cor_059
-	//"eth_getTransactionReceipt": {shortCacheTTL, true},
-	"eth_call":     {shortCacheTTL, true},
-	"eth_gasPrice": {shortCacheTTL, false},
-	// "eth_getTransactionCount": {longCacheTTL, true}, // excluded for test: gen_cor_009
-	"eth_estimateGas": {shortCacheTTL, true},
-	"eth_feeHistory":  {shortCacheTTL, false},
+	"eth_getBlockByNumber": {BlockParam: 0},
+	"eth_getBlockByHash":   {BlockParam: noBlockParam},
+	"eth_chainId":          {BlockParam: noBlockParam},
+
+	"eth_blockNumber":         {BlockParam: latestBlockParam},
+	"eth_getCode":             {RequiresAuth: true, BlockParam: 1},
+	"eth_getBalance":          {RequiresAuth: true, BlockParam: 1, PendingNotCacheable: true},
+	"eth_getTransactionCount": {RequiresAuth: true, BlockParam: 1, PendingNotCacheable: true},
+	"eth_call":                {RequiresAuth: true, BlockParam: 1},
+	"eth_gasPrice":            {BlockParam: latestBlockParam},
+	"eth_estimateGas":         {RequiresAuth: true, BlockParam: 1},
+	"eth_feeHistory":          {BlockParam: 1},
 }
 
 type Cache interface {
@@ -51,43 +61,94 @@ func NewCache(logger log.Logger) (Cache, error) {
 	return NewRistrettoCache(logger)
 }
 
-// IsCacheable checks if the given RPC request is cacheable and returns the cache key and TTL
-func IsCacheable(key *common.RPCRequest, encryptionToken string) (bool, string, time.Duration) {
+// IsCacheable checks if the given RPC request is cacheable and returns the cache key and TTL.
+// The results for the latest (or pending) state are keyed to the head batch, so they are invalidated as soon as a new
+// batch arrives, and the results for an explicit batch number or hash are cached indefinitely. If the head batch is not
+// known (nil) the results for the latest state expire after a short TTL instead.
+func IsCacheable(key *common.RPCRequest, encryptionToken string, head *big.Int) (bool, string, time.Duration) {
 	if key == nil || key.Method == "" {
 		return false, "", 0
 	}
 
 	// Check if the method is cacheable
 	methodCacheConfig, isCacheable := cacheableRPCMethods[key.Method]
+	if !isCacheable {
+		return false, "", 0
+	}
 
 	// If method does not need to be authenticated, we can don't need to cache it per user
 	if !methodCacheConfig.RequiresAuth {
 		encryptionToken = ""
 	}
 
-	if isCacheable {
-		// method is cacheable - select cache key and ttl
-		switch key.Method {
-		case "eth_getCode", "eth_getBalance", "eth_estimateGas", "eth_call":
-			if len(key.Params) == 1 || len(key.Params) == 2 && (key.Params[1] == "latest" || key.Params[1] == "pending") {
-				return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), methodCacheConfig.CacheTTL
-			}
-			// in this case, we have a fixed block number, and we can cache the result for a long time
-			return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), longCacheTTL
-		case "eth_feeHistory":
-			if len(key.Params) == 2 || len(key.Params) == 3 && (key.Params[2] == "latest" || key.Params[2] == "pending") {
-				return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), methodCacheConfig.CacheTTL
-			}
-			// in this case, we have a fixed block number, and we can cache the result for a long time
-			return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), longCacheTTL
-
-		default:
-			return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), methodCacheConfig.CacheTTL
+	scope := scopeLatest
+	switch methodCacheConfig.BlockParam {
+	case noBlockParam:
+		scope = scopePinned
+	case latestBlockParam:
+	default:
+		if len(key.Params) > methodCacheConfig.BlockParam {
+			scope = blockParamScope(key.Params[methodCacheConfig.BlockParam], head)
 		}
 	}
 
-	// method is not cacheable
-	return false, "", 0
+	switch {
+	case scope == scopeNotCacheable || scope == scopePending && methodCacheConfig.PendingNotCacheable:
+		return false, "", 0
+	case scope == scopePinned:
+		return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), pinnedCacheTTL
+	case head == nil:
+		return true, GenerateCacheKey(key.Method, encryptionToken, key.Params...), shortCacheTTL
+	default:
+		// the key changes with every new head batch, which invalidates the entries of the previous head
+		return true, GenerateCacheKey(key.Method, encryptionToken+"@"+head.String(), key.Params...), headCacheTTL
+	}
+}
+
+type cacheScope int
+
+const (
+	scopeLatest cacheScope = iota
+	scopePending
+	scopePinned
+	scopeNotCacheable
+)
+
+// blockParamScope returns the scope of a block parameter: a block tag, number or hash, or an EIP-1898 object
+func blockParamScope(param interface{}, head *big.Int) cacheScope {
+	switch p := param.(type) {
+	case nil:
+		return scopeLatest
+	case string:
+		switch p {
+		case "latest", "safe", "finalized":
+			return scopeLatest
+		case "pending":
+			return scopePending
+		case "earliest":
+			return scopePinned
+		}
+		if len(p) == 2+2*gethcommon.HashLength {
+			return scopePinned // a batch hash
+		}
+		number, err := hexutil.DecodeBig(p)
+		if err != nil {
+			return scopeNotCacheable
+		}
+		// a batch that doesn't exist yet is not pinned, the result changes once it does
+		if head == nil || number.Cmp(head) > 0 {
+			return scopeLatest
+		}
+		return scopePinned
+	case map[string]interface{}:
+		if _, found := p["blockHash"]; found {
+			return scopePinned
+		}
+		if number, found := p["blockNumber"]; found {
+			return blockParamScope(number, head)
+		}
+	}
+	return scopeNotCacheable
 }
 
 // GenerateCacheKey generates a cache key for the given method, encryptionToken and parameters
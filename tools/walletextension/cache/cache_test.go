@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,8 @@ var tests = map[string]func(t *testing.T){
 	"testCacheableMethods":                    testCacheableMethods,
 	"testNonCacheableMethods":                 testNonCacheableMethods,
 	"testMethodsWithLatestOrPendingParameter": testMethodsWithLatestOrPendingParameter,
+	"testLatestEntriesAreKeyedToHead":         testLatestEntriesAreKeyedToHead,
+	"testPinnedEntriesNeverExpire":            testPinnedEntriesNeverExpire,
 }
 
 var cacheTests = map[string]func(cache Cache, t *testing.T){
@@ -53,7 +57,7 @@ func TestGatewayCaching(t *testing.T) {
 func testCacheableMethods(t *testing.T) {
 	for method := range cacheableRPCMethods {
 		key := &common.RPCRequest{Method: method}
-		isCacheable, _, _ := IsCacheable(key, encryptionToken)
+		isCacheable, _, _ := IsCacheable(key, encryptionToken, nil)
 		if isCacheable != true {
 			t.Errorf("method %s should be cacheable", method)
 		}
@@ -64,7 +68,7 @@ func testCacheableMethods(t *testing.T) {
 func testNonCacheableMethods(t *testing.T) {
 	for _, method := range nonCacheableMethods {
 		key := &common.RPCRequest{Method: method}
-		isCacheable, _, _ := IsCacheable(key, encryptionToken)
+		isCacheable, _, _ := IsCacheable(key, encryptionToken, nil)
 		if isCacheable == true {
 			t.Errorf("method %s should not be cacheable", method)
 		}
@@ -76,25 +80,71 @@ func testMethodsWithLatestOrPendingParameter(t *testing.T) {
 	methods := []string{"eth_getCode", "eth_estimateGas", "eth_call"}
 	for _, method := range methods {
 		key := &common.RPCRequest{Method: method, Params: []interface{}{"0x123", "latest"}}
-		_, _, ttl := IsCacheable(key, encryptionToken)
+		_, _, ttl := IsCacheable(key, encryptionToken, nil)
 		if ttl != shortCacheTTL {
 			t.Errorf("method %s with latest parameter should have TTL of %s, but %s received", method, shortCacheTTL, ttl)
 		}
 
 		key = &common.RPCRequest{Method: method, Params: []interface{}{"0x123", "pending"}}
-		_, _, ttl = IsCacheable(key, encryptionToken)
+		_, _, ttl = IsCacheable(key, encryptionToken, nil)
 		if ttl != shortCacheTTL {
 			t.Errorf("method %s with pending parameter should have TTL of %s, but %s received", method, shortCacheTTL, ttl)
 		}
 	}
 }
 
+// testLatestEntriesAreKeyedToHead tests that the entries for the latest state change with the head batch
+func testLatestEntriesAreKeyedToHead(t *testing.T) {
+	req := &common.RPCRequest{Method: "eth_getBalance", Params: []interface{}{"0x123", "latest"}}
+	_, keyAtHead1, ttl := IsCacheable(req, encryptionToken, big.NewInt(1))
+	if ttl != headCacheTTL {
+		t.Errorf("expected TTL of %s, but %s received", headCacheTTL, ttl)
+	}
+	_, keyAtHead1Again, _ := IsCacheable(req, encryptionToken, big.NewInt(1))
+	_, keyAtHead2, _ := IsCacheable(req, encryptionToken, big.NewInt(2))
+	if keyAtHead1 != keyAtHead1Again || keyAtHead1 == keyAtHead2 {
+		t.Errorf("the cache key should only change with the head batch")
+	}
+
+	// the pending balance changes as soon as the user submits a transaction
+	req = &common.RPCRequest{Method: "eth_getBalance", Params: []interface{}{"0x123", "pending"}}
+	if isCacheable, _, _ := IsCacheable(req, encryptionToken, big.NewInt(1)); isCacheable {
+		t.Errorf("the pending balance should not be cacheable")
+	}
+}
+
+// testPinnedEntriesNeverExpire tests that the entries for an explicit batch are cached indefinitely
+func testPinnedEntriesNeverExpire(t *testing.T) {
+	head := big.NewInt(10)
+	pinnedParams := []interface{}{
+		"0x5",
+		"earliest",
+		"0x" + strings.Repeat("ab", 32),
+		map[string]interface{}{"blockHash": "0x" + strings.Repeat("ab", 32)},
+		map[string]interface{}{"blockNumber": "0xa"},
+	}
+	for _, param := range pinnedParams {
+		req := &common.RPCRequest{Method: "eth_call", Params: []interface{}{"0x123", param}}
+		_, key, ttl := IsCacheable(req, encryptionToken, head)
+		_, keyAtNextHead, _ := IsCacheable(req, encryptionToken, big.NewInt(11))
+		if ttl != pinnedCacheTTL || key != keyAtNextHead {
+			t.Errorf("the result for block %v should be cached indefinitely", param)
+		}
+	}
+
+	// a batch after the head is not pinned yet
+	req := &common.RPCRequest{Method: "eth_getBlockByNumber", Params: []interface{}{"0xb", false}}
+	if _, _, ttl := IsCacheable(req, encryptionToken, head); ttl != headCacheTTL {
+		t.Errorf("expected TTL of %s for a future batch, but %s received", headCacheTTL, ttl)
+	}
+}
+
 // testResultsAreCached tests if the results are cached as expected
 func testResultsAreCached(cache Cache, t *testing.T) {
 	// prepare a cacheable request and imaginary response
 	req := &common.RPCRequest{Method: "eth_getBlockByNumber", Params: []interface{}{"0x123"}}
 	res := map[string]interface{}{"result": "block"}
-	isCacheable, key, ttl := IsCacheable(req, encryptionToken)
+	isCacheable, key, ttl := IsCacheable(req, encryptionToken, nil)
 	if !isCacheable {
 		t.Errorf("method %s should be cacheable", req.Method)
 	}
@@ -118,7 +168,7 @@ func testResultsAreCached(cache Cache, t *testing.T) {
 func testCacheTTL(cache Cache, t *testing.T) {
 	req := &common.RPCRequest{Method: "eth_blockNumber", Params: []interface{}{"0x123"}}
 	res := map[string]interface{}{"result": "100"}
-	isCacheable, key, ttl := IsCacheable(req, encryptionToken)
+	isCacheable, key, ttl := IsCacheable(req, encryptionToken, nil)
 
 	if !isCacheable {
 		t.Errorf("method %s should be cacheable", req.Method)
@@ -166,7 +216,7 @@ func testCachingAuthenticatedMethods(cache Cache, t *testing.T) {
 		res := map[string]interface{}{"result": "transaction"}
 
 		// store the response in cache for the first user using encryptionToken
-		isCacheable, key, ttl := IsCacheable(req, encryptionToken)
+		isCacheable, key, ttl := IsCacheable(req, encryptionToken, nil)
 
 		if !isCacheable {
 			t.Errorf("method %s should be cacheable", req.Method)
@@ -190,7 +240,7 @@ func testCachingAuthenticatedMethods(cache Cache, t *testing.T) {
 		}
 
 		// now check with the second user asking for the same request, but with a different encryptionToken
-		_, key2, _ := IsCacheable(req, encryptionToken2)
+		_, key2, _ := IsCacheable(req, encryptionToken2, nil)
 
 		_, okSecondUser := cache.Get(key2)
 		if okSecondUser {
@@ -215,7 +265,7 @@ func testCachingNonAuthenticatedMethods(cache Cache, t *testing.T) {
 		res := map[string]interface{}{"result": "transaction"}
 
 		// store the response in cache for the first user using encryptionToken
-		isCacheable, key, ttl := IsCacheable(req, encryptionToken)
+		isCacheable, key, ttl := IsCacheable(req, encryptionToken, nil)
 
 		if !isCacheable {
 			t.Errorf("method %s should be cacheable", req.Method)
@@ -239,7 +289,7 @@ func testCachingNonAuthenticatedMethods(cache Cache, t *testing.T) {
 		}
 
 		// now check with the second user asking for the same request, but with a different encryptionToken
-		_, key2, _ := IsCacheable(req, encryptionToken2)
+		_, key2, _ := IsCacheable(req, encryptionToken2, nil)
 
 		_, okSecondUser := cache.Get(key2)
 		if !okSecondUser {
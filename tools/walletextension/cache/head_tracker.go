@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
+)
+
+const (
+	headSubscriptionMinRetry = time.Second
+	headSubscriptionMaxRetry = 30 * time.Second
+)
+
+// HeadTracker follows the head batches of the node through a `newHeads` subscription, so the cache entries for the
+// latest state can be invalidated as soon as a new batch arrives
+type HeadTracker struct {
+	nodePool    *nodepool.Pool
+	head        atomic.Pointer[big.Int] // the number of the head batch, nil while the subscription is down
+	stopControl *stopcontrol.StopControl
+	logger      gethlog.Logger
+}
+
+func NewHeadTracker(nodePool *nodepool.Pool, stopControl *stopcontrol.StopControl, logger gethlog.Logger) *HeadTracker {
+	return &HeadTracker{
+		nodePool:    nodePool,
+		stopControl: stopControl,
+		logger:      logger,
+	}
+}
+
+// Start follows the head batches in the background, until the stop control is stopped
+func (h *HeadTracker) Start() {
+	go h.followHeads()
+}
+
+// Head returns the number of the head batch, or nil if it is not known
+func (h *HeadTracker) Head() *big.Int {
+	return h.head.Load()
+}
+
+func (h *HeadTracker) followHeads() {
+	retryDelay := headSubscriptionMinRetry
+	for !h.stopControl.IsStopping() {
+		start := time.Now()
+		err := h.subscribe()
+		h.head.Store(nil)
+		if h.stopControl.IsStopping() {
+			return
+		}
+
+		// back off if the node can't be reached, but reconnect quickly after a subscription that was up for a while
+		if time.Since(start) > headSubscriptionMaxRetry {
+			retryDelay = headSubscriptionMinRetry
+		}
+		h.logger.Debug("New heads subscription is down, the cache falls back to short TTLs", "retryIn", retryDelay, log.ErrKey, err)
+		select {
+		case <-time.After(retryDelay):
+		case <-h.stopControl.Done():
+			return
+		}
+		if retryDelay *= 2; retryDelay > headSubscriptionMaxRetry {
+			retryDelay = headSubscriptionMaxRetry
+		}
+	}
+}
+
+// subscribe follows the head batches until the subscription fails or the tracker is stopped
+func (h *HeadTracker) subscribe() error {
+	client, node, err := h.nodePool.NewSubscriptionClient()
+	if err != nil {
+		return err
+	}
+	defer client.Stop()
+
+	heads := make(chan *common.BatchHeader)
+	subscription, err := client.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, heads, rpc.SubscriptionTypeNewHeads)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+	h.logger.Info("Following the head batches of the node to invalidate the cache", "node", node)
+
+	// the heads of a node that fell behind are stale, so the tracker moves to another node
+	healthCheck := time.NewTicker(headSubscriptionMinRetry)
+	defer healthCheck.Stop()
+	for {
+		select {
+		case header := <-heads:
+			h.head.Store(new(big.Int).Set(header.Number))
+		case <-healthCheck.C:
+			if !h.nodePool.IsHealthy(node) {
+				return fmt.Errorf("node %s is not healthy", node)
+			}
+		case err = <-subscription.Err():
+			return err
+		case <-h.stopControl.Done():
+			return nil
+		}
+	}
+}
@@ -40,7 +40,7 @@ func GetUserIDbyte(userID string) ([]byte, error) {
 }
 
 func CreateEncClient(
-	hostRPCBindAddr string,
+	client rpc.Client,
 	addressBytes []byte,
 	privateKeyBytes []byte,
 	signature []byte,
@@ -59,7 +59,7 @@ func CreateEncClient(
 		PublicKey:               PrivateKeyToCompressedPubKey(privateKey),
 		SignatureWithAccountKey: signature,
 	}
-	encClient, err := rpc.NewEncNetworkClient(hostRPCBindAddr, vk, logger)
+	encClient, err := rpc.NewEncRPCClient(client, vk, logger)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create EncRPCClient: %w", err)
 	}
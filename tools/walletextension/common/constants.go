@@ -35,6 +35,13 @@ const (
 	PathRevoke                          = "/revoke/"
 	PathObscuroGateway                  = "/"
 	PathHealth                          = "/health/"
+	PathSession                         = "/session/"
+	PathSessionRefresh                  = "/session/refresh/"
+	PathSessions                        = "/sessions/"
+	PathAdminUsers                      = "/admin/users/"
+	PathAdminDeleteUser                 = "/admin/users/delete/"
+	PathAdminStats                      = "/admin/stats/"
+	AdminUserQueryParameter             = "user"
 	PathNetworkHealth                   = "/network-health/"
 	WSProtocol                          = "ws://"
 	HTTPProtocol                        = "http://"
@@ -50,6 +57,10 @@ const (
 	MethodEthSubscription               = "eth_subscription"
 	PathVersion                         = "/version/"
 	DeduplicationBufferSize             = 20
+	DefaultMaxBatchSize                 = 100
 )
 
 var ReaderHeadTimeout = 10 * time.Second
+
+// DefaultSessionTTL is how long a session is valid for, until it is refreshed
+var DefaultSessionTTL = 30 * 24 * time.Hour
@@ -1,5 +1,7 @@
 package common
 
+import "time"
+
 type AccountDB struct {
 	AccountAddress []byte
 	Signature      []byte
@@ -9,3 +11,13 @@ type UserDB struct {
 	UserID     []byte
 	PrivateKey []byte
 }
+
+// SessionDB is a session of a user, whose ID is the token the requests are made with. A session without accounts can
+// use all the accounts of the user.
+type SessionDB struct {
+	SessionID []byte
+	UserID    []byte
+	ExpiresAt time.Time
+	Accounts  [][]byte
+	ReadOnly  bool // read-only sessions can't send transactions
+}
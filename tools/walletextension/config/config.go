@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // Config contains the configuration required by the WalletExtension.
 type Config struct {
 	WalletExtensionHost     string
@@ -14,4 +16,28 @@ type Config struct {
 	DBConnectionURL         string
 	TenChainID              int
 	StoreIncomingTxs        bool
+	EncryptionKey           string // The master keys encrypting the users' private keys, as `<version>:<hex key>` entries
+	EncryptionKeyFile       string // The file holding the master keys, used if EncryptionKey is empty (created if missing)
+
+	// Rate limits, in request cost per second (0 disables a limit) and bucket capacity
+	RateLimitUserRate     float64
+	RateLimitUserBurst    int
+	RateLimitIPRate       float64
+	RateLimitIPBurst      int
+	RateLimitMethods      string // Limits per user and method, as comma-separated `<method>:<rate>:<burst>` entries
+	RateLimitMethodCosts  string // Overrides the cost of methods, as comma-separated `<method>:<cost>` entries
+	UserDailyQuota        int    // The total request cost a user can spend per day (0 is unlimited)
+	TrustForwardedForAddr bool   // Limits IPs by the X-Forwarded-For header, if the gateway is behind a trusted proxy
+
+	MaxBatchSize int // The maximum number of requests in a JSON-RPC batch (0 is the default of 100)
+
+	AdditionalNodes string // Other nodes to balance the requests over, as comma-separated `<host>:<http port>:<ws port>` entries
+	MaxNodeBatchLag int    // The number of batches a node can be behind the others and still serve reads (0 is the default of 5)
+
+	SessionTTL time.Duration // How long a session is valid for, until it is refreshed (0 is the default of 30 days)
+
+	AdminAPIKey        string // The key of the admin API, which is disabled if empty
+	AuditLogPath       string // The file of the audit log of the auth events, which is disabled if empty
+	AuditLogMaxSizeMB  int    // The size at which the audit log is rotated
+	AuditLogMaxBackups int    // The number of rotated audit log files kept
 }
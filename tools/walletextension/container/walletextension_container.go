@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -14,10 +15,11 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
-	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/tools/walletextension"
 	"github.com/ten-protocol/go-ten/tools/walletextension/api"
 	"github.com/ten-protocol/go-ten/tools/walletextension/config"
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
+	"github.com/ten-protocol/go-ten/tools/walletextension/ratelimiter"
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage"
 	"github.com/ten-protocol/go-ten/tools/walletextension/useraccountmanager"
 
@@ -26,7 +28,7 @@ import (
 )
 
 type WalletExtensionContainer struct {
-	hostAddr           string
+	nodePool           *nodepool.Pool
 	userAccountManager *useraccountmanager.UserAccountManager
 	storage            storage.Storage
 	stopControl        *stopcontrol.StopControl
@@ -37,22 +39,32 @@ type WalletExtensionContainer struct {
 }
 
 func NewWalletExtensionContainerFromConfig(config config.Config, logger gethlog.Logger) *WalletExtensionContainer {
-	// create the account manager with a single unauthenticated connection
-	hostRPCBindAddrWS := wecommon.WSProtocol + config.NodeRPCWebsocketAddress
-	hostRPCBindAddrHTTP := wecommon.HTTPProtocol + config.NodeRPCHTTPAddress
-	unAuthedClient, err := rpc.NewNetworkClient(hostRPCBindAddrHTTP)
+	// the requests are balanced over the configured node and the additional nodes
+	nodePool, err := newNodePool(config, logger)
 	if err != nil {
-		logger.Crit("unable to create temporary client for request ", log.ErrKey, err)
+		logger.Crit("unable to create the node pool ", log.ErrKey, err)
 		os.Exit(1)
 	}
+	// create the account manager with a single unauthenticated connection
+	unAuthedClient := nodePool.Client()
 
 	// start the database
-	databaseStorage, err := storage.New(config.DBType, config.DBConnectionURL, config.DBPathOverride)
+	masterKeys, err := loadMasterKeys(config)
+	if err != nil {
+		logger.Crit("unable to load the master keys to encrypt viewing keys ", log.ErrKey, err)
+		os.Exit(1)
+	}
+	databaseStorage, err := storage.New(config.DBType, config.DBConnectionURL, config.DBPathOverride, masterKeys)
 	if err != nil {
 		logger.Crit("unable to create database to store viewing keys ", log.ErrKey, err)
 		os.Exit(1)
 	}
-	userAccountManager := useraccountmanager.NewUserAccountManager(unAuthedClient, logger, databaseStorage, hostRPCBindAddrHTTP, hostRPCBindAddrWS)
+	rateLimiter, err := newRateLimiter(config)
+	if err != nil {
+		logger.Crit("invalid rate limits ", log.ErrKey, err)
+		os.Exit(1)
+	}
+	userAccountManager := useraccountmanager.NewUserAccountManager(unAuthedClient, logger, databaseStorage, nodePool)
 
 	// add default user (when no UserID is provided in the query parameter - for WE endpoints)
 	defaultUserAccountManager := userAccountManager.AddAndReturnAccountManager(hex.EncodeToString([]byte(wecommon.DefaultUser)))
@@ -85,7 +97,7 @@ func NewWalletExtensionContainerFromConfig(config config.Config, logger gethlog.
 				os.Exit(1)
 			}
 			for _, account := range accounts {
-				encClient, err := wecommon.CreateEncClient(hostRPCBindAddrWS, account.AccountAddress, user.PrivateKey, account.Signature, logger)
+				encClient, err := wecommon.CreateEncClient(nodePool.Client(), account.AccountAddress, user.PrivateKey, account.Signature, logger)
 				if err != nil {
 					logger.Error(fmt.Errorf("error creating new client, %w", err).Error())
 					os.Exit(1)
@@ -110,14 +122,20 @@ func NewWalletExtensionContainerFromConfig(config config.Config, logger gethlog.
 	}
 
 	stopControl := stopcontrol.New()
-	walletExt := walletextension.New(hostRPCBindAddrHTTP, hostRPCBindAddrWS, &userAccountManager, databaseStorage, stopControl, version, logger, &config)
+	walletExt := walletextension.New(nodePool, &userAccountManager, databaseStorage, rateLimiter, stopControl, version, logger, &config)
+	// the users that joined before the sessions were introduced get an initial session, with their userID as the token
+	if err = walletExt.AddInitialSessions(allUsers); err != nil {
+		logger.Error("Unable to add the initial sessions of the users", log.ErrKey, err)
+		os.Exit(1)
+	}
 	httpRoutes := api.NewHTTPRoutes(walletExt)
+	httpRoutes = append(httpRoutes, api.NewAdminRoutes(walletExt)...)
 	httpServer := api.NewHTTPServer(fmt.Sprintf("%s:%d", config.WalletExtensionHost, config.WalletExtensionPortHTTP), httpRoutes)
 
 	wsRoutes := api.NewWSRoutes(walletExt)
 	wsServer := api.NewWSServer(fmt.Sprintf("%s:%d", config.WalletExtensionHost, config.WalletExtensionPortWS), wsRoutes)
 	return NewWalletExtensionContainer(
-		hostRPCBindAddrWS,
+		nodePool,
 		walletExt,
 		&userAccountManager,
 		databaseStorage,
@@ -129,7 +147,7 @@ func NewWalletExtensionContainerFromConfig(config config.Config, logger gethlog.
 }
 
 func NewWalletExtensionContainer(
-	hostAddr string,
+	nodePool *nodepool.Pool,
 	walletExt *walletextension.WalletExtension,
 	userAccountManager *useraccountmanager.UserAccountManager,
 	storage storage.Storage,
@@ -139,7 +157,7 @@ func NewWalletExtensionContainer(
 	logger gethlog.Logger,
 ) *WalletExtensionContainer {
 	return &WalletExtensionContainer{
-		hostAddr:           hostAddr,
+		nodePool:           nodePool,
 		walletExt:          walletExt,
 		userAccountManager: userAccountManager,
 		storage:            storage,
@@ -152,6 +170,7 @@ func NewWalletExtensionContainer(
 
 // Start starts the wallet extension container
 func (w *WalletExtensionContainer) Start() error {
+	w.nodePool.Start(w.stopControl)
 	httpErrChan := w.httpServer.Start()
 	wsErrChan := w.wsServer.Start()
 
@@ -202,6 +221,63 @@ func (w *WalletExtensionContainer) Stop() error {
 		w.logger.Warn("could not shut down wallet extension", log.ErrKey, err)
 	}
 
+	w.nodePool.Stop()
+
+	err = w.walletExt.Stop()
+	if err != nil {
+		w.logger.Warn("could not close the audit log", log.ErrKey, err)
+	}
+
 	// todo (@pedro) correctly surface shutdown errors
 	return nil
 }
+
+// loadMasterKeys returns the configured master keys. If none are configured, sqlite databases use a key file next to
+// the database file (or a random key if the database is a throwaway temp file).
+func loadMasterKeys(config config.Config) (*storage.MasterKeys, error) {
+	keyFile := config.EncryptionKeyFile
+	if config.EncryptionKey == "" && keyFile == "" && config.DBType == "sqlite" {
+		if config.DBPathOverride == "" {
+			return storage.NewRandomMasterKeys()
+		}
+		keyFile = filepath.Join(filepath.Dir(config.DBPathOverride), "gateway_master.key")
+	}
+	return storage.LoadMasterKeys(config.EncryptionKey, keyFile)
+}
+
+// newRateLimiter returns the rate limiter for the configured limits, which reports the limit hits in the default metrics
+// registry
+func newRateLimiter(config config.Config) (*ratelimiter.RateLimiter, error) {
+	methodLimits, err := ratelimiter.ParseMethodLimits(config.RateLimitMethods)
+	if err != nil {
+		return nil, err
+	}
+	methodCosts, err := ratelimiter.ParseMethodCosts(config.RateLimitMethodCosts)
+	if err != nil {
+		return nil, err
+	}
+	return ratelimiter.New(ratelimiter.Config{
+		User:         ratelimiter.Limit{Rate: config.RateLimitUserRate, Burst: config.RateLimitUserBurst},
+		IP:           ratelimiter.Limit{Rate: config.RateLimitIPRate, Burst: config.RateLimitIPBurst},
+		Methods:      methodLimits,
+		MethodCosts:  methodCosts,
+		UserDayQuota: config.UserDailyQuota,
+	}, nil), nil
+}
+
+// newNodePool returns the pool of the configured node and the additional nodes
+func newNodePool(config config.Config, logger gethlog.Logger) (*nodepool.Pool, error) {
+	nodes := []nodepool.Node{{
+		HTTPAddress: wecommon.HTTPProtocol + config.NodeRPCHTTPAddress,
+		WSAddress:   wecommon.WSProtocol + config.NodeRPCWebsocketAddress,
+	}}
+	additionalNodes, err := nodepool.ParseNodes(config.AdditionalNodes)
+	if err != nil {
+		return nil, err
+	}
+	maxBatchLag := uint64(nodepool.DefaultMaxBatchLag)
+	if config.MaxNodeBatchLag > 0 {
+		maxBatchLag = uint64(config.MaxNodeBatchLag)
+	}
+	return nodepool.New(append(nodes, additionalNodes...), maxBatchLag, logger)
+}
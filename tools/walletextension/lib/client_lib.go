@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"github.com/ten-protocol/go-ten/integration"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/valyala/fasthttp"
@@ -46,51 +48,105 @@ func (o *TGLib) Join() error {
 
 func (o *TGLib) RegisterAccount(pk *ecdsa.PrivateKey, addr gethcommon.Address) error {
 	// create the registration message
-	rawMessageOptions, err := viewingkey.GenerateAuthenticationEIP712RawDataOptions(string(o.userID), integration.TenChainID)
+	signature, err := signEIP712(string(o.userID), pk)
 	if err != nil {
 		return err
 	}
-	if len(rawMessageOptions) == 0 {
-		return fmt.Errorf("GenerateAuthenticationEIP712RawDataOptions returned 0 options")
+	payload := fmt.Sprintf("{\"signature\": \"%s\", \"address\": \"%s\"}", signature, addr.Hex())
+
+	// issue the registration message
+	r, err := o.post(o.httpURL+"/v1/authenticate/?token="+string(o.userID), payload)
+	if err != nil {
+		return err
 	}
+	if string(r) != "success" {
+		return fmt.Errorf("expected success, got %s", string(r))
+	}
+	return nil
+}
 
-	messageHash := crypto.Keccak256(rawMessageOptions[0])
-	sig, err := crypto.Sign(messageHash, pk)
+// RefreshSession extends the session of the user, signing its refresh challenge with the key of a registered account
+func (o *TGLib) RefreshSession(pk *ecdsa.PrivateKey) error {
+	sessions, err := o.get(o.httpURL + "/v1/sessions/?token=" + string(o.userID))
 	if err != nil {
-		return fmt.Errorf("failed to sign message: %w", err)
+		return err
+	}
+	var sessionInfos []struct {
+		Current          bool          `json:"current"`
+		RefreshChallenge hexutil.Bytes `json:"refreshChallenge"`
+	}
+	if err = json.Unmarshal(sessions, &sessionInfos); err != nil {
+		return fmt.Errorf("unable to parse sessions %s - %w", string(sessions), err)
+	}
+	var challenge hexutil.Bytes
+	for _, session := range sessionInfos {
+		if session.Current {
+			challenge = session.RefreshChallenge
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no refresh challenge for the session")
 	}
-	sig[64] += 27
-	signature := "0x" + hex.EncodeToString(sig)
-	payload := fmt.Sprintf("{\"signature\": \"%s\", \"address\": \"%s\"}", signature, addr.Hex())
 
-	// issue the registration message
-	req, err := http.NewRequestWithContext(
-		context.Background(),
-		http.MethodPost,
-		o.httpURL+"/v1/authenticate/?token="+string(o.userID),
-		strings.NewReader(payload),
-	)
+	signature, err := signEIP712(hex.EncodeToString(challenge), pk)
+	if err != nil {
+		return err
+	}
+	payload := fmt.Sprintf("{\"signature\": \"%s\"}", signature)
+	r, err := o.post(o.httpURL+"/v1/session/refresh/?token="+string(o.userID), payload)
 	if err != nil {
-		return fmt.Errorf("unable to create request - %w", err)
+		return err
 	}
+	if !strings.Contains(string(r), "expiresAt") {
+		return fmt.Errorf("unable to refresh session - %s", string(r))
+	}
+	return nil
+}
 
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+func (o *TGLib) get(url string) ([]byte, error) {
+	statusCode, body, err := fasthttp.Get(nil, url)
+	if err != nil || statusCode != 200 {
+		return nil, fmt.Errorf("request failed. Status code: %d, err: %v", statusCode, err)
+	}
+	return body, nil
+}
 
-	client := &http.Client{}
-	response, err := client.Do(req)
+func (o *TGLib) post(url string, payload string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(payload))
 	if err != nil {
-		return fmt.Errorf("unable to issue request - %w", err)
+		return nil, fmt.Errorf("unable to create request - %w", err)
 	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
+	response, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to issue request - %w", err)
+	}
 	defer response.Body.Close()
 	r, err := io.ReadAll(response.Body)
 	if err != nil {
-		return fmt.Errorf("unable to read response - %w", err)
+		return nil, fmt.Errorf("unable to read response - %w", err)
 	}
-	if string(r) != "success" {
-		return fmt.Errorf("expected success, got %s", string(r))
+	return r, nil
+}
+
+// signEIP712 returns the hex EIP-712 authentication signature of the (hex) encryption token
+func signEIP712(token string, pk *ecdsa.PrivateKey) (string, error) {
+	rawMessageOptions, err := viewingkey.GenerateAuthenticationEIP712RawDataOptions(token, integration.TenChainID)
+	if err != nil {
+		return "", err
 	}
-	return nil
+	if len(rawMessageOptions) == 0 {
+		return "", fmt.Errorf("GenerateAuthenticationEIP712RawDataOptions returned 0 options")
+	}
+
+	messageHash := crypto.Keccak256(rawMessageOptions[0])
+	sig, err := crypto.Sign(messageHash, pk)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+	sig[64] += 27
+	return "0x" + hex.EncodeToString(sig), nil
 }
 
 func (o *TGLib) HTTP() string {
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
 	"github.com/ten-protocol/go-ten/tools/walletextension/container"
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 )
@@ -24,21 +26,22 @@ func main() {
 	fmt.Printf("Welcome to the Obscuro wallet extension. \n\n")
 	fmt.Printf("Starting with following config: \n%s\n", string(jsonConfig))
 
-	// We wait thirty seconds for a connection to the node. If we cannot establish one, we exit the program.
-	fmt.Printf("Waiting up to thirty seconds for connection to host at %s...\n", config.NodeRPCWebsocketAddress)
+	// We wait thirty seconds for a connection to one of the nodes. If we cannot establish one, we exit the program.
+	nodeAddrs := []string{config.NodeRPCWebsocketAddress}
+	additionalNodes, err := nodepool.ParseNodes(config.AdditionalNodes)
+	if err != nil {
+		fmt.Printf("Exiting. Invalid additional nodes. Cause: %s\n", err)
+		return
+	}
+	for _, node := range additionalNodes {
+		nodeAddrs = append(nodeAddrs, strings.TrimPrefix(node.WSAddress, common.WSProtocol))
+	}
+	fmt.Printf("Waiting up to thirty seconds for connection to a host at %s...\n", strings.Join(nodeAddrs, ", "))
 	counter := 30
-	for {
-		conn, err := net.Dial(tcp, config.NodeRPCWebsocketAddress)
-		if conn != nil {
-			conn.Close()
-		}
-		if err == nil {
-			break
-		}
-
+	for !canConnectToAny(nodeAddrs) {
 		counter--
 		if counter <= 0 {
-			fmt.Printf("Exiting. Could not establish connection to host at %s. Cause: %s\n", config.NodeRPCWebsocketAddress, err)
+			fmt.Printf("Exiting. Could not establish connection to a host at %s.\n", strings.Join(nodeAddrs, ", "))
 			return
 		}
 		time.Sleep(time.Second)
@@ -61,7 +64,7 @@ func main() {
 	walletExtContainer := container.NewWalletExtensionContainerFromConfig(config, logger)
 
 	// Start the wallet extension.
-	err := walletExtContainer.Start()
+	err = walletExtContainer.Start()
 	if err != nil {
 		fmt.Printf("error in WE - %s", err)
 	}
@@ -72,3 +75,17 @@ func main() {
 
 	select {}
 }
+
+// canConnectToAny returns whether any of the addresses accepts connections
+func canConnectToAny(addrs []string) bool {
+	for _, addr := range addrs {
+		conn, err := net.Dial(tcp, addr)
+		if conn != nil {
+			conn.Close()
+		}
+		if err == nil {
+			return true
+		}
+	}
+	return false
+}
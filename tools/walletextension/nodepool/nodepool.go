@@ -0,0 +1,299 @@
+package nodepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+)
+
+const (
+	healthCheckInterval = 5 * time.Second
+	healthCheckTimeout  = 3 * time.Second
+
+	// DefaultMaxBatchLag is the number of batches a node can be behind the most up-to-date node and still serve reads
+	DefaultMaxBatchLag = 5
+)
+
+// Node is the address of a TEN node the gateway proxies the requests to
+type Node struct {
+	HTTPAddress string // e.g. http://127.0.0.1:80
+	WSAddress   string // e.g. ws://127.0.0.1:81
+}
+
+// ParseNodes parses comma-separated `<host>:<http port>:<ws port>` entries
+func ParseNodes(value string) ([]Node, error) {
+	var nodes []Node
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid node %q, expected <host>:<http port>:<ws port>", entry)
+		}
+		for _, port := range parts[1:] {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				return nil, fmt.Errorf("invalid port in node %q", entry)
+			}
+		}
+		nodes = append(nodes, Node{
+			HTTPAddress: wecommon.HTTPProtocol + parts[0] + ":" + parts[1],
+			WSAddress:   wecommon.WSProtocol + parts[0] + ":" + parts[2],
+		})
+	}
+	return nodes, nil
+}
+
+type nodeState struct {
+	Node
+	client  rpc.Client // over HTTP, shared by all the requests routed to the node
+	healthy bool
+	height  uint64 // the number of the head batch of the node
+}
+
+// Pool balances the requests over the healthy, in-sync nodes. The nodes are health-checked with `obscuro_health` and
+// their batch height, and a node that can't be reached is taken out of the pool until its next successful check.
+type Pool struct {
+	nodes       []*nodeState
+	maxBatchLag uint64
+	next        atomic.Uint64 // the node the next request starts from, to spread the requests
+	lock        sync.RWMutex
+	logger      gethlog.Logger
+}
+
+func New(nodes []Node, maxBatchLag uint64, logger gethlog.Logger) (*Pool, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no nodes configured")
+	}
+	pool := &Pool{maxBatchLag: maxBatchLag, logger: logger}
+	for _, node := range nodes {
+		client, err := rpc.NewNetworkClient(node.HTTPAddress)
+		if err != nil {
+			return nil, err
+		}
+		// the nodes are used until the first health check says otherwise
+		pool.nodes = append(pool.nodes, &nodeState{Node: node, client: client, healthy: true})
+	}
+	return pool, nil
+}
+
+// Start health-checks the nodes in the background, until the stop control is stopped
+func (p *Pool) Start(stopControl *stopcontrol.StopControl) {
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			p.checkHealth()
+			select {
+			case <-ticker.C:
+			case <-stopControl.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop closes the connections to the nodes
+func (p *Pool) Stop() {
+	for _, node := range p.nodes {
+		node.client.Stop()
+	}
+}
+
+// Client returns a client that routes each request to a healthy node
+func (p *Pool) Client() rpc.Client {
+	return &poolClient{pool: p}
+}
+
+// NewSubscriptionClient connects to a healthy node over websockets. The subscriptions are tied to the node they were
+// made on, so it also returns the node, which can be checked with IsHealthy.
+func (p *Pool) NewSubscriptionClient() (rpc.Client, string, error) {
+	var err error
+	for _, node := range p.candidates(false) {
+		var client rpc.Client
+		client, err = rpc.NewNetworkClient(node.WSAddress)
+		if err == nil {
+			return client, node.WSAddress, nil
+		}
+		p.markUnhealthy(node, err)
+	}
+	return nil, "", fmt.Errorf("could not connect to any node - %w", err)
+}
+
+// NodeClient returns a client to a single healthy node over HTTP, for the requests that must all go to the same node
+// (e.g. the polling filters, which are kept by the node they are created on)
+func (p *Pool) NodeClient() rpc.Client {
+	return &nodeClient{Client: p.candidates(false)[0].client}
+}
+
+// IsHealthy returns whether the requests can still be routed to the node (as returned by NewSubscriptionClient), i.e.
+// whether it is healthy and in sync, or no node is
+func (p *Pool) IsHealthy(node string) bool {
+	for _, candidate := range p.eligible(false) {
+		if candidate.WSAddress == node {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the eligible nodes for a request, starting from a different node each time
+func (p *Pool) candidates(upToDate bool) []*nodeState {
+	candidates := p.eligible(upToDate)
+	start := int(p.next.Add(1) % uint64(len(candidates)))
+	ordered := make([]*nodeState, 0, len(candidates))
+	return append(append(ordered, candidates[start:]...), candidates[:start]...)
+}
+
+// eligible returns the nodes a request can be routed to. The reads go to the healthy nodes at most maxBatchLag batches
+// behind, and the transactions only to the most up-to-date nodes, which are the ones expected to gossip them promptly.
+// If no node is healthy, all of them are tried.
+func (p *Pool) eligible(upToDate bool) []*nodeState {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	var maxHeight uint64
+	for _, node := range p.nodes {
+		if node.healthy && node.height > maxHeight {
+			maxHeight = node.height
+		}
+	}
+	lag := p.maxBatchLag
+	if upToDate {
+		lag = 0
+	}
+	var candidates []*nodeState
+	for _, node := range p.nodes {
+		if node.healthy && node.height+lag >= maxHeight {
+			candidates = append(candidates, node)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = append(candidates, p.nodes...)
+	}
+	return candidates
+}
+
+func (p *Pool) checkHealth() {
+	var wg sync.WaitGroup
+	for _, node := range p.nodes {
+		wg.Add(1)
+		go func(node *nodeState) {
+			defer wg.Done()
+			height, err := checkNode(node.client)
+
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			if err != nil {
+				if node.healthy {
+					p.logger.Warn("Node is unhealthy, taking it out of the pool", "node", node.HTTPAddress, log.ErrKey, err)
+				}
+				node.healthy = false
+				return
+			}
+			if !node.healthy {
+				p.logger.Info("Node is healthy, adding it back to the pool", "node", node.HTTPAddress, "height", height)
+			}
+			node.healthy = true
+			node.height = height
+		}(node)
+	}
+	wg.Wait()
+}
+
+// checkNode returns the batch height of the node, or an error if the node is not healthy
+func checkNode(client rpc.Client) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	var health *host.HealthCheck
+	if err := client.CallContext(ctx, &health, rpc.Health); err != nil {
+		return 0, err
+	}
+	if health == nil || !health.OverallHealth {
+		return 0, fmt.Errorf("node reports it is unhealthy")
+	}
+	var height hexutil.Uint64
+	if err := client.CallContext(ctx, &height, rpc.BatchNumber); err != nil {
+		return 0, err
+	}
+	return uint64(height), nil
+}
+
+func (p *Pool) markUnhealthy(node *nodeState, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if node.healthy {
+		p.logger.Warn("Node could not be reached, taking it out of the pool", "node", node.HTTPAddress, log.ErrKey, err)
+	}
+	node.healthy = false
+}
+
+// isNodeFailure returns whether the error means the node could not serve the request (e.g. it is down), in which case
+// the request can be retried on another node. The errors returned by the node itself are not.
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var httpErr gethrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, gethrpc.ErrClientQuit)
+}
+
+// poolClient is an rpc.Client that routes each request to a healthy node of the pool, retrying the request on the next
+// node if a node fails
+type poolClient struct {
+	pool *Pool
+}
+
+func (c *poolClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+func (c *poolClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var err error
+	// a transaction is identified by its hash, so it is safe to resubmit it to another node
+	for _, node := range c.pool.candidates(method == rpc.SendRawTransaction) {
+		err = node.client.CallContext(ctx, result, method, args...)
+		if !isNodeFailure(err) || ctx.Err() != nil {
+			return err
+		}
+		c.pool.markUnhealthy(node, err)
+	}
+	return err
+}
+
+func (c *poolClient) Subscribe(context.Context, interface{}, string, interface{}, ...interface{}) (*gethrpc.ClientSubscription, error) {
+	return nil, errors.New("subscriptions are tied to a single node, use a client from NewSubscriptionClient")
+}
+
+// Stop does nothing, the connections are shared by all the clients of the pool and closed with the pool
+func (c *poolClient) Stop() {}
+
+// nodeClient is the shared client of a node, which is not stopped by its users
+type nodeClient struct {
+	rpc.Client
+}
+
+// Stop does nothing, the connection is shared and closed with the pool
+func (c *nodeClient) Stop() {}
@@ -0,0 +1,118 @@
+package nodepool
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/rpc"
+)
+
+func TestParseNodes(t *testing.T) {
+	nodes, err := ParseNodes("node1:80:81, 10.0.0.2:8080:8081")
+	require.NoError(t, err)
+	require.Equal(t, []Node{
+		{HTTPAddress: "http://node1:80", WSAddress: "ws://node1:81"},
+		{HTTPAddress: "http://10.0.0.2:8080", WSAddress: "ws://10.0.0.2:8081"},
+	}, nodes)
+
+	_, err = ParseNodes("node1:80")
+	require.Error(t, err)
+	_, err = ParseNodes("node1:80:ws")
+	require.Error(t, err)
+}
+
+func TestPool_RoutesToHealthyInSyncNodes(t *testing.T) {
+	upToDate := &fakeClient{name: "upToDate"}
+	lagging := &fakeClient{name: "lagging"}
+	behind := &fakeClient{name: "behind"}
+	down := &fakeClient{name: "down"}
+	pool := newTestPool(2,
+		&nodeState{client: upToDate, healthy: true, height: 100},
+		&nodeState{client: lagging, healthy: true, height: 99},
+		&nodeState{client: behind, healthy: true, height: 90},
+		&nodeState{client: down, healthy: false, height: 100},
+	)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, pool.Client().Call(nil, rpc.ChainID))
+	}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, pool.Client().Call(nil, rpc.SendRawTransaction))
+	}
+	// the reads are spread over the in-sync nodes, and the transactions only go to the most up-to-date node
+	require.Equal(t, 15, upToDate.calls)
+	require.Equal(t, 5, lagging.calls)
+	require.Zero(t, behind.calls)
+	require.Zero(t, down.calls)
+}
+
+func TestPool_RetriesOnAnotherNodeIfNodeFails(t *testing.T) {
+	failing := &fakeClient{name: "failing", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	working := &fakeClient{name: "working"}
+	pool := newTestPool(DefaultMaxBatchLag,
+		&nodeState{client: failing, healthy: true},
+		&nodeState{client: working, healthy: true},
+	)
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, pool.Client().Call(nil, rpc.ChainID))
+	}
+	// the failing node is taken out of the pool after its first failure
+	require.Equal(t, 1, failing.calls)
+	require.Equal(t, 4, working.calls)
+	require.False(t, pool.nodes[0].healthy)
+
+	// the errors of the node itself are returned as they are
+	working.err = &jsonError{message: "execution reverted"}
+	require.ErrorIs(t, pool.Client().Call(nil, rpc.ChainID), working.err)
+	require.True(t, pool.nodes[1].healthy)
+}
+
+func TestPool_TriesAllNodesIfNoneIsHealthy(t *testing.T) {
+	node := &fakeClient{name: "node"}
+	pool := newTestPool(DefaultMaxBatchLag, &nodeState{client: node, healthy: false})
+
+	require.NoError(t, pool.Client().Call(nil, rpc.ChainID))
+	require.Equal(t, 1, node.calls)
+}
+
+func newTestPool(maxBatchLag uint64, nodes ...*nodeState) *Pool {
+	for _, node := range nodes {
+		node.WSAddress = "ws://" + node.client.(*fakeClient).name
+	}
+	return &Pool{nodes: nodes, maxBatchLag: maxBatchLag, logger: gethlog.New()}
+}
+
+type fakeClient struct {
+	name  string
+	calls int
+	err   error
+}
+
+func (c *fakeClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+func (c *fakeClient) CallContext(context.Context, interface{}, string, ...interface{}) error {
+	c.calls++
+	return c.err
+}
+
+func (c *fakeClient) Subscribe(context.Context, interface{}, string, interface{}, ...interface{}) (*gethrpc.ClientSubscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeClient) Stop() {}
+
+// jsonError is an error returned by a node, like the JSON-RPC errors of the geth client
+type jsonError struct {
+	message string
+}
+
+func (e *jsonError) Error() string  { return e.message }
+func (e *jsonError) ErrorCode() int { return -32000 }
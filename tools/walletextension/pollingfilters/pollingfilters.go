@@ -0,0 +1,203 @@
+package pollingfilters
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/subscriptions"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the filters that are not polled for this long are removed, as on the nodes
+const filterTimeout = 5 * time.Minute
+
+// ConnectFunc returns the clients to create a filter with (one per account), all connected to the same node
+type ConnectFunc func() []*rpc.EncRPCClient
+
+// nodeFilter is a filter created on the node for an account of the user
+type nodeFilter struct {
+	client *rpc.EncRPCClient
+	id     string
+}
+
+// userFilter is a polling filter of a user, made of one filter per account on a node
+type userFilter struct {
+	method      string // the method the filter was created with
+	nodeFilters []nodeFilter
+	seen        *subscriptions.CircularBuffer // the changes already returned, which can be returned for several accounts
+	lastPoll    time.Time
+	lock        sync.Mutex // the polls of a filter are done one at a time, so the changes are only returned once
+}
+
+// FilterManager manages the polling filters (eth_newFilter, eth_newBlockFilter and eth_newPendingTransactionFilter)
+// of a user
+type FilterManager struct {
+	filters map[string]*userFilter
+	mu      sync.Mutex
+	logger  gethlog.Logger
+}
+
+func New(logger gethlog.Logger) *FilterManager {
+	return &FilterManager{
+		filters: make(map[string]*userFilter),
+		logger:  logger,
+	}
+}
+
+// NewFilter creates a filter on a node with all the clients returned by connect (only one for the block filters, which
+// are the same for all the accounts), and returns the ID of the filter for the user
+func (fm *FilterManager) NewFilter(connect ConnectFunc, req *wecommon.RPCRequest) (string, error) {
+	fm.removeExpiredFilters()
+
+	clients := connect()
+	if len(clients) == 0 {
+		return "", fmt.Errorf("could not create filter as there are no registered accounts")
+	}
+	if req.Method == rpc.NewBlockFilter {
+		clients = clients[:1]
+	}
+
+	filter := &userFilter{
+		method:   req.Method,
+		seen:     subscriptions.NewCircularBuffer(wecommon.DeduplicationBufferSize),
+		lastPoll: time.Now(),
+	}
+	for _, client := range clients {
+		var id string
+		var err error
+		switch req.Method {
+		case rpc.NewFilter:
+			if len(req.Params) != 1 {
+				return "", fmt.Errorf("one parameter (filter criteria) expected, %d parameters received", len(req.Params))
+			}
+			id, err = client.NewLogFilter(context.Background(), req.Params[0])
+		case rpc.NewPendingTransactionFilter:
+			id, err = client.NewPendingTxFilter(context.Background())
+		default:
+			err = client.Call(&id, rpc.NewBlockFilter)
+		}
+		if err != nil {
+			fm.uninstall(filter)
+			return "", fmt.Errorf("could not call %s. Cause: %w", req.Method, err)
+		}
+		filter.nodeFilters = append(filter.nodeFilters, nodeFilter{client: client, id: id})
+	}
+
+	filterID := string(gethrpc.NewID())
+	fm.mu.Lock()
+	fm.filters[filterID] = filter
+	fm.mu.Unlock()
+	return filterID, nil
+}
+
+// GetFilterChanges returns the changes of the filter since it was last polled, from all its accounts and without
+// duplicates
+func (fm *FilterManager) GetFilterChanges(filterID string) (interface{}, error) {
+	fm.removeExpiredFilters()
+
+	fm.mu.Lock()
+	filter, found := fm.filters[filterID]
+	fm.mu.Unlock()
+	if !found {
+		return nil, fmt.Errorf("filter not found")
+	}
+
+	filter.lock.Lock()
+	defer filter.lock.Unlock()
+	filter.lastPoll = time.Now()
+
+	switch filter.method {
+	case rpc.NewFilter:
+		logs := []*types.Log{}
+		for _, nodeFilter := range filter.nodeFilters {
+			accountLogs, err := nodeFilter.client.GetLogFilterChanges(context.Background(), nodeFilter.id)
+			if err != nil {
+				return nil, err
+			}
+			for _, l := range accountLogs {
+				if filter.isNew(subscriptions.LogKey{BlockHash: l.BlockHash, TxHash: l.TxHash, Index: l.Index}) {
+					logs = append(logs, l)
+				}
+			}
+		}
+		return logs, nil
+
+	case rpc.NewPendingTransactionFilter:
+		txHashes := []gethcommon.Hash{}
+		for _, nodeFilter := range filter.nodeFilters {
+			accountTxHashes, err := nodeFilter.client.GetPendingTxFilterChanges(context.Background(), nodeFilter.id)
+			if err != nil {
+				return nil, err
+			}
+			for _, txHash := range accountTxHashes {
+				if filter.isNew(subscriptions.LogKey{TxHash: txHash}) {
+					txHashes = append(txHashes, txHash)
+				}
+			}
+		}
+		return txHashes, nil
+
+	default:
+		var hashes []gethcommon.Hash
+		if err := filter.nodeFilters[0].client.Call(&hashes, rpc.GetFilterChanges, filter.nodeFilters[0].id); err != nil {
+			return nil, err
+		}
+		return hashes, nil
+	}
+}
+
+// UninstallFilter removes the filter, and returns whether it existed
+func (fm *FilterManager) UninstallFilter(filterID string) bool {
+	fm.mu.Lock()
+	filter, found := fm.filters[filterID]
+	delete(fm.filters, filterID)
+	fm.mu.Unlock()
+
+	if found {
+		fm.uninstall(filter)
+	}
+	return found
+}
+
+// removeExpiredFilters removes the filters that were not polled for the filter timeout. Their node filters have
+// expired too, so they are not uninstalled.
+func (fm *FilterManager) removeExpiredFilters() {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	for id, filter := range fm.filters {
+		filter.lock.Lock()
+		expired := time.Since(filter.lastPoll) > filterTimeout
+		filter.lock.Unlock()
+		if expired {
+			delete(fm.filters, id)
+		}
+	}
+}
+
+// uninstall removes the filters of the user filter from the node
+func (fm *FilterManager) uninstall(filter *userFilter) {
+	for _, nodeFilter := range filter.nodeFilters {
+		var uninstalled bool
+		if err := nodeFilter.client.Call(&uninstalled, rpc.UninstallFilter, nodeFilter.id); err != nil {
+			fm.logger.Debug("Could not uninstall filter", log.SubIDKey, nodeFilter.id, log.ErrKey, err)
+		}
+	}
+}
+
+// isNew returns whether the change was not returned yet, and records it
+func (f *userFilter) isNew(key subscriptions.LogKey) bool {
+	if f.seen.Contains(key) {
+		return false
+	}
+	f.seen.Push(key)
+	return true
+}
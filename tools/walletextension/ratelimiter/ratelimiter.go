@@ -0,0 +1,353 @@
+package ratelimiter
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	// ErrCodeLimitExceeded is the JSON-RPC error code for requests over the limits (EIP-1474)
+	ErrCodeLimitExceeded = -32005
+
+	// the number of users, IPs and user methods whose buckets are kept, the least recently used are forgotten
+	maxTrackedKeys = 100_000
+	quotaWindow    = 24 * time.Hour
+	// how often the quotas whose window ended are forgotten
+	quotaPruneInterval = time.Hour
+	defaultCost        = 1
+
+	// the requests to the gateway endpoints that don't have a user yet are limited by IP like the methods
+	JoinMethod         = "gateway_join"
+	AuthenticateMethod = "gateway_authenticate"
+)
+
+// methodCosts are the default cost weights of the methods that are heavy for the node, the other methods cost 1
+var methodCosts = map[string]int{
+	"eth_getLogs":               10,
+	"debug_traceTransaction":    10,
+	"eth_call":                  5,
+	"eth_estimateGas":           5,
+	"eth_createAccessList":      5,
+	"eth_sendRawTransaction":    5,
+	"eth_getTransactionReceipt": 2,
+	"eth_getStorageAt":          2,
+	JoinMethod:                  10,
+	AuthenticateMethod:          5,
+}
+
+// Limit is the rate of a token bucket, in tokens (the cost of the requests) per second, and its capacity
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// Config holds the limits of the gateway. A zero limit disables it.
+type Config struct {
+	User         Limit            // per user ID
+	IP           Limit            // per client IP
+	Methods      map[string]Limit // per user (or IP for requests without a user) and method
+	MethodCosts  map[string]int   // overrides the default cost weights of the methods
+	UserDayQuota int              // the total cost a user can spend per day
+}
+
+// ParseMethodLimits parses comma-separated `<method>:<rate>:<burst>` entries
+func ParseMethodLimits(value string) (map[string]Limit, error) {
+	limits := map[string]Limit{}
+	for _, entry := range splitEntries(value) {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid method limit %q, expected <method>:<rate>:<burst>", entry)
+		}
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate in method limit %q", entry)
+		}
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid burst in method limit %q", entry)
+		}
+		limits[parts[0]] = Limit{Rate: rate, Burst: burst}
+	}
+	return limits, nil
+}
+
+// ParseMethodCosts parses comma-separated `<method>:<cost>` entries
+func ParseMethodCosts(value string) (map[string]int, error) {
+	costs := map[string]int{}
+	for _, entry := range splitEntries(value) {
+		method, costStr, found := strings.Cut(entry, ":")
+		cost, err := strconv.Atoi(costStr)
+		if !found || err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid method cost %q, expected <method>:<cost>", entry)
+		}
+		costs[method] = cost
+	}
+	return costs, nil
+}
+
+func splitEntries(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// LimitExceededError is returned for requests over the limits
+type LimitExceededError struct {
+	Scope      string // the limit that was hit: user, ip, method or quota
+	RetryAfter time.Duration
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.Scope == scopeQuota {
+		return "daily request quota exceeded"
+	}
+	return fmt.Sprintf("rate limit exceeded (%s), retry in %s", e.Scope, e.RetryAfter.Round(time.Millisecond))
+}
+
+// ErrorCode returns the JSON-RPC error code of the error
+func (e *LimitExceededError) ErrorCode() int {
+	return ErrCodeLimitExceeded
+}
+
+// ErrorData returns the data of the JSON-RPC error
+func (e *LimitExceededError) ErrorData() interface{} {
+	return map[string]interface{}{
+		"scope":      e.Scope,
+		"retryAfter": math.Ceil(e.RetryAfter.Seconds()),
+	}
+}
+
+const (
+	scopeUser   = "user"
+	scopeIP     = "ip"
+	scopeMethod = "method"
+	scopeQuota  = "quota"
+)
+
+// RateLimiter throttles the requests of the users with token buckets. Each request takes the cost of its method from
+// the buckets of its user, its IP and its user method, and is only allowed if all of them have enough tokens.
+type RateLimiter struct {
+	config Config
+	now    func() time.Time
+
+	lock    sync.Mutex
+	buckets *lru.Cache[string, *bucket]
+	// the quotas are only forgotten once their window ended, otherwise users could reset them by making the limiter
+	// track other keys
+	quotas         map[string]*quota
+	quotasPrunedAt time.Time
+
+	allowed gethmetrics.Meter
+	hits    map[string]gethmetrics.Meter // by scope
+	// the limit hits by method, registered as they happen (the unknown methods share a meter, so users can't create
+	// meters at will)
+	methodHits     map[string]gethmetrics.Meter
+	metricRegistry gethmetrics.Registry
+}
+
+func New(config Config, metricRegistry gethmetrics.Registry) *RateLimiter {
+	buckets, _ := lru.New[string, *bucket](maxTrackedKeys)
+	hits := map[string]gethmetrics.Meter{}
+	for _, scope := range []string{scopeUser, scopeIP, scopeMethod, scopeQuota} {
+		hits[scope] = gethmetrics.GetOrRegisterMeter("gateway/ratelimit/hits/"+scope, metricRegistry)
+	}
+	return &RateLimiter{
+		config:         config,
+		now:            time.Now,
+		buckets:        buckets,
+		quotas:         map[string]*quota{},
+		allowed:        gethmetrics.GetOrRegisterMeter("gateway/ratelimit/allowed", metricRegistry),
+		hits:           hits,
+		methodHits:     map[string]gethmetrics.Meter{},
+		metricRegistry: metricRegistry,
+	}
+}
+
+// Allow takes the cost of the request from the limits of the user, the IP and the method. The user is empty for
+// requests without a user ID. It returns a LimitExceededError if the request is over the limits.
+func (r *RateLimiter) Allow(userID string, ip string, method string) error {
+	cost := r.cost(method)
+	if cost == 0 {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+
+	// the requests without a user share the limits of their IP
+	methodKey := "ip/" + ip
+	if userID != "" {
+		methodKey = "user/" + userID
+	}
+
+	type take struct {
+		scope  string
+		bucket *bucket
+		cost   float64
+	}
+	var takes []take
+	if userID != "" && r.config.User.enabled() {
+		takes = append(takes, take{scopeUser, r.bucket("user/"+userID, r.config.User), bucketCost(cost, r.config.User)})
+	}
+	if ip != "" && r.config.IP.enabled() {
+		takes = append(takes, take{scopeIP, r.bucket("ip/"+ip, r.config.IP), bucketCost(cost, r.config.IP)})
+	}
+	if limit, found := r.config.Methods[method]; found && limit.enabled() {
+		takes = append(takes, take{scopeMethod, r.bucket("method/"+method+"/"+methodKey, limit), bucketCost(cost, limit)})
+	}
+
+	// nothing is taken unless the request is allowed by all the limits
+	for _, t := range takes {
+		if wait := t.bucket.wait(t.cost, now); wait > 0 {
+			r.hit(t.scope, method)
+			return &LimitExceededError{Scope: t.scope, RetryAfter: wait}
+		}
+	}
+	var userQuota *quota
+	if userID != "" && r.config.UserDayQuota > 0 {
+		userQuota = r.quota(userID, now)
+		if userQuota.used+cost > r.config.UserDayQuota {
+			r.hit(scopeQuota, method)
+			return &LimitExceededError{Scope: scopeQuota, RetryAfter: userQuota.resetAt.Sub(now)}
+		}
+	}
+
+	for _, t := range takes {
+		t.bucket.tokens -= t.cost
+	}
+	if userQuota != nil {
+		userQuota.used += cost
+	}
+	r.allowed.Mark(1)
+	return nil
+}
+
+func (r *RateLimiter) cost(method string) int {
+	if cost, found := r.config.MethodCosts[method]; found {
+		return cost
+	}
+	if cost, found := methodCosts[method]; found {
+		return cost
+	}
+	return defaultCost
+}
+
+func (r *RateLimiter) isKnownMethod(method string) bool {
+	_, isConfigured := r.config.Methods[method]
+	_, hasConfiguredCost := r.config.MethodCosts[method]
+	_, hasCost := methodCosts[method]
+	return isConfigured || hasConfiguredCost || hasCost
+}
+
+// must be called with the lock held
+func (r *RateLimiter) bucket(key string, limit Limit) *bucket {
+	b, found := r.buckets.Get(key)
+	if !found {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updatedAt: r.now()}
+		r.buckets.Add(key, b)
+	}
+	return b
+}
+
+// must be called with the lock held
+func (r *RateLimiter) quota(userID string, now time.Time) *quota {
+	if now.Sub(r.quotasPrunedAt) >= quotaPruneInterval {
+		for id, q := range r.quotas {
+			if !now.Before(q.resetAt) {
+				delete(r.quotas, id)
+			}
+		}
+		r.quotasPrunedAt = now
+	}
+
+	q, found := r.quotas[userID]
+	if !found || !now.Before(q.resetAt) {
+		q = &quota{resetAt: now.Add(quotaWindow)}
+		r.quotas[userID] = q
+	}
+	return q
+}
+
+// must be called with the lock held
+func (r *RateLimiter) hit(scope string, method string) {
+	r.hits[scope].Mark(1)
+	if !r.isKnownMethod(method) {
+		method = "other"
+	}
+	meter, found := r.methodHits[method]
+	if !found {
+		meter = gethmetrics.GetOrRegisterMeter("gateway/ratelimit/hits/method/"+method, r.metricRegistry)
+		r.methodHits[method] = meter
+	}
+	meter.Mark(1)
+}
+
+// a request costing more than the capacity of a bucket takes the whole bucket, so it can still be served
+func bucketCost(cost int, limit Limit) float64 {
+	if cost > limit.Burst {
+		return float64(limit.Burst)
+	}
+	return float64(cost)
+}
+
+type bucket struct {
+	limit     Limit
+	tokens    float64
+	updatedAt time.Time
+}
+
+// wait refills the bucket, and returns how long to wait until it holds the cost (0 if it already does)
+func (b *bucket) wait(cost float64, now time.Time) time.Duration {
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed.Seconds()*b.limit.Rate)
+		b.updatedAt = now
+	}
+	if b.tokens >= cost {
+		return 0
+	}
+	return time.Duration((cost - b.tokens) / b.limit.Rate * float64(time.Second))
+}
+
+// quota is the cost a user spent in the current window
+type quota struct {
+	used    int
+	resetAt time.Time
+}
+
+// ClientIP returns the IP of the client of the request. The X-Forwarded-For header can be set by the clients, so it is
+// only used if the gateway runs behind a proxy that sets it.
+func ClientIP(req *http.Request, trustForwardedFor bool) string {
+	if req == nil {
+		return ""
+	}
+	if trustForwardedFor {
+		// the proxy appends the address it received the request from, so the last entry is the one it vouches for
+		if forwardedFor := req.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+			addrs := strings.Split(forwardedFor, ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,128 @@
+package ratelimiter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter, clock := newTestLimiter(Config{
+		User:    Limit{Rate: 10, Burst: 20},
+		IP:      Limit{Rate: 100, Burst: 100},
+		Methods: map[string]Limit{"eth_getLogs": {Rate: 1, Burst: 20}},
+	})
+
+	// eth_getLogs costs 10, so the user's bucket holds two of them
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_getLogs"))
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_getLogs"))
+	err := limiter.Allow("user1", "1.1.1.1", "eth_chainId")
+	requireLimitExceeded(t, err, scopeUser, 100*time.Millisecond)
+
+	// other users are not affected
+	require.NoError(t, limiter.Allow("user2", "1.1.1.1", "eth_chainId"))
+
+	// the user's bucket refills, but the method bucket refills slower
+	clock.advance(time.Second)
+	err = limiter.Allow("user1", "1.1.1.1", "eth_getLogs")
+	requireLimitExceeded(t, err, scopeMethod, 9*time.Second)
+	// the rejected request didn't take tokens from the other buckets
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_chainId"))
+	}
+}
+
+func TestRateLimiter_RequestsWithoutUserShareIPLimit(t *testing.T) {
+	limiter, _ := newTestLimiter(Config{
+		User: Limit{Rate: 1, Burst: 1},
+		IP:   Limit{Rate: 1, Burst: 2},
+	})
+
+	require.NoError(t, limiter.Allow("", "1.1.1.1", "eth_chainId"))
+	require.NoError(t, limiter.Allow("", "1.1.1.1", "eth_chainId"))
+	requireLimitExceeded(t, limiter.Allow("", "1.1.1.1", "eth_chainId"), scopeIP, time.Second)
+	require.NoError(t, limiter.Allow("", "2.2.2.2", "eth_chainId"))
+}
+
+func TestRateLimiter_DailyQuota(t *testing.T) {
+	limiter, clock := newTestLimiter(Config{
+		MethodCosts:  map[string]int{"eth_call": 50},
+		UserDayQuota: 100,
+	})
+
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_call"))
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_call"))
+	clock.advance(time.Hour)
+	requireLimitExceeded(t, limiter.Allow("user1", "1.1.1.1", "eth_call"), scopeQuota, 23*time.Hour)
+
+	clock.advance(23 * time.Hour)
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_call"))
+}
+
+func TestRateLimiter_DailyQuotaIsKeptWhileOtherUsersAreTracked(t *testing.T) {
+	limiter, clock := newTestLimiter(Config{
+		User:         Limit{Rate: 1, Burst: 1},
+		UserDayQuota: 1,
+	})
+
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_chainId"))
+	// the buckets of user1 are evicted by the requests of the other users, but not its quota
+	for i := 0; i <= maxTrackedKeys; i++ {
+		require.NoError(t, limiter.Allow(fmt.Sprintf("other%d", i), "", "eth_chainId"))
+	}
+	clock.advance(time.Hour)
+	requireLimitExceeded(t, limiter.Allow("user1", "1.1.1.1", "eth_chainId"), scopeQuota, 23*time.Hour)
+
+	// the quotas are forgotten once their window ended
+	clock.advance(23 * time.Hour)
+	require.NoError(t, limiter.Allow("user1", "1.1.1.1", "eth_chainId"))
+	require.Len(t, limiter.quotas, 1)
+}
+
+func TestRateLimiter_GatewayEndpointsAreLimitedByIP(t *testing.T) {
+	limiter, _ := newTestLimiter(Config{
+		IP: Limit{Rate: 1, Burst: 15},
+	})
+
+	require.NoError(t, limiter.Allow("", "1.1.1.1", JoinMethod))
+	requireLimitExceeded(t, limiter.Allow("", "1.1.1.1", JoinMethod), scopeIP, 5*time.Second)
+	require.NoError(t, limiter.Allow("", "1.1.1.1", AuthenticateMethod))
+	require.NoError(t, limiter.Allow("", "2.2.2.2", JoinMethod))
+}
+
+func TestParseMethodLimits(t *testing.T) {
+	limits, err := ParseMethodLimits("eth_getLogs:0.5:10, eth_call:20:40")
+	require.NoError(t, err)
+	require.Equal(t, map[string]Limit{"eth_getLogs": {Rate: 0.5, Burst: 10}, "eth_call": {Rate: 20, Burst: 40}}, limits)
+
+	_, err = ParseMethodLimits("eth_getLogs:10")
+	require.Error(t, err)
+	_, err = ParseMethodCosts("eth_getLogs:-1")
+	require.Error(t, err)
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(config Config) (*RateLimiter, *testClock) {
+	clock := &testClock{now: time.Unix(1_700_000_000, 0)}
+	limiter := New(config, gethmetrics.NewRegistry())
+	limiter.now = func() time.Time { return clock.now }
+	return limiter, clock
+}
+
+func requireLimitExceeded(t *testing.T, err error, scope string, retryAfter time.Duration) {
+	var limitErr *LimitExceededError
+	require.True(t, errors.As(err, &limitErr), "expected a LimitExceededError, got %v", err)
+	require.Equal(t, scope, limitErr.Scope)
+	require.Equal(t, retryAfter, limitErr.RetryAfter)
+}
@@ -0,0 +1,305 @@
+package walletextension
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	sessionIDLen = 20
+	// the length of the session IDs in the list of sessions, which is not enough to use them
+	listedSessionIDLen = 8
+	// how often the expired sessions are deleted from the storage
+	sessionGCInterval = 10 * time.Minute
+)
+
+// ErrInvalidSession is returned for the requests made with an unknown or expired session token
+var ErrInvalidSession = errors.New("unknown or expired session token, refresh the session or join again")
+
+// SessionInfo describes an active session of a user, without revealing its token
+type SessionInfo struct {
+	ID               string         `json:"id"` // the start of the session token
+	ExpiresAt        time.Time      `json:"expiresAt"`
+	Accounts         []string       `json:"accounts"` // empty if the session can use all the accounts of the user
+	ReadOnly         bool           `json:"readOnly"`
+	Current          bool           `json:"current"`                    // whether it is the session of the request
+	RefreshChallenge *hexutil.Bytes `json:"refreshChallenge,omitempty"` // what to sign to refresh the current session
+}
+
+// newInitialSession returns the session a user gets on joining, which can use all the accounts of the user. Its token
+// is the user ID, so the user ID keeps working as the encryption token.
+func newInitialSession(userID []byte, ttl time.Duration) common.SessionDB {
+	return common.SessionDB{SessionID: userID, UserID: userID, ExpiresAt: time.Now().Add(ttl)}
+}
+
+// SessionTTL returns how long a session is valid for, until it is refreshed
+func (w *WalletExtension) SessionTTL() time.Duration {
+	if w.config.SessionTTL <= 0 {
+		return common.DefaultSessionTTL
+	}
+	return w.config.SessionTTL
+}
+
+// DefaultSession returns the session of the requests made without a session token
+func (w *WalletExtension) DefaultSession() *common.SessionDB {
+	return &common.SessionDB{SessionID: []byte(common.DefaultUser), UserID: []byte(common.DefaultUser)}
+}
+
+// ResolveSession returns the active session with the given hex token
+func (w *WalletExtension) ResolveSession(hexToken string) (*common.SessionDB, error) {
+	sessionID, err := hex.DecodeString(hexToken)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+	session, err := w.storage.GetSession(sessionID)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, ErrInvalidSession
+		}
+		return nil, fmt.Errorf("could not get session - %w", err)
+	}
+	if !session.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidSession
+	}
+	return session, nil
+}
+
+// AddInitialSessions gives the users that joined before sessions were introduced an initial session
+func (w *WalletExtension) AddInitialSessions(users []common.UserDB) error {
+	for _, user := range users {
+		if bytes.Equal(user.UserID, []byte(common.DefaultUser)) {
+			continue
+		}
+		sessions, err := w.storage.GetSessions(user.UserID)
+		if err != nil {
+			return fmt.Errorf("could not get sessions of user %x - %w", user.UserID, err)
+		}
+		if len(sessions) > 0 {
+			continue
+		}
+		if err = w.storage.AddSession(newInitialSession(user.UserID, w.SessionTTL())); err != nil {
+			return fmt.Errorf("could not add session for user %x - %w", user.UserID, err)
+		}
+	}
+	return nil
+}
+
+// CreateSession creates a session for the user of the given session, which can only be narrower than it: restricted
+// to some of its accounts, read-only if it is, and expiring no later than it. It returns the hex token of the session.
+func (w *WalletExtension) CreateSession(parent *common.SessionDB, accounts []gethcommon.Address, readOnly bool, ttl time.Duration) (string, error) {
+	if ttl <= 0 || ttl > w.SessionTTL() {
+		ttl = w.SessionTTL()
+	}
+	expiresAt := time.Now().Add(ttl)
+	if expiresAt.After(parent.ExpiresAt) {
+		expiresAt = parent.ExpiresAt
+	}
+
+	registered, err := w.storage.GetAccounts(parent.UserID)
+	if err != nil {
+		return "", fmt.Errorf("could not get accounts of user - %w", err)
+	}
+	scope := make([][]byte, 0, len(accounts))
+	for _, account := range accounts {
+		if !isRegisteredAccount(registered, account) || !sessionAllowsAccount(parent, account) {
+			return "", fmt.Errorf("account %s is not available to the session", account.Hex())
+		}
+		scope = append(scope, account.Bytes())
+	}
+	if len(scope) == 0 {
+		scope = parent.Accounts
+	}
+
+	sessionID := make([]byte, sessionIDLen)
+	if _, err = rand.Read(sessionID); err != nil {
+		return "", fmt.Errorf("could not generate session token - %w", err)
+	}
+	err = w.storage.AddSession(common.SessionDB{
+		SessionID: sessionID,
+		UserID:    parent.UserID,
+		ExpiresAt: expiresAt,
+		Accounts:  scope,
+		ReadOnly:  parent.ReadOnly || readOnly,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not store session - %w", err)
+	}
+	return hex.EncodeToString(sessionID), nil
+}
+
+// RefreshSession extends the session with the given hex token by the session TTL. It requires an EIP-712 signature of
+// the session's refresh challenge by one of the accounts the session can use.
+func (w *WalletExtension) RefreshSession(hexToken string, signature []byte) (time.Time, error) {
+	session, err := w.ResolveSession(hexToken)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	address, err := viewingkey.CheckEIP712Signature(hex.EncodeToString(refreshChallenge(session)), signature, int64(w.config.TenChainID))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("signature is not valid: %w", err)
+	}
+	registered, err := w.storage.GetAccounts(session.UserID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not get accounts of user - %w", err)
+	}
+	if !isRegisteredAccount(registered, *address) || !sessionAllowsAccount(session, *address) {
+		return time.Time{}, fmt.Errorf("account %s is not available to the session", address.Hex())
+	}
+
+	// the new expiry also changes the challenge, so the signature can't be reused
+	session.ExpiresAt = time.Now().Add(w.SessionTTL())
+	if err = w.storage.AddSession(*session); err != nil {
+		return time.Time{}, fmt.Errorf("could not refresh session - %w", err)
+	}
+	return session.ExpiresAt, nil
+}
+
+// ListSessions returns the active sessions of the user of the given session
+func (w *WalletExtension) ListSessions(current *common.SessionDB) ([]SessionInfo, error) {
+	sessions, err := w.storage.GetSessions(current.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sessions - %w", err)
+	}
+
+	now := time.Now()
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.ExpiresAt.After(now) {
+			continue
+		}
+		info := SessionInfo{
+			ID:        hex.EncodeToString(session.SessionID)[:listedSessionIDLen],
+			ExpiresAt: session.ExpiresAt,
+			Accounts:  make([]string, 0, len(session.Accounts)),
+			ReadOnly:  session.ReadOnly,
+			Current:   bytes.Equal(session.SessionID, current.SessionID),
+		}
+		for _, account := range session.Accounts {
+			info.Accounts = append(info.Accounts, gethcommon.BytesToAddress(account).Hex())
+		}
+		if info.Current {
+			challenge := hexutil.Bytes(refreshChallenge(&session))
+			info.RefreshChallenge = &challenge
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// checkSessionAllowsRequest returns an error if the session is not allowed to make the request, i.e. if it sends a
+// transaction from a read-only session or from an account out of its scope
+func (w *WalletExtension) checkSessionAllowsRequest(session *common.SessionDB, request *common.RPCRequest) error {
+	if request.Method != rpc.SendRawTransaction {
+		return nil
+	}
+	if session.ReadOnly {
+		return errors.New("the session is read-only and can't send transactions")
+	}
+	if len(session.Accounts) == 0 {
+		return nil
+	}
+
+	if len(request.Params) != 1 {
+		return fmt.Errorf("one parameter (raw transaction) expected, %d parameters received", len(request.Params))
+	}
+	rawTx, ok := request.Params[0].(string)
+	if !ok {
+		return fmt.Errorf("raw transaction needs to be a string. Got: %v", request.Params[0])
+	}
+	txBytes, err := hexutil.Decode(rawTx)
+	if err != nil {
+		return fmt.Errorf("could not decode raw transaction - %w", err)
+	}
+	var tx types.Transaction
+	if err = tx.UnmarshalBinary(txBytes); err != nil {
+		return fmt.Errorf("could not decode raw transaction - %w", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(int64(w.config.TenChainID))), &tx)
+	if err != nil {
+		return fmt.Errorf("could not recover transaction sender - %w", err)
+	}
+	if !sessionAllowsAccount(session, sender) {
+		return fmt.Errorf("the session can't send transactions from account %s", sender.Hex())
+	}
+	return nil
+}
+
+// collectExpiredSessions deletes the expired sessions from the storage periodically, until the gateway stops
+func (w *WalletExtension) collectExpiredSessions() {
+	ticker := time.NewTicker(sessionGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			deleted, err := w.storage.DeleteExpiredSessions(time.Now())
+			if err != nil {
+				w.logger.Warn("Could not delete expired sessions", log.ErrKey, err)
+				continue
+			}
+			if deleted > 0 {
+				w.logger.Info("Deleted expired sessions", "count", deleted)
+			}
+		case <-w.stopControl.Done():
+			return
+		}
+	}
+}
+
+// sessionScope returns the accounts the session can use, or nil if it can use all the accounts of the user
+func sessionScope(session *common.SessionDB) []gethcommon.Address {
+	if len(session.Accounts) == 0 {
+		return nil
+	}
+	scope := make([]gethcommon.Address, 0, len(session.Accounts))
+	for _, account := range session.Accounts {
+		scope = append(scope, gethcommon.BytesToAddress(account))
+	}
+	return scope
+}
+
+func sessionAllowsAccount(session *common.SessionDB, account gethcommon.Address) bool {
+	if len(session.Accounts) == 0 {
+		return true
+	}
+	for _, allowed := range session.Accounts {
+		if bytes.Equal(allowed, account.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRegisteredAccount(accounts []common.AccountDB, account gethcommon.Address) bool {
+	for _, registered := range accounts {
+		if bytes.Equal(registered.AccountAddress, account.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshChallenge returns the value to sign (as the encryption token of the EIP-712 authentication message) to refresh
+// the session. It depends on the expiry of the session, so a signature can only refresh the session once.
+func refreshChallenge(session *common.SessionDB) []byte {
+	expiresAt := make([]byte, 8)
+	binary.BigEndian.PutUint64(expiresAt, uint64(session.ExpiresAt.Unix()))
+	return crypto.Keccak256(session.SessionID, expiresAt)[:sessionIDLen]
+}
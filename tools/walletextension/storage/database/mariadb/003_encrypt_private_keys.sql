@@ -0,0 +1,5 @@
+/*
+    This is a migration file for MariaDB that makes room for the private keys of the users, which are stored encrypted
+*/
+
+ALTER TABLE ogdb.users MODIFY private_key varbinary(256);
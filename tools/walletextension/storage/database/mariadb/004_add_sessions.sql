@@ -0,0 +1,15 @@
+/*
+    This is a migration file for MariaDB that creates the sessions table. The sessions expire at the unix time in
+    expires_at, and the accounts they are restricted to are stored concatenated.
+*/
+
+CREATE TABLE IF NOT EXISTS ogdb.sessions (
+    session_id varbinary(20) PRIMARY KEY,
+    user_id varbinary(20),
+    expires_at BIGINT NOT NULL,
+    accounts BLOB,
+    read_only BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY(user_id) REFERENCES ogdb.users(user_id) ON DELETE CASCADE,
+    INDEX sessions_user_id_idx (user_id),
+    INDEX sessions_expires_at_idx (expires_at)
+);
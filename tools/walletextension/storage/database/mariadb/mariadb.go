@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -85,6 +86,12 @@ func (m *MariaDB) GetUserPrivateKey(userID []byte) ([]byte, error) {
 	return privateKey, nil
 }
 
+// UpdateUserPrivateKey replaces the stored private key of an existing user (unlike AddUser it keeps the user's accounts)
+func (m *MariaDB) UpdateUserPrivateKey(userID []byte, privateKey []byte) error {
+	_, err := m.db.Exec("UPDATE users SET private_key = ? WHERE user_id = ?", privateKey, userID)
+	return err
+}
+
 func (m *MariaDB) AddAccount(userID []byte, accountAddress []byte, signature []byte) error {
 	stmt, err := m.db.Prepare("INSERT INTO accounts(user_id, account_address, signature) VALUES (?, ?, ?)")
 	if err != nil {
@@ -146,6 +153,41 @@ func (m *MariaDB) GetAllUsers() ([]common.UserDB, error) {
 	return users, nil
 }
 
+func (m *MariaDB) GetAllUserIDs() ([][]byte, error) {
+	rows, err := m.db.Query("SELECT user_id FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs [][]byte
+	for rows.Next() {
+		var userID []byte
+		if err = rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (m *MariaDB) CountUsers(excludedUserID []byte) (int, error) {
+	var count int
+	err := m.db.QueryRow("SELECT COUNT(*) FROM users WHERE user_id <> ?", excludedUserID).Scan(&count)
+	return count, err
+}
+
+func (m *MariaDB) CountAccounts(excludedUserID []byte) (int, error) {
+	var count int
+	err := m.db.QueryRow("SELECT COUNT(*) FROM accounts WHERE user_id <> ?", excludedUserID).Scan(&count)
+	return count, err
+}
+
 func (m *MariaDB) StoreTransaction(rawTx string, userID []byte) error {
 	stmt, err := m.db.Prepare("INSERT INTO transactions(user_id, tx_hash, tx) VALUES (?, ?, ?)")
 	if err != nil {
@@ -175,3 +217,47 @@ func (m *MariaDB) StoreTransaction(rawTx string, userID []byte) error {
 
 	return nil
 }
+
+// AddSession stores the session, replacing the session with the same ID (e.g. to refresh it)
+func (m *MariaDB) AddSession(session common.SessionDB) error {
+	_, err := m.db.Exec("REPLACE INTO sessions(session_id, user_id, expires_at, accounts, read_only) VALUES (?, ?, ?, ?, ?)",
+		session.SessionID, session.UserID, session.ExpiresAt.Unix(), database.EncodeAccounts(session.Accounts), session.ReadOnly)
+	return err
+}
+
+func (m *MariaDB) GetSession(sessionID []byte) (*common.SessionDB, error) {
+	rows, err := m.db.Query("SELECT session_id, user_id, expires_at, accounts, read_only FROM sessions WHERE session_id = ?", sessionID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := database.ScanSessions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return &sessions[0], nil
+}
+
+func (m *MariaDB) GetSessions(userID []byte) ([]common.SessionDB, error) {
+	rows, err := m.db.Query("SELECT session_id, user_id, expires_at, accounts, read_only FROM sessions WHERE user_id = ?", userID)
+	if err != nil {
+		return nil, err
+	}
+	return database.ScanSessions(rows)
+}
+
+func (m *MariaDB) DeleteSession(sessionID []byte) error {
+	_, err := m.db.Exec("DELETE FROM sessions WHERE session_id = ?", sessionID)
+	return err
+}
+
+// DeleteExpiredSessions deletes the sessions that expired before now, and returns how many were deleted
+func (m *MariaDB) DeleteExpiredSessions(now time.Time) (int64, error) {
+	result, err := m.db.Exec("DELETE FROM sessions WHERE expires_at <= ?", now.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,27 @@
+/*
+    This is a migration file for PostgreSQL and is executed when the Gateway is started to make sure the database schema is up to date.
+    The migrations are run by every gateway instance sharing the database, so they take a lock to run one at a time.
+*/
+
+SELECT pg_advisory_xact_lock(7400001);
+
+CREATE TABLE IF NOT EXISTS users (
+    user_id bytea PRIMARY KEY,
+    private_key bytea
+);
+
+CREATE TABLE IF NOT EXISTS accounts (
+    user_id bytea REFERENCES users(user_id) ON DELETE CASCADE,
+    account_address bytea,
+    signature bytea
+);
+
+CREATE INDEX IF NOT EXISTS accounts_user_id_idx ON accounts(user_id);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id bigserial PRIMARY KEY,
+    user_id bytea,
+    tx_hash text,
+    tx text,
+    tx_time timestamptz DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,17 @@
+/*
+    This is a migration file for PostgreSQL that creates the sessions table. The sessions expire at the unix time in
+    expires_at, and the accounts they are restricted to are stored concatenated.
+*/
+
+SELECT pg_advisory_xact_lock(7400001);
+
+CREATE TABLE IF NOT EXISTS sessions (
+    session_id bytea PRIMARY KEY,
+    user_id bytea REFERENCES users(user_id) ON DELETE CASCADE,
+    expires_at bigint NOT NULL,
+    accounts bytea,
+    read_only boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions(user_id);
+CREATE INDEX IF NOT EXISTS sessions_expires_at_idx ON sessions(expires_at);
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+)
+
+const addressLen = 20
+
+// EncodeAccounts concatenates the account addresses of a session, to store them in a single column
+func EncodeAccounts(accounts [][]byte) []byte {
+	encoded := make([]byte, 0, len(accounts)*addressLen)
+	for _, account := range accounts {
+		encoded = append(encoded, account...)
+	}
+	return encoded
+}
+
+// DecodeAccounts splits the account addresses encoded with EncodeAccounts
+func DecodeAccounts(encoded []byte) [][]byte {
+	var accounts [][]byte
+	for i := 0; i+addressLen <= len(encoded); i += addressLen {
+		accounts = append(accounts, encoded[i:i+addressLen])
+	}
+	return accounts
+}
+
+// ScanSessions reads the sessions selected as `session_id, user_id, expires_at, accounts, read_only`
+func ScanSessions(rows *sql.Rows) ([]common.SessionDB, error) {
+	defer rows.Close()
+
+	var sessions []common.SessionDB
+	for rows.Next() {
+		var session common.SessionDB
+		var expiresAt int64
+		var accounts []byte
+		if err := rows.Scan(&session.SessionID, &session.UserID, &expiresAt, &accounts, &session.ReadOnly); err != nil {
+			return nil, err
+		}
+		session.ExpiresAt = time.Unix(expiresAt, 0)
+		session.Accounts = DecodeAccounts(accounts)
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
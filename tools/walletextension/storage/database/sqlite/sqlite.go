@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -13,6 +14,7 @@ import (
 	obscurocommon "github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	common "github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/storage/database"
 )
 
 type Database struct {
@@ -74,6 +76,27 @@ func NewSqliteDatabase(dbPath string) (*Database, error) {
 		return nil, err
 	}
 
+	// create sessions table
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS sessions (
+		session_id binary(20) PRIMARY KEY,
+		user_id binary(20),
+		expires_at INTEGER NOT NULL,
+		accounts BLOB,
+		read_only BOOLEAN NOT NULL DEFAULT FALSE,
+		FOREIGN KEY(user_id) REFERENCES users(user_id) ON DELETE CASCADE
+	);`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions(user_id);
+		CREATE INDEX IF NOT EXISTS sessions_expires_at_idx ON sessions(expires_at);`)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &Database{db: db}, nil
 }
 
@@ -121,6 +144,12 @@ func (s *Database) GetUserPrivateKey(userID []byte) ([]byte, error) {
 	return privateKey, nil
 }
 
+// UpdateUserPrivateKey replaces the stored private key of an existing user (unlike AddUser it keeps the user's accounts)
+func (s *Database) UpdateUserPrivateKey(userID []byte, privateKey []byte) error {
+	_, err := s.db.Exec("UPDATE users SET private_key = ? WHERE user_id = ?", privateKey, userID)
+	return err
+}
+
 func (s *Database) AddAccount(userID []byte, accountAddress []byte, signature []byte) error {
 	stmt, err := s.db.Prepare("INSERT INTO accounts(user_id, account_address, signature) VALUES (?, ?, ?)")
 	if err != nil {
@@ -182,6 +211,41 @@ func (s *Database) GetAllUsers() ([]common.UserDB, error) {
 	return users, nil
 }
 
+func (s *Database) GetAllUserIDs() ([][]byte, error) {
+	rows, err := s.db.Query("SELECT user_id FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs [][]byte
+	for rows.Next() {
+		var userID []byte
+		if err = rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (s *Database) CountUsers(excludedUserID []byte) (int, error) {
+	var count int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM users WHERE user_id <> ?", excludedUserID).Scan(&count)
+	return count, err
+}
+
+func (s *Database) CountAccounts(excludedUserID []byte) (int, error) {
+	var count int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM accounts WHERE user_id <> ?", excludedUserID).Scan(&count)
+	return count, err
+}
+
 func createOrLoad(dbPath string) (string, error) {
 	// If path is empty we create a random throwaway temp file, otherwise we use the path to the database
 	if dbPath == "" {
@@ -232,3 +296,47 @@ func (s *Database) StoreTransaction(rawTx string, userID []byte) error {
 
 	return nil
 }
+
+// AddSession stores the session, replacing the session with the same ID (e.g. to refresh it)
+func (s *Database) AddSession(session common.SessionDB) error {
+	_, err := s.db.Exec("INSERT OR REPLACE INTO sessions(session_id, user_id, expires_at, accounts, read_only) VALUES (?, ?, ?, ?, ?)",
+		session.SessionID, session.UserID, session.ExpiresAt.Unix(), database.EncodeAccounts(session.Accounts), session.ReadOnly)
+	return err
+}
+
+func (s *Database) GetSession(sessionID []byte) (*common.SessionDB, error) {
+	rows, err := s.db.Query("SELECT session_id, user_id, expires_at, accounts, read_only FROM sessions WHERE session_id = ?", sessionID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := database.ScanSessions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return &sessions[0], nil
+}
+
+func (s *Database) GetSessions(userID []byte) ([]common.SessionDB, error) {
+	rows, err := s.db.Query("SELECT session_id, user_id, expires_at, accounts, read_only FROM sessions WHERE user_id = ?", userID)
+	if err != nil {
+		return nil, err
+	}
+	return database.ScanSessions(rows)
+}
+
+func (s *Database) DeleteSession(sessionID []byte) error {
+	_, err := s.db.Exec("DELETE FROM sessions WHERE session_id = ?", sessionID)
+	return err
+}
+
+// DeleteExpiredSessions deletes the sessions that expired before now, and returns how many were deleted
+func (s *Database) DeleteExpiredSessions(now time.Time) (int64, error) {
+	result, err := s.db.Exec("DELETE FROM sessions WHERE expires_at <= ?", now.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	masterKeySize   = 32
+	dataKeySize     = 32
+	keyVersionSize  = 4
+	gcmNonceSize    = 12
+	gcmTagSize      = 16
+	wrappedKeySize  = gcmNonceSize + dataKeySize + gcmTagSize
+	envelopeHdrSize = 4 + keyVersionSize // magic + master key version
+)
+
+// envelopeMagic prefixes the encrypted private keys ("TEN" + format version), so they can be told apart from the
+// private keys that were stored before encryption was introduced
+var envelopeMagic = []byte{'T', 'E', 'N', 1}
+
+// ErrNoMasterKey is returned when neither a master key nor a master key file is configured
+var ErrNoMasterKey = errors.New("no master key configured to encrypt the users' private keys")
+
+// MasterKeys are the versioned keys used to encrypt the data keys of the users' private keys (envelope encryption).
+// New private keys are encrypted with the current (highest) version, the older versions are only used to decrypt the
+// private keys that were not re-encrypted yet (see RotateMasterKey).
+type MasterKeys struct {
+	current uint32
+	keys    map[uint32][]byte
+}
+
+// NewMasterKeys returns the master keys given by version
+func NewMasterKeys(keys map[uint32][]byte) (*MasterKeys, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoMasterKey
+	}
+	mk := &MasterKeys{keys: map[uint32][]byte{}}
+	for version, key := range keys {
+		if len(key) != masterKeySize {
+			return nil, fmt.Errorf("master key version %d must be %d bytes, got %d", version, masterKeySize, len(key))
+		}
+		if version >= mk.current {
+			mk.current = version
+		}
+		mk.keys[version] = key
+	}
+	return mk, nil
+}
+
+// NewRandomMasterKeys returns a random master key, for databases that are thrown away when the gateway stops
+func NewRandomMasterKeys() (*MasterKeys, error) {
+	key := make([]byte, masterKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("could not generate master key. Cause: %w", err)
+	}
+	return NewMasterKeys(map[uint32][]byte{1: key})
+}
+
+// LoadMasterKeys parses the master keys from the config value, or from the key file if the config value is empty.
+// Both hold comma- or newline-separated `<version>:<hex key>` entries (a single key without a version is version 1).
+// A key file that does not exist is created with a new random key.
+func LoadMasterKeys(encryptionKey string, keyFilePath string) (*MasterKeys, error) {
+	if encryptionKey != "" {
+		return parseMasterKeys(encryptionKey)
+	}
+	if keyFilePath == "" {
+		return nil, ErrNoMasterKey
+	}
+
+	content, err := os.ReadFile(keyFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return createMasterKeyFile(keyFilePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read master key file %s. Cause: %w", keyFilePath, err)
+	}
+	return parseMasterKeys(string(content))
+}
+
+// CurrentVersion returns the version of the master key used to encrypt new private keys
+func (mk *MasterKeys) CurrentVersion() uint32 {
+	return mk.current
+}
+
+// encrypt seals the private key with a new data key, and wraps the data key with the current master key. The envelope
+// is `magic | master key version | wrapped data key | nonce | encrypted private key`, and the user ID is authenticated
+// with the private key so envelopes can't be swapped between users.
+func (mk *MasterKeys) encrypt(userID []byte, privateKey []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("could not generate data key. Cause: %w", err)
+	}
+
+	header := make([]byte, envelopeHdrSize)
+	copy(header, envelopeMagic)
+	binary.BigEndian.PutUint32(header[len(envelopeMagic):], mk.current)
+
+	wrappedKey, err := seal(mk.keys[mk.current], dataKey, header)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := seal(dataKey, privateKey, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := make([]byte, 0, len(header)+len(wrappedKey)+len(encryptedKey))
+	envelope = append(envelope, header...)
+	envelope = append(envelope, wrappedKey...)
+	return append(envelope, encryptedKey...), nil
+}
+
+// decrypt opens an envelope created by encrypt. Private keys stored before encryption was introduced are returned as is.
+func (mk *MasterKeys) decrypt(userID []byte, stored []byte) ([]byte, error) {
+	if !isEnvelope(stored) {
+		return stored, nil
+	}
+
+	header := stored[:envelopeHdrSize]
+	version := binary.BigEndian.Uint32(header[len(envelopeMagic):])
+	masterKey, found := mk.keys[version]
+	if !found {
+		return nil, fmt.Errorf("private key is encrypted with unknown master key version %d", version)
+	}
+
+	dataKey, err := open(masterKey, stored[envelopeHdrSize:envelopeHdrSize+wrappedKeySize], header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt data key. Cause: %w", err)
+	}
+	privateKey, err := open(dataKey, stored[envelopeHdrSize+wrappedKeySize:], userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt private key. Cause: %w", err)
+	}
+	return privateKey, nil
+}
+
+// isCurrent returns true if the stored private key is encrypted with the current master key
+func (mk *MasterKeys) isCurrent(stored []byte) bool {
+	return isEnvelope(stored) && binary.BigEndian.Uint32(stored[len(envelopeMagic):envelopeHdrSize]) == mk.current
+}
+
+func isEnvelope(stored []byte) bool {
+	return len(stored) >= envelopeHdrSize+wrappedKeySize+gcmNonceSize+gcmTagSize && bytes.HasPrefix(stored, envelopeMagic)
+}
+
+// seal encrypts the plaintext with AES-GCM, and returns the nonce followed by the ciphertext
+func seal(key []byte, plaintext []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcmNonceSize)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("could not generate nonce. Cause: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key []byte, sealed []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcmNonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	return gcm.Open(nil, sealed[:gcmNonceSize], sealed[gcmNonceSize:], additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cipher. Cause: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func parseMasterKeys(value string) (*MasterKeys, error) {
+	keys := map[uint32][]byte{}
+	entries := strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' })
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		version := uint64(1)
+		hexKey := entry
+		if versionStr, key, found := strings.Cut(entry, ":"); found {
+			var err error
+			if version, err = strconv.ParseUint(versionStr, 10, 32); err != nil {
+				return nil, fmt.Errorf("invalid master key version %q", versionStr)
+			}
+			hexKey = key
+		}
+		key, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("master key version %d is not valid hex", version)
+		}
+		if _, found := keys[uint32(version)]; found {
+			return nil, fmt.Errorf("master key version %d is defined twice", version)
+		}
+		keys[uint32(version)] = key
+	}
+	return NewMasterKeys(keys)
+}
+
+func createMasterKeyFile(keyFilePath string) (*MasterKeys, error) {
+	keys, err := NewRandomMasterKeys()
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(keyFilePath), 0o700); err != nil {
+		return nil, fmt.Errorf("could not create directory for master key file. Cause: %w", err)
+	}
+	content := fmt.Sprintf("1:%s\n", hex.EncodeToString(keys.keys[1]))
+	if err = os.WriteFile(keyFilePath, []byte(content), 0o600); err != nil {
+		return nil, fmt.Errorf("could not write master key file %s. Cause: %w", keyFilePath, err)
+	}
+	return keys, nil
+}
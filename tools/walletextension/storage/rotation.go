@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"fmt"
+)
+
+// RotateMasterKey re-encrypts the private keys of all the users with the current master key, including the private
+// keys that were stored before encryption was introduced. The master keys must include the versions the private keys
+// are currently encrypted with. It returns the number of private keys that were re-encrypted.
+// It is meant to run offline, while no gateway is using the database.
+func RotateMasterKey(dbType string, dbConnectionURL, dbPath string, masterKeys *MasterKeys) (int, error) {
+	db, err := newDatabase(dbType, dbConnectionURL, dbPath)
+	if err != nil {
+		return 0, err
+	}
+	return rotateMasterKey(db, masterKeys)
+}
+
+func rotateMasterKey(db database, masterKeys *MasterKeys) (int, error) {
+	users, err := db.GetAllUsers()
+	if err != nil {
+		return 0, fmt.Errorf("could not read users. Cause: %w", err)
+	}
+
+	rotated := 0
+	for _, user := range users {
+		if masterKeys.isCurrent(user.PrivateKey) {
+			continue
+		}
+		privateKey, err := masterKeys.decrypt(user.UserID, user.PrivateKey)
+		if err != nil {
+			return rotated, fmt.Errorf("could not decrypt private key of user %x. Cause: %w", user.UserID, err)
+		}
+		encryptedKey, err := masterKeys.encrypt(user.UserID, privateKey)
+		if err != nil {
+			return rotated, fmt.Errorf("could not encrypt private key of user %x. Cause: %w", user.UserID, err)
+		}
+		if err = db.UpdateUserPrivateKey(user.UserID, encryptedKey); err != nil {
+			return rotated, fmt.Errorf("could not update private key of user %x. Cause: %w", user.UserID, err)
+		}
+		rotated++
+	}
+	return rotated, nil
+}
@@ -2,8 +2,10 @@ package storage
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage/database/mariadb"
+	"github.com/ten-protocol/go-ten/tools/walletextension/storage/database/postgres"
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage/database/sqlite"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/common"
@@ -16,15 +18,78 @@ type Storage interface {
 	AddAccount(userID []byte, accountAddress []byte, signature []byte) error
 	GetAccounts(userID []byte) ([]common.AccountDB, error)
 	GetAllUsers() ([]common.UserDB, error)
+	// the lookups and counts below don't load the private keys of the users
+	GetAllUserIDs() ([][]byte, error)
+	CountUsers(excludedUserID []byte) (int, error)
+	CountAccounts(excludedUserID []byte) (int, error)
 	StoreTransaction(rawTx string, userID []byte) error
+	AddSession(session common.SessionDB) error
+	GetSession(sessionID []byte) (*common.SessionDB, error)
+	GetSessions(userID []byte) ([]common.SessionDB, error)
+	DeleteSession(sessionID []byte) error
+	DeleteExpiredSessions(now time.Time) (int64, error)
 }
 
-func New(dbType string, dbConnectionURL, dbPath string) (Storage, error) {
+// database is a storage backend, which stores the private keys as it is given them
+type database interface {
+	Storage
+	UpdateUserPrivateKey(userID []byte, privateKey []byte) error
+}
+
+// New returns the storage for the given db type. The private keys of the users are encrypted with the master keys
+// before they are written to the database.
+func New(dbType string, dbConnectionURL, dbPath string, masterKeys *MasterKeys) (Storage, error) {
+	db, err := newDatabase(dbType, dbConnectionURL, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedStorage{database: db, masterKeys: masterKeys}, nil
+}
+
+func newDatabase(dbType string, dbConnectionURL, dbPath string) (database, error) {
 	switch dbType {
 	case "mariaDB":
 		return mariadb.NewMariaDB(dbConnectionURL)
+	case "postgres":
+		return postgres.NewPostgresDB(dbConnectionURL)
 	case "sqlite":
 		return sqlite.NewSqliteDatabase(dbPath)
 	}
 	return nil, fmt.Errorf("unknown db %s", dbType)
 }
+
+// encryptedStorage encrypts the private keys of the users before they reach the database
+type encryptedStorage struct {
+	database
+	masterKeys *MasterKeys
+}
+
+func (s *encryptedStorage) AddUser(userID []byte, privateKey []byte) error {
+	encryptedKey, err := s.masterKeys.encrypt(userID, privateKey)
+	if err != nil {
+		return fmt.Errorf("could not encrypt private key. Cause: %w", err)
+	}
+	return s.database.AddUser(userID, encryptedKey)
+}
+
+func (s *encryptedStorage) GetUserPrivateKey(userID []byte) ([]byte, error) {
+	storedKey, err := s.database.GetUserPrivateKey(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.masterKeys.decrypt(userID, storedKey)
+}
+
+func (s *encryptedStorage) GetAllUsers() ([]common.UserDB, error) {
+	users, err := s.database.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		users[i].PrivateKey, err = s.masterKeys.decrypt(users[i].UserID, users[i].PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt private key of user %x. Cause: %w", users[i].UserID, err)
+		}
+	}
+	return users, nil
+}
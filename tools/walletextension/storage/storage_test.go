@@ -2,11 +2,15 @@ package storage
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
 )
 
 var tests = map[string]func(storage Storage, t *testing.T){
@@ -14,19 +18,79 @@ var tests = map[string]func(storage Storage, t *testing.T){
 	"testAddAndGetAccounts": testAddAndGetAccounts,
 	"testDeleteUser":        testDeleteUser,
 	"testGetAllUsers":       testGetAllUsers,
+	"testUserLookups":       testUserLookups,
 	"testStoringNewTx":      testStoringNewTx,
+	"testSessions":          testSessions,
 }
 
-func TestSQLiteGatewayDB(t *testing.T) {
+var rotationTests = map[string]func(db database, t *testing.T){
+	"testPrivateKeyIsEncryptedAtRest":   testPrivateKeyIsEncryptedAtRest,
+	"testRotationMigratesExistingUsers": testRotationMigratesExistingUsers,
+}
+
+// the MariaDB tests run against the instance at this URL (e.g. obscurouser:password@tcp(127.0.0.1:3306)/ogdb), if set
+const mariaDBURLEnvVar = "GATEWAY_TEST_MARIADB_URL"
+
+// backend is a storage backend the conformance tests run against. connect returns the db type and connection URL of
+// the database for a test.
+type backend struct {
+	dbType  string
+	setup   func(t *testing.T) // prepares the backend for the tests, or skips them if the backend is not available
+	connect func(t *testing.T) string
+}
+
+var backends = []backend{
+	{
+		dbType:  "sqlite",
+		setup:   func(t *testing.T) {},
+		connect: func(t *testing.T) string { return "" }, // an empty path is a new throwaway database
+	},
+	{
+		dbType: "mariaDB",
+		setup: func(t *testing.T) {
+			if os.Getenv(mariaDBURLEnvVar) == "" {
+				t.Skipf("%s is not set", mariaDBURLEnvVar)
+			}
+		},
+		connect: func(t *testing.T) string { return os.Getenv(mariaDBURLEnvVar) },
+	},
+	{
+		dbType:  "postgres",
+		setup:   setupPostgres,
+		connect: newPostgresDatabase,
+	},
+}
+
+// TestGatewayDBConformance runs the same tests against every backend, so they all have the same semantics
+func TestGatewayDBConformance(t *testing.T) {
+	for _, b := range backends {
+		b := b
+		t.Run(b.dbType, func(t *testing.T) {
+			b.setup(t)
+			runStorageTests(t, b)
+		})
+	}
+}
+
+func runStorageTests(t *testing.T, b backend) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			// storage, err := New("mariaDB", "obscurouser:password@tcp(127.0.0.1:3306)/ogdb", "") allows to run tests against a local instance of MariaDB
-			storage, err := New("sqlite", "", "")
+			masterKeys, err := NewRandomMasterKeys()
+			require.NoError(t, err)
+			storage, err := New(b.dbType, b.connect(t), "", masterKeys)
 			require.NoError(t, err)
 
 			test(storage, t)
 		})
 	}
+	for name, test := range rotationTests {
+		t.Run(name, func(t *testing.T) {
+			db, err := newDatabase(b.dbType, b.connect(t), "")
+			require.NoError(t, err)
+
+			test(db, t)
+		})
+	}
 }
 
 func testAddAndGetUser(storage Storage, t *testing.T) {
@@ -146,6 +210,34 @@ func testGetAllUsers(storage Storage, t *testing.T) {
 	}
 }
 
+func testUserLookups(storage Storage, t *testing.T) {
+	excludedUserID := []byte("userLookupsExcludedID")
+	initialUsers, err := storage.CountUsers(excludedUserID)
+	require.NoError(t, err)
+	initialAccounts, err := storage.CountAccounts(excludedUserID)
+	require.NoError(t, err)
+
+	userID := []byte("userLookupsTestID")
+	require.NoError(t, storage.AddUser(userID, []byte("userLookupsTestPrivateKey")))
+	require.NoError(t, storage.AddAccount(userID, []byte("userLookupsAccount1"), []byte("signature")))
+	require.NoError(t, storage.AddAccount(userID, []byte("userLookupsAccount2"), []byte("signature")))
+	// the excluded user is not counted
+	require.NoError(t, storage.AddUser(excludedUserID, []byte("userLookupsExcludedPrivateKey")))
+	require.NoError(t, storage.AddAccount(excludedUserID, []byte("userLookupsAccount3"), []byte("signature")))
+
+	userIDs, err := storage.GetAllUserIDs()
+	require.NoError(t, err)
+	require.Contains(t, userIDs, userID)
+	require.Contains(t, userIDs, excludedUserID)
+
+	users, err := storage.CountUsers(excludedUserID)
+	require.NoError(t, err)
+	require.Equal(t, initialUsers+1, users)
+	accounts, err := storage.CountAccounts(excludedUserID)
+	require.NoError(t, err)
+	require.Equal(t, initialAccounts+2, accounts)
+}
+
 func testStoringNewTx(storage Storage, t *testing.T) {
 	userID := []byte("userID")
 	rawTransaction := "0x0123456789"
@@ -155,3 +247,141 @@ func testStoringNewTx(storage Storage, t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func testSessions(storage Storage, t *testing.T) {
+	userID := bytes.Repeat([]byte{1}, 20)
+	require.NoError(t, storage.AddUser(userID, []byte("privateKey")))
+
+	now := time.Now()
+	full := common.SessionDB{SessionID: userID, UserID: userID, ExpiresAt: now.Add(time.Hour)}
+	scoped := common.SessionDB{
+		SessionID: bytes.Repeat([]byte{2}, 20),
+		UserID:    userID,
+		ExpiresAt: now.Add(time.Minute),
+		Accounts:  [][]byte{bytes.Repeat([]byte{3}, 20), bytes.Repeat([]byte{4}, 20)},
+		ReadOnly:  true,
+	}
+	expired := common.SessionDB{SessionID: bytes.Repeat([]byte{5}, 20), UserID: userID, ExpiresAt: now.Add(-time.Minute)}
+	for _, session := range []common.SessionDB{full, scoped, expired} {
+		require.NoError(t, storage.AddSession(session))
+	}
+
+	returned, err := storage.GetSession(scoped.SessionID)
+	require.NoError(t, err)
+	require.Equal(t, scoped.Accounts, returned.Accounts)
+	require.True(t, returned.ReadOnly)
+	require.Equal(t, scoped.ExpiresAt.Unix(), returned.ExpiresAt.Unix())
+
+	sessions, err := storage.GetSessions(userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	deleted, err := storage.DeleteExpiredSessions(now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	_, err = storage.GetSession(expired.SessionID)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	require.NoError(t, storage.DeleteSession(scoped.SessionID))
+	_, err = storage.GetSession(scoped.SessionID)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	// the sessions are deleted with their user
+	require.NoError(t, storage.DeleteUser(userID))
+	sessions, err = storage.GetSessions(userID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func testPrivateKeyIsEncryptedAtRest(db database, t *testing.T) {
+	userID := []byte("encryptedUserID")
+	privateKey := []byte("encryptedPrivateKey")
+	masterKeys, err := NewRandomMasterKeys()
+	require.NoError(t, err)
+	storage := &encryptedStorage{database: db, masterKeys: masterKeys}
+
+	require.NoError(t, storage.AddUser(userID, privateKey))
+
+	storedKey, err := db.GetUserPrivateKey(userID)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(storedKey, privateKey), "private key is stored in plain form")
+	require.True(t, masterKeys.isCurrent(storedKey))
+
+	returnedKey, err := storage.GetUserPrivateKey(userID)
+	require.NoError(t, err)
+	require.Equal(t, privateKey, returnedKey)
+
+	// another master key can't decrypt the private key
+	otherKeys, err := NewRandomMasterKeys()
+	require.NoError(t, err)
+	_, err = (&encryptedStorage{database: db, masterKeys: otherKeys}).GetUserPrivateKey(userID)
+	require.Error(t, err)
+}
+
+func testRotationMigratesExistingUsers(db database, t *testing.T) {
+	plainUserID := []byte("plainUserID")
+	plainKey := []byte("plainPrivateKey")
+	oldUserID := []byte("oldUserID")
+	oldKey := []byte("oldPrivateKey")
+	accountAddress := []byte("rotatedAccount")
+
+	oldKeys, err := NewMasterKeys(map[uint32][]byte{1: bytes.Repeat([]byte{1}, masterKeySize)})
+	require.NoError(t, err)
+	newKeys, err := NewMasterKeys(map[uint32][]byte{1: bytes.Repeat([]byte{1}, masterKeySize), 2: bytes.Repeat([]byte{2}, masterKeySize)})
+	require.NoError(t, err)
+
+	// a user stored before encryption was introduced, and one encrypted with the old master key
+	require.NoError(t, db.AddUser(plainUserID, plainKey))
+	require.NoError(t, db.AddAccount(plainUserID, accountAddress, []byte("signature")))
+	require.NoError(t, (&encryptedStorage{database: db, masterKeys: oldKeys}).AddUser(oldUserID, oldKey))
+
+	rotated, err := rotateMasterKey(db, newKeys)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, rotated, 2)
+
+	// the rotation is idempotent
+	rotated, err = rotateMasterKey(db, newKeys)
+	require.NoError(t, err)
+	require.Equal(t, 0, rotated)
+
+	// only the new master key is needed once the rows are migrated
+	onlyNewKey, err := NewMasterKeys(map[uint32][]byte{2: bytes.Repeat([]byte{2}, masterKeySize)})
+	require.NoError(t, err)
+	storage := &encryptedStorage{database: db, masterKeys: onlyNewKey}
+	for userID, privateKey := range map[string][]byte{string(plainUserID): plainKey, string(oldUserID): oldKey} {
+		storedKey, err := db.GetUserPrivateKey([]byte(userID))
+		require.NoError(t, err)
+		require.True(t, onlyNewKey.isCurrent(storedKey))
+
+		returnedKey, err := storage.GetUserPrivateKey([]byte(userID))
+		require.NoError(t, err)
+		require.Equal(t, privateKey, returnedKey)
+	}
+
+	// the accounts of the users are kept
+	accounts, err := db.GetAccounts(plainUserID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+}
+
+func TestLoadMasterKeys(t *testing.T) {
+	keyFile := t.TempDir() + "/master.key"
+	created, err := LoadMasterKeys("", keyFile)
+	require.NoError(t, err)
+	loaded, err := LoadMasterKeys("", keyFile)
+	require.NoError(t, err)
+	require.Equal(t, created.keys, loaded.keys)
+
+	fromConfig, err := LoadMasterKeys("1:"+hexKey(1)+",3:"+hexKey(3), keyFile)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), fromConfig.CurrentVersion())
+
+	_, err = LoadMasterKeys("", "")
+	require.ErrorIs(t, err, ErrNoMasterKey)
+	_, err = LoadMasterKeys("1:abcd", "")
+	require.Error(t, err)
+}
+
+func hexKey(b byte) string {
+	return hex.EncodeToString(bytes.Repeat([]byte{b}, masterKeySize))
+}
@@ -2,7 +2,8 @@ package subscriptions
 
 import "github.com/ethereum/go-ethereum/common"
 
-// LogKey uniquely represents a log (consists of BlockHash, TxHash, and Index)
+// LogKey uniquely represents a log (consists of BlockHash, TxHash, and Index). The keys of the heads only have the
+// BlockHash, and the keys of the pending transactions only have the TxHash.
 type LogKey struct {
 	BlockHash common.Hash // Not necessary, but can be helpful in edge case of block reorg.
 	TxHash    common.Hash
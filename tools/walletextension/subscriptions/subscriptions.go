@@ -18,160 +18,242 @@ import (
 	"github.com/ten-protocol/go-ten/tools/walletextension/userconn"
 )
 
+// how often the subscriptions check whether their user connection is closed and their node is still healthy
+const subscriptionCheckInterval = 100 * time.Millisecond
+
+// NodeHealth reports whether a node is healthy
+type NodeHealth interface {
+	IsHealthy(node string) bool
+}
+
+// ConnectFunc connects to a healthy node, and returns the clients to subscribe with (one per account) and the node
+type ConnectFunc func() ([]rpc.Client, string, error)
+
+// event is a notification of a subscription, with the key identifying it for the deduplication
+type event struct {
+	key    LogKey
+	result interface{}
+}
+
+// userSubscription is a subscription of a user, made with one subscription per account on a node
+type userSubscription struct {
+	node          string
+	clients       []rpc.Client
+	subscriptions []*gethrpc.ClientSubscription
+}
+
+// close ends the subscriptions, and the connections they were made over
+func (s *userSubscription) close() {
+	for _, subscription := range s.subscriptions {
+		subscription.Unsubscribe()
+	}
+	for _, client := range s.clients {
+		client.Stop()
+	}
+}
+
 type SubscriptionManager struct {
-	subscriptionMappings map[string][]*gethrpc.ClientSubscription
+	subscriptionMappings map[string]*userSubscription
+	nodeHealth           NodeHealth
 	logger               gethlog.Logger
 	mu                   sync.Mutex
 }
 
-func New(logger gethlog.Logger) *SubscriptionManager {
+func New(nodeHealth NodeHealth, logger gethlog.Logger) *SubscriptionManager {
 	return &SubscriptionManager{
-		subscriptionMappings: make(map[string][]*gethrpc.ClientSubscription),
+		subscriptionMappings: make(map[string]*userSubscription),
+		nodeHealth:           nodeHealth,
 		logger:               logger,
 	}
 }
 
-// HandleNewSubscriptions subscribes to an event with all the clients provided.
-// Doing this is necessary because we have relevancy rule, and we want to subscribe sometimes with all clients to get all the events
-func (sm *SubscriptionManager) HandleNewSubscriptions(clients []rpc.Client, req *wecommon.RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
+// HandleNewSubscriptions subscribes to an event with all the clients returned by connect.
+// Doing this is necessary because we have relevancy rule, and we want to subscribe sometimes with all clients to get all the events.
+// If the node of the subscription fails, the subscription is made again on another node.
+func (sm *SubscriptionManager) HandleNewSubscriptions(connect ConnectFunc, req *wecommon.RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
 	if len(req.Params) == 0 {
 		return fmt.Errorf("could not subscribe as no subscription namespace was provided")
 	}
 
-	sm.logger.Info(fmt.Sprintf("Subscribing to event %s with %d clients", req.Params, len(clients)))
-
 	// create subscriptionID which will enable user to unsubscribe from all subscriptions
 	userSubscriptionID := gethrpc.NewID()
 
 	// create a common channel for subscriptions from all accounts
-	funnelMultipleAccountsChan := make(chan common.IDAndLog)
+	funnelMultipleAccountsChan := make(chan event)
+
+	subscription, err := sm.subscribe(connect, req, funnelMultipleAccountsChan)
+	if err != nil {
+		return err
+	}
+	sm.mu.Lock()
+	sm.subscriptionMappings[string(userSubscriptionID)] = subscription
+	sm.mu.Unlock()
 
 	// read from a multiple accounts channel and write results to userConn
 	go readFromChannelAndWriteToUserConn(funnelMultipleAccountsChan, userConn, userSubscriptionID, sm.logger)
-
-	// iterate over all clients and subscribe for each of them
-	for _, client := range clients {
-		subscription, err := client.Subscribe(context.Background(), resp, rpc.SubscribeNamespace, funnelMultipleAccountsChan, req.Params...)
-		if err != nil {
-			return fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, err)
-		}
-		sm.UpdateSubscriptionMapping(string(userSubscriptionID), subscription)
-
-		// We periodically check if the websocket is closed, and terminate the subscription.
-		// TODO: Check if it will be much more efficient to create just one go routine for all clients together
-		go sm.checkIfUserConnIsClosedAndUnsubscribe(userConn, subscription, string(userSubscriptionID))
-	}
+	go sm.maintainSubscription(string(userSubscriptionID), connect, req, funnelMultipleAccountsChan, userConn)
 
 	// We return subscriptionID with resp interface. We want to use userSubscriptionID to allow unsubscribing
 	*resp = userSubscriptionID
 	return nil
 }
 
-func readFromChannelAndWriteToUserConn(channel chan common.IDAndLog, userConn userconn.UserConn, userSubscriptionID gethrpc.ID, logger gethlog.Logger) {
-	buffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
-	for data := range channel {
-		// create unique identifier for current log
-		uniqueLogKey := LogKey{
-			BlockHash: data.Log.BlockHash,
-			TxHash:    data.Log.TxHash,
-			Index:     data.Log.Index,
-		}
+// subscribe connects to a node and subscribes with all the clients
+func (sm *SubscriptionManager) subscribe(connect ConnectFunc, req *wecommon.RPCRequest, funnel chan event) (*userSubscription, error) {
+	clients, node, err := connect()
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe - %w", err)
+	}
+	sm.logger.Info(fmt.Sprintf("Subscribing to event %s with %d clients", req.Params, len(clients)), "node", node)
 
-		// check if the current event is a duplicate (and skip it if it is)
-		if buffer.Contains(uniqueLogKey) {
-			continue
+	subscription := &userSubscription{node: node, clients: clients}
+	if req.Params[0] == rpc.SubscriptionTypeNewHeads {
+		// the heads are the same for all the accounts, so one subscription is enough
+		if len(clients) == 0 {
+			return nil, fmt.Errorf("could not subscribe to %s as there are no registered accounts", rpc.SubscriptionTypeNewHeads)
 		}
-
-		jsonResponse, err := prepareLogResponse(data, userSubscriptionID)
+		clients = clients[:1]
+	}
+	for _, client := range clients {
+		clientSubscription, err := subscribeClient(client, req, funnel)
 		if err != nil {
-			logger.Error("could not marshal log response to JSON on subscription.", log.SubIDKey, data.SubID, log.ErrKey, err)
-			continue
+			subscription.close()
+			return nil, fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, err)
 		}
+		subscription.subscriptions = append(subscription.subscriptions, clientSubscription)
+	}
+	return subscription, nil
+}
 
-		// the current log is unique, and we want to add it to our buffer and proceed with forwarding to the user
-		buffer.Push(uniqueLogKey)
-
-		logger.Trace(fmt.Sprintf("Forwarding log from Obscuro node: %s", jsonResponse), log.SubIDKey, data.SubID)
-		err = userConn.WriteResponse(jsonResponse)
+// subscribeClient subscribes with the client, and forwards the notifications of the subscription to the funnel
+func subscribeClient(client rpc.Client, req *wecommon.RPCRequest, funnel chan event) (*gethrpc.ClientSubscription, error) {
+	switch req.Params[0] {
+	case rpc.SubscriptionTypeNewHeads:
+		ch := make(chan *common.BatchHeader)
+		subscription, err := client.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, ch, req.Params...)
 		if err != nil {
-			logger.Error("could not write the JSON log to the websocket on subscription %", log.SubIDKey, data.SubID, log.ErrKey, err)
-			continue
+			return nil, err
+		}
+		go forwardEvents(ch, subscription, funnel, func(header *common.BatchHeader) event {
+			return event{key: LogKey{BlockHash: header.Hash()}, result: header}
+		})
+		return subscription, nil
+
+	case rpc.SubscriptionTypePendingTxs:
+		ch := make(chan common.IDAndTxHash)
+		subscription, err := client.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, ch, req.Params...)
+		if err != nil {
+			return nil, err
+		}
+		go forwardEvents(ch, subscription, funnel, func(idAndTxHash common.IDAndTxHash) event {
+			return event{key: LogKey{TxHash: idAndTxHash.TxHash}, result: idAndTxHash.TxHash}
+		})
+		return subscription, nil
+
+	default:
+		ch := make(chan common.IDAndLog)
+		subscription, err := client.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, ch, req.Params...)
+		if err != nil {
+			return nil, err
 		}
+		go forwardEvents(ch, subscription, funnel, func(idAndLog common.IDAndLog) event {
+			return event{
+				key:    LogKey{BlockHash: idAndLog.Log.BlockHash, TxHash: idAndLog.Log.TxHash, Index: idAndLog.Log.Index},
+				result: idAndLog.Log,
+			}
+		})
+		return subscription, nil
 	}
 }
 
-func (sm *SubscriptionManager) unsubscribeAndRemove(userSubscriptionID string, subscription *gethrpc.ClientSubscription) {
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-
-	subscription.Unsubscribe()
-
-	subscriptions, exists := sm.subscriptionMappings[userSubscriptionID]
-	if !exists {
-		sm.logger.Error("subscription that needs to be removed is not present in subscriptionMappings for userSubscriptionID: %s", userSubscriptionID)
-		return
+// forwardEvents sends the notifications of the subscription to the funnel, until the subscription ends
+func forwardEvents[T any](ch chan T, subscription *gethrpc.ClientSubscription, funnel chan event, toEvent func(T) event) {
+	for {
+		select {
+		case notification := <-ch:
+			funnel <- toEvent(notification)
+		case <-subscription.Err():
+			return
+		}
 	}
+}
 
-	for i, s := range subscriptions {
-		if s != subscription {
+// maintainSubscription ends the subscription when the user connection is closed, and moves it to another node when
+// its node is no longer healthy. The events already sent to the user are filtered out by the deduplication buffer.
+func (sm *SubscriptionManager) maintainSubscription(userSubscriptionID string, connect ConnectFunc, req *wecommon.RPCRequest, funnel chan event, userConn userconn.UserConn) {
+	for !userConn.IsClosed() {
+		time.Sleep(subscriptionCheckInterval)
+
+		sm.mu.Lock()
+		current, exists := sm.subscriptionMappings[userSubscriptionID]
+		sm.mu.Unlock()
+		if !exists {
+			// the user unsubscribed
+			return
+		}
+		if sm.nodeHealth.IsHealthy(current.node) {
 			continue
 		}
 
-		// Remove the subscription from the slice
-		lastIndex := len(subscriptions) - 1
-		subscriptions[i] = subscriptions[lastIndex]
-		subscriptions = subscriptions[:lastIndex]
-
-		// If the slice is empty, delete the key from the map
-		if len(subscriptions) == 0 {
-			delete(sm.subscriptionMappings, userSubscriptionID)
-		} else {
-			sm.subscriptionMappings[userSubscriptionID] = subscriptions
+		// if no other node can be reached, the subscription is retried at the next check
+		replacement, err := sm.subscribe(connect, req, funnel)
+		if err != nil {
+			sm.logger.Warn("Could not move subscription off an unhealthy node", log.SubIDKey, userSubscriptionID, "node", current.node, log.ErrKey, err)
+			continue
+		}
+		sm.logger.Info("Moved subscription off an unhealthy node", log.SubIDKey, userSubscriptionID, "from", current.node, "to", replacement.node)
+
+		// the user may have unsubscribed in the meantime, in which case the replacement is closed instead
+		stale := replacement
+		sm.mu.Lock()
+		if _, exists = sm.subscriptionMappings[userSubscriptionID]; exists {
+			sm.subscriptionMappings[userSubscriptionID] = replacement
+			stale = current
 		}
-		break
+		sm.mu.Unlock()
+		stale.close()
 	}
-}
 
-func (sm *SubscriptionManager) checkIfUserConnIsClosedAndUnsubscribe(userConn userconn.UserConn, subscription *gethrpc.ClientSubscription, userSubscriptionID string) {
-	for !userConn.IsClosed() {
-		time.Sleep(100 * time.Millisecond)
+	sm.mu.Lock()
+	subscription, exists := sm.subscriptionMappings[userSubscriptionID]
+	delete(sm.subscriptionMappings, userSubscriptionID)
+	sm.mu.Unlock()
+	if exists {
+		subscription.close()
 	}
-
-	sm.unsubscribeAndRemove(userSubscriptionID, subscription)
 }
 
-func (sm *SubscriptionManager) UpdateSubscriptionMapping(userSubscriptionID string, subscription *gethrpc.ClientSubscription) {
-	// Ensure there is no concurrent map writes
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
+func readFromChannelAndWriteToUserConn(channel chan event, userConn userconn.UserConn, userSubscriptionID gethrpc.ID, logger gethlog.Logger) {
+	buffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
+	for data := range channel {
+		// check if the current event is a duplicate (and skip it if it is)
+		if buffer.Contains(data.key) {
+			continue
+		}
 
-	// Check if the userSubscriptionID already exists in the map
-	subscriptions, exists := sm.subscriptionMappings[userSubscriptionID]
+		jsonResponse, err := prepareEventResponse(data, userSubscriptionID)
+		if err != nil {
+			logger.Error("could not marshal event response to JSON on subscription.", log.SubIDKey, userSubscriptionID, log.ErrKey, err)
+			continue
+		}
 
-	// If it doesn't exist, create a new slice for it
-	if !exists {
-		subscriptions = []*gethrpc.ClientSubscription{}
-	}
+		// the current event is unique, and we want to add it to our buffer and proceed with forwarding to the user
+		buffer.Push(data.key)
 
-	// Check if the subscription is already in the slice, if not, add it
-	subscriptionExists := false
-	for _, sub := range subscriptions {
-		if sub == subscription {
-			subscriptionExists = true
-			break
+		logger.Trace(fmt.Sprintf("Forwarding event from Obscuro node: %s", jsonResponse), log.SubIDKey, userSubscriptionID)
+		err = userConn.WriteResponse(jsonResponse)
+		if err != nil {
+			logger.Error("could not write the JSON event to the websocket on subscription", log.SubIDKey, userSubscriptionID, log.ErrKey, err)
+			continue
 		}
 	}
-
-	if !subscriptionExists {
-		sm.subscriptionMappings[userSubscriptionID] = append(subscriptions, subscription)
-	}
 }
 
-// Formats the log to be sent as an Eth JSON-RPC response.
-func prepareLogResponse(idAndLog common.IDAndLog, userSubscriptionID gethrpc.ID) ([]byte, error) {
+// Formats the event to be sent as an Eth JSON-RPC response.
+func prepareEventResponse(data event, userSubscriptionID gethrpc.ID) ([]byte, error) {
 	paramsMap := make(map[string]interface{})
 	paramsMap[wecommon.JSONKeySubscription] = userSubscriptionID
-	paramsMap[wecommon.JSONKeyResult] = idAndLog.Log
+	paramsMap[wecommon.JSONKeyResult] = data.result
 
 	respMap := make(map[string]interface{})
 	respMap[wecommon.JSONKeyRPCVersion] = jsonrpc.Version
@@ -180,23 +262,21 @@ func prepareLogResponse(idAndLog common.IDAndLog, userSubscriptionID gethrpc.ID)
 
 	jsonResponse, err := json.Marshal(respMap)
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal log response to JSON. Cause: %w", err)
+		return nil, fmt.Errorf("could not marshal event response to JSON. Cause: %w", err)
 	}
 	return jsonResponse, nil
 }
 
 func (sm *SubscriptionManager) HandleUnsubscribe(userSubscriptionID string, rpcResp *interface{}) {
-	subscriptions, exists := sm.subscriptionMappings[userSubscriptionID]
+	sm.mu.Lock()
+	subscription, exists := sm.subscriptionMappings[userSubscriptionID]
+	delete(sm.subscriptionMappings, userSubscriptionID)
+	sm.mu.Unlock()
+
 	if !exists {
 		*rpcResp = false
 		return
 	}
-
-	sm.mu.Lock()
-	defer sm.mu.Unlock()
-	for _, sub := range subscriptions {
-		sub.Unsubscribe()
-	}
-	delete(sm.subscriptionMappings, userSubscriptionID)
+	subscription.close()
 	*rpcResp = true
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
 	"github.com/ten-protocol/go-ten/go/responses"
 
@@ -27,8 +28,11 @@ const (
 	l2ChainIDHex         = "0x309"
 	l2ChainIDDecimal     = 443
 	enclavePrivateKeyHex = "81acce9620f0adf1728cb8df7f6b8b8df857955eb9e8b7aed6ef8390c09fc207"
+	dummyFilterID        = "0x1"
 )
 
+var dummyBatchHash = gethcommon.Hash{1}
+
 // DummyAPI provides dummies for the RPC operations defined in the `eth_` namespace. For each sensitive RPC
 // operation, it decrypts the parameters using the enclave's private key, then echoes them back to the caller encrypted
 // with the viewing key set using the `setViewingKey` method, mimicking the privacy behaviour of the host.
@@ -62,6 +66,15 @@ func (api *DummyAPI) ChainId() (*hexutil.Big, error) { //nolint:stylecheck,reviv
 	return (*hexutil.Big)(chainID), err
 }
 
+// Health reports the node as healthy, so the gateway's health checks keep it in the node pool
+func (api *DummyAPI) Health() (*host.HealthCheck, error) {
+	return &host.HealthCheck{OverallHealth: true}, nil
+}
+
+func (api *DummyAPI) BlockNumber() hexutil.Uint64 {
+	return 0
+}
+
 func (api *DummyAPI) Call(_ context.Context, encryptedParams common.EncryptedParamsCall) (*responses.EnclaveResponse, error) {
 	return api.reEncryptParams(encryptedParams)
 }
@@ -158,6 +171,22 @@ func (api *DummyAPI) Logs(ctx context.Context, encryptedParams common.EncryptedP
 	return subscription, nil
 }
 
+// NewBlockFilter returns the ID of the only block filter of the dummy API, whose changes are dummyBatchHash
+func (api *DummyAPI) NewBlockFilter() rpc.ID {
+	return dummyFilterID
+}
+
+func (api *DummyAPI) GetFilterChanges(id rpc.ID) ([]gethcommon.Hash, error) {
+	if id != dummyFilterID {
+		return nil, fmt.Errorf("filter not found")
+	}
+	return []gethcommon.Hash{dummyBatchHash}, nil
+}
+
+func (api *DummyAPI) UninstallFilter(id rpc.ID) bool {
+	return id == dummyFilterID
+}
+
 func (api *DummyAPI) GetLogs(_ context.Context, encryptedParams common.EncryptedParamsGetLogs) (*responses.EnclaveResponse, error) {
 	reEncryptParams, err := api.reEncryptParams(encryptedParams)
 	return reEncryptParams, err
@@ -37,11 +37,13 @@ func createWalExtCfg(connectPort, wallHTTPPort, wallWSPort int) *config.Config {
 		panic("could not create persistence file for wallet extension tests")
 	}
 	return &config.Config{
+		NodeRPCHTTPAddress:      fmt.Sprintf("localhost:%d", connectPort),
 		NodeRPCWebsocketAddress: fmt.Sprintf("localhost:%d", connectPort),
 		DBPathOverride:          testDBPath.Name(),
 		WalletExtensionPortHTTP: wallHTTPPort,
 		WalletExtensionPortWS:   wallWSPort,
 		DBType:                  "sqlite",
+		TenChainID:              l2ChainIDDecimal,
 	}
 }
 
@@ -63,10 +65,14 @@ func createWalExt(t *testing.T, walExtCfg *config.Config) func() error {
 // Creates an RPC layer that the wallet extension can connect to. Returns a handle to shut down the host.
 func createDummyHost(t *testing.T, wsRPCPort int) (*DummyAPI, func() error) { //nolint: unparam
 	dummyAPI := NewDummyAPI()
+	// the dummy host serves HTTP and WS on the same port
 	cfg := gethnode.Config{
-		WSHost:    common.Localhost,
-		WSPort:    wsRPCPort,
-		WSOrigins: []string{"*"},
+		HTTPHost:         common.Localhost,
+		HTTPPort:         wsRPCPort,
+		HTTPVirtualHosts: []string{"*"},
+		WSHost:           common.Localhost,
+		WSPort:           wsRPCPort,
+		WSOrigins:        []string{"*"},
 	}
 	rpcServerNode, err := gethnode.New(&cfg)
 	rpcServerNode.RegisterAPIs([]gethrpc.API{
@@ -376,3 +382,24 @@ func validateJSONResponse(t *testing.T, resp []byte) {
 //		t.Fatalf("subscription response did not contain expected result. Expected pattern matching %s, got %s", pattern, resultString)
 //	}
 //}
+
+// Sends a request with the admin API key to the URL, and returns the response body.
+func makeAdminRequestHTTP(method string, url string, adminAPIKey string) []byte {
+	req, err := http.NewRequest(method, url, nil) //nolint:noctx
+	if err != nil {
+		panic(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+adminAPIKey)
+	resp, err := http.DefaultClient.Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		panic(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	return body
+}
@@ -1,14 +1,23 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/integration"
+	"github.com/ten-protocol/go-ten/tools/walletextension"
 	"github.com/ten-protocol/go-ten/tools/walletextension/accountmanager"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/lib"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
@@ -277,10 +286,11 @@ func TestGetStorageAtForReturningUserID(t *testing.T) {
 	walletHTTPPort := _hostWSPort + 1
 	walletWSPort := _hostWSPort + 2
 
-	createDummyHost(t, _hostWSPort)
+	_, shutdownHost := createDummyHost(t, _hostWSPort)
+	defer shutdownHost() //nolint: errcheck
 	walExtCfg := createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort)
-	createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort)
-	createWalExt(t, walExtCfg)
+	shutdownWallet := createWalExt(t, walExtCfg)
+	defer shutdownWallet() //nolint: errcheck
 
 	// create userID
 	respJoin := makeHTTPEthJSONReqWithPath(walletHTTPPort, "v1/join")
@@ -294,12 +304,13 @@ func TestGetStorageAtForReturningUserID(t *testing.T) {
 		t.Fatalf("expected response containing '%s', got '%s'", userID, string(respBody))
 	}
 
-	// make a request to GetStorageAt with correct parameters, but userID that is not present in the database
+	// make a request to GetStorageAt with correct parameters, but userID that is not present in the database (which is
+	// rejected, as it is not a session token)
 	invalidUserID := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	respBody2 := makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.GetStorageAt, []interface{}{"getUserID", "0", nil}, invalidUserID)
 
-	if !strings.Contains(string(respBody2), "method eth_getStorageAt cannot be called with an unauthorised client - no signed viewing keys found") {
-		t.Fatalf("expected method eth_getStorageAt cannot be called with an unauthorised client - no signed viewing keys found, got '%s'", string(respBody2))
+	if !strings.Contains(string(respBody2), walletextension.ErrInvalidSession.Error()) {
+		t.Fatalf("expected '%s', got '%s'", walletextension.ErrInvalidSession, string(respBody2))
 	}
 
 	// make a request to GetStorageAt with userID that is in the database, but wrong parameters
@@ -314,3 +325,193 @@ func TestGetStorageAtForReturningUserID(t *testing.T) {
 		t.Fatalf("expected response not containing userID as the parameters are wrong ")
 	}
 }
+
+func TestBatchRequests(t *testing.T) {
+	walletHTTPPort := _hostWSPort + 1
+	walletWSPort := _hostWSPort + 2
+
+	_, shutdownHost := createDummyHost(t, _hostWSPort)
+	defer shutdownHost() //nolint: errcheck
+
+	walExtCfg := createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort)
+	walExtCfg.MaxBatchSize = 5
+	shutdownWallet := createWalExt(t, walExtCfg)
+	defer shutdownWallet() //nolint: errcheck
+
+	batch := []byte(`[` +
+		`{"jsonrpc":"2.0","method":"` + rpc.ChainID + `","params":[],"id":1},` +
+		`"not a request",` +
+		`{"jsonrpc":"2.0","method":"` + rpc.ChainID + `","params":[]},` +
+		`{"jsonrpc":"2.0","method":"` + rpc.GetBalance + `","params":[],"id":3},` +
+		`{"jsonrpc":"2.0","method":"` + rpc.Subscribe + `","params":["newHeads"],"id":4}]`)
+
+	conn, err := openWSConn(walletWSPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, respBody := range [][]byte{
+		makeRequestHTTP(fmt.Sprintf("http://%s:%d/v1/", common.Localhost, walletHTTPPort), batch),
+		issueRequestWS(conn, batch),
+	} {
+		var responses []map[string]json.RawMessage
+		if err := json.Unmarshal(respBody, &responses); err != nil {
+			t.Fatalf("expected a batch response, got '%s'", string(respBody))
+		}
+		// the responses are in the order of the requests, each failed request gets its own error, the notification gets
+		// no response and the subscription is refused
+		assert.Len(t, responses, 4)
+		assert.Equal(t, "1", string(responses[0]["id"]))
+		assert.Contains(t, string(responses[0]["result"]), l2ChainIDHex)
+		assert.Equal(t, "null", string(responses[1]["id"]))
+		assert.Contains(t, string(responses[1]["error"]), "-32600")
+		assert.Equal(t, "3", string(responses[2]["id"]))
+		assert.Contains(t, string(responses[2]["error"]), fmt.Sprintf(accountmanager.ErrNoViewingKey, rpc.GetBalance))
+		assert.Equal(t, "4", string(responses[3]["id"]))
+		assert.Contains(t, string(responses[3]["error"]), "not supported in batch requests")
+	}
+
+	// a batch of notifications gets no response at all
+	notifications := []byte(`[{"jsonrpc":"2.0","method":"` + rpc.ChainID + `","params":[]}]`)
+	respBody := makeRequestHTTP(fmt.Sprintf("http://%s:%d/v1/", common.Localhost, walletHTTPPort), notifications)
+	assert.Empty(t, respBody)
+
+	tooLarge := []byte(`[{"jsonrpc":"2.0","method":"` + rpc.ChainID + `","params":[],"id":1}` +
+		strings.Repeat(`,{"jsonrpc":"2.0","method":"`+rpc.ChainID+`","params":[],"id":1}`, 5) + `]`)
+	respBody = makeRequestHTTP(fmt.Sprintf("http://%s:%d/v1/", common.Localhost, walletHTTPPort), tooLarge)
+	assert.Contains(t, string(respBody), "the maximum is 5")
+}
+
+func TestSessions(t *testing.T) {
+	walletHTTPPort := _hostWSPort + 1
+	walletWSPort := _hostWSPort + 2
+
+	_, shutdownHost := createDummyHost(t, _hostWSPort)
+	defer shutdownHost() //nolint: errcheck
+
+	shutdownWallet := createWalExt(t, createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet() //nolint: errcheck
+
+	httpURL := fmt.Sprintf("http://%s:%d", common.Localhost, walletHTTPPort)
+	gatewayLib := lib.NewTenGatewayLibrary(httpURL, fmt.Sprintf("ws://%s:%d", common.Localhost, walletWSPort))
+	require.NoError(t, gatewayLib.Join())
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, gatewayLib.RegisterAccount(privateKey, crypto.PubkeyToAddress(privateKey.PublicKey)))
+
+	// the user ID is the token of the initial session, which can be refreshed with a signature of a registered account
+	require.NoError(t, gatewayLib.RefreshSession(privateKey))
+
+	// a read-only session can't send transactions
+	readOnlyToken := string(makeRequestHTTP(httpURL+"/v1/session/?token="+gatewayLib.UserID(), []byte(`{"readOnly": true, "ttl": "1h"}`)))
+	require.Len(t, readOnlyToken, common.MessageUserIDLen)
+	respBody := makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.SendRawTransaction, []interface{}{"0x00"}, readOnlyToken)
+	assert.Contains(t, string(respBody), "read-only")
+
+	// the sessions are listed without their tokens
+	var sessions []walletextension.SessionInfo
+	require.NoError(t, json.Unmarshal(makeHTTPEthJSONReqWithPath(walletHTTPPort, "v1/sessions/?token="+readOnlyToken), &sessions))
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.NotContains(t, []string{gatewayLib.UserID(), readOnlyToken}, session.ID)
+		assert.Equal(t, session.ReadOnly, session.Current)
+	}
+
+	// a session can't be widened, e.g. to an account that is not registered
+	respBody = makeRequestHTTP(httpURL+"/v1/session/?token="+readOnlyToken, []byte(`{"accounts": ["`+gethcommon.Address{1}.Hex()+`"]}`))
+	assert.Contains(t, string(respBody), "is not available to the session")
+}
+
+func TestAdminAPI(t *testing.T) {
+	walletHTTPPort := _hostWSPort + 1
+	walletWSPort := _hostWSPort + 2
+	adminAPIKey := "test-admin-key"
+
+	_, shutdownHost := createDummyHost(t, _hostWSPort)
+	defer shutdownHost() //nolint: errcheck
+
+	auditLogPath := filepath.Join(t.TempDir(), "gateway_audit.log")
+	walExtCfg := createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort)
+	walExtCfg.AdminAPIKey = adminAPIKey
+	walExtCfg.AuditLogPath = auditLogPath
+	shutdownWallet := createWalExt(t, walExtCfg)
+	defer shutdownWallet() //nolint: errcheck
+
+	httpURL := fmt.Sprintf("http://%s:%d", common.Localhost, walletHTTPPort)
+	gatewayLib := lib.NewTenGatewayLibrary(httpURL, fmt.Sprintf("ws://%s:%d", common.Localhost, walletWSPort))
+	require.NoError(t, gatewayLib.Join())
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+	require.NoError(t, gatewayLib.RegisterAccount(privateKey, address))
+
+	// the admin API requires the admin API key
+	assert.Contains(t, string(makeAdminRequestHTTP(http.MethodGet, httpURL+common.PathAdminUsers, "wrong-key")), "unauthorised")
+
+	// the users are listed with their accounts, but without their user IDs
+	usersResp := makeAdminRequestHTTP(http.MethodGet, httpURL+common.PathAdminUsers, adminAPIKey)
+	assert.NotContains(t, string(usersResp), gatewayLib.UserID())
+	var users []walletextension.UserSummary
+	require.NoError(t, json.Unmarshal(usersResp, &users))
+	require.Len(t, users, 1)
+	assert.Equal(t, []string{address.Hex()}, users[0].Accounts)
+
+	var stats walletextension.GatewayStats
+	require.NoError(t, json.Unmarshal(makeAdminRequestHTTP(http.MethodGet, httpURL+common.PathAdminStats, adminAPIKey), &stats))
+	assert.Equal(t, 1, stats.Users)
+	assert.Equal(t, 1, stats.Accounts)
+
+	// the user is deleted by its reference
+	deleteURL := fmt.Sprintf("%s%s?%s=%s", httpURL, common.PathAdminDeleteUser, common.AdminUserQueryParameter, users[0].User)
+	assert.Equal(t, common.SuccessMsg, string(makeAdminRequestHTTP(http.MethodPost, deleteURL, adminAPIKey)))
+	require.NoError(t, json.Unmarshal(makeAdminRequestHTTP(http.MethodGet, httpURL+common.PathAdminUsers, adminAPIKey), &users))
+	assert.Empty(t, users)
+
+	// the auth events are in the audit log, without the user ID
+	auditLog, err := os.ReadFile(auditLogPath)
+	require.NoError(t, err)
+	for _, event := range []string{"join", "authenticate", "admin_delete_user"} {
+		assert.Contains(t, string(auditLog), `"msg":"`+event+`"`)
+	}
+	assert.NotContains(t, string(auditLog), gatewayLib.UserID())
+}
+
+func TestPollingFilters(t *testing.T) {
+	walletHTTPPort := _hostWSPort + 1
+	walletWSPort := _hostWSPort + 2
+
+	_, shutdownHost := createDummyHost(t, _hostWSPort)
+	defer shutdownHost() //nolint: errcheck
+
+	shutdownWallet := createWalExt(t, createWalExtCfg(_hostWSPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet() //nolint: errcheck
+
+	httpURL := fmt.Sprintf("http://%s:%d", common.Localhost, walletHTTPPort)
+	gatewayLib := lib.NewTenGatewayLibrary(httpURL, fmt.Sprintf("ws://%s:%d", common.Localhost, walletWSPort))
+	require.NoError(t, gatewayLib.Join())
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, gatewayLib.RegisterAccount(privateKey, crypto.PubkeyToAddress(privateKey.PublicKey)))
+
+	var filterResp struct {
+		Result string `json:"result"`
+	}
+	respBody := makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.NewBlockFilter, []interface{}{}, gatewayLib.UserID())
+	require.NoError(t, json.Unmarshal(respBody, &filterResp))
+	// the filter of the user has its own ID, which is mapped to the filter on the node
+	require.NotEmpty(t, filterResp.Result)
+	assert.NotEqual(t, dummyFilterID, filterResp.Result)
+
+	var changesResp struct {
+		Result []gethcommon.Hash `json:"result"`
+	}
+	respBody = makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.GetFilterChanges, []interface{}{filterResp.Result}, gatewayLib.UserID())
+	require.NoError(t, json.Unmarshal(respBody, &changesResp))
+	assert.Equal(t, []gethcommon.Hash{dummyBatchHash}, changesResp.Result)
+
+	respBody = makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.UninstallFilter, []interface{}{filterResp.Result}, gatewayLib.UserID())
+	assert.Contains(t, string(respBody), `"result":true`)
+	respBody = makeHTTPEthJSONReqWithUserID(walletHTTPPort, rpc.GetFilterChanges, []interface{}{filterResp.Result}, gatewayLib.UserID())
+	assert.Contains(t, string(respBody), "filter not found")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/tools/walletextension/accountmanager"
 	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage"
 )
 
@@ -18,19 +19,17 @@ type UserAccountManager struct {
 	userAccountManager    map[string]*accountmanager.AccountManager
 	unauthenticatedClient rpc.Client
 	storage               storage.Storage
-	hostRPCBinAddrHTTP    string
-	hostRPCBinAddrWS      string
+	nodePool              *nodepool.Pool
 	logger                gethlog.Logger
 	mu                    sync.Mutex
 }
 
-func NewUserAccountManager(unauthenticatedClient rpc.Client, logger gethlog.Logger, storage storage.Storage, hostRPCBindAddrHTTP string, hostRPCBindAddrWS string) UserAccountManager {
+func NewUserAccountManager(unauthenticatedClient rpc.Client, logger gethlog.Logger, storage storage.Storage, nodePool *nodepool.Pool) UserAccountManager {
 	return UserAccountManager{
 		userAccountManager:    make(map[string]*accountmanager.AccountManager),
 		unauthenticatedClient: unauthenticatedClient,
 		storage:               storage,
-		hostRPCBinAddrHTTP:    hostRPCBindAddrHTTP,
-		hostRPCBinAddrWS:      hostRPCBindAddrWS,
+		nodePool:              nodePool,
 		logger:                logger,
 	}
 }
@@ -43,7 +42,7 @@ func (m *UserAccountManager) AddAndReturnAccountManager(userID string) *accountm
 	if exists {
 		return existingUserAccountManager
 	}
-	newAccountManager := accountmanager.NewAccountManager(userID, m.unauthenticatedClient, m.hostRPCBinAddrWS, m.storage, m.logger)
+	newAccountManager := accountmanager.NewAccountManager(userID, m.unauthenticatedClient, m.nodePool, m.storage, m.logger)
 	m.userAccountManager[userID] = newAccountManager
 	return newAccountManager
 }
@@ -96,7 +95,7 @@ func (m *UserAccountManager) GetUserAccountManager(userID string) (*accountmanag
 		}
 
 		// create a new client
-		encClient, err := wecommon.CreateEncClient(m.hostRPCBinAddrWS, account.AccountAddress, userPrivateKey, account.Signature, m.logger)
+		encClient, err := wecommon.CreateEncClient(m.nodePool.Client(), account.AccountAddress, userPrivateKey, account.Signature, m.logger)
 		if err != nil {
 			m.logger.Error(fmt.Errorf("error creating new client, %w", err).Error())
 		}
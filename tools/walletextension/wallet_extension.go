@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ten-protocol/go-ten/tools/walletextension/activity"
 	"github.com/ten-protocol/go-ten/tools/walletextension/cache"
+	"github.com/ten-protocol/go-ten/tools/walletextension/nodepool"
+	"github.com/ten-protocol/go-ten/tools/walletextension/ratelimiter"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/accountmanager"
 
@@ -34,8 +37,7 @@ import (
 
 // WalletExtension handles the management of viewing keys and the forwarding of Ethereum JSON-RPC requests.
 type WalletExtension struct {
-	hostAddrHTTP       string // The HTTP address on which the Ten host can be reached
-	hostAddrWS         string // The WS address on which the Ten host can be reached
+	nodePool           *nodepool.Pool // The Ten nodes the requests are balanced over
 	userAccountManager *useraccountmanager.UserAccountManager
 	unsignedVKs        map[gethcommon.Address]*viewingkey.ViewingKey // Map temporarily holding VKs that have been generated but not yet signed
 	storage            storage.Storage
@@ -46,34 +48,35 @@ type WalletExtension struct {
 	config             *config.Config
 	tenClient          *obsclient.ObsClient
 	cache              cache.Cache
+	headTracker        *cache.HeadTracker
+	rateLimiter        *ratelimiter.RateLimiter
+	activity           *activity.Tracker
+	auditLog           *activity.AuditLog
 }
 
 func New(
-	hostAddrHTTP string,
-	hostAddrWS string,
+	nodePool *nodepool.Pool,
 	userAccountManager *useraccountmanager.UserAccountManager,
 	storage storage.Storage,
+	rateLimiter *ratelimiter.RateLimiter,
 	stopControl *stopcontrol.StopControl,
 	version string,
 	logger gethlog.Logger,
 	config *config.Config,
 ) *WalletExtension {
-	rpcClient, err := rpc.NewNetworkClient(hostAddrHTTP)
-	if err != nil {
-		logger.Error(fmt.Errorf("could not create RPC client on %s. Cause: %w", hostAddrHTTP, err).Error())
-		panic(err)
-	}
-	newTenClient := obsclient.NewObsClient(rpcClient)
+	newTenClient := obsclient.NewObsClient(nodePool.Client())
 	newFileLogger := common.NewFileLogger()
 	newGatewayCache, err := cache.NewCache(logger)
 	if err != nil {
 		logger.Error(fmt.Errorf("could not create cache. Cause: %w", err).Error())
 		panic(err)
 	}
+	// the cache entries for the latest state are invalidated when the node has a new head batch
+	headTracker := cache.NewHeadTracker(nodePool, stopControl, logger)
+	headTracker.Start()
 
-	return &WalletExtension{
-		hostAddrHTTP:       hostAddrHTTP,
-		hostAddrWS:         hostAddrWS,
+	walletExtension := &WalletExtension{
+		nodePool:           nodePool,
 		userAccountManager: userAccountManager,
 		unsignedVKs:        map[gethcommon.Address]*viewingkey.ViewingKey{},
 		storage:            storage,
@@ -84,7 +87,18 @@ func New(
 		config:             config,
 		tenClient:          newTenClient,
 		cache:              newGatewayCache,
+		headTracker:        headTracker,
+		rateLimiter:        rateLimiter,
+		activity:           activity.NewTracker(),
+		auditLog:           activity.NewAuditLog(config.AuditLogPath, config.AuditLogMaxSizeMB, config.AuditLogMaxBackups),
 	}
+	go walletExtension.collectExpiredSessions()
+	return walletExtension
+}
+
+// Stop closes the audit log
+func (w *WalletExtension) Stop() error {
+	return w.auditLog.Close()
 }
 
 // IsStopping returns whether the WE is stopping
@@ -92,13 +106,51 @@ func (w *WalletExtension) IsStopping() bool {
 	return w.stopControl.IsStopping()
 }
 
+// MaxBatchSize returns the maximum number of requests in a JSON-RPC batch
+func (w *WalletExtension) MaxBatchSize() int {
+	if w.config.MaxBatchSize <= 0 {
+		return common.DefaultMaxBatchSize
+	}
+	return w.config.MaxBatchSize
+}
+
 // Logger returns the WE set logger
 func (w *WalletExtension) Logger() gethlog.Logger {
 	return w.logger
 }
 
-// ProxyEthRequest proxys an incoming user request to the enclave
-func (w *WalletExtension) ProxyEthRequest(request *common.RPCRequest, conn userconn.UserConn, hexUserID string) (map[string]interface{}, error) {
+// ProxyEthRequest proxys an incoming user request to the enclave, on behalf of the given session
+func (w *WalletExtension) ProxyEthRequest(request *common.RPCRequest, conn userconn.UserConn, session *common.SessionDB) (map[string]interface{}, error) {
+	response, err := w.proxyEthRequest(request, conn, session)
+	w.activity.Record(hex.EncodeToString(session.UserID), err)
+	return response, err
+}
+
+// ClientIP returns the IP of the client of the connection
+func (w *WalletExtension) ClientIP(conn userconn.UserConn) string {
+	return ratelimiter.ClientIP(conn.GetHTTPRequest(), w.config.TrustForwardedForAddr)
+}
+
+// AllowEndpointRequest applies the IP limits to a request to a gateway endpoint (e.g. ratelimiter.JoinMethod), so
+// clients can't create users or add accounts at will
+func (w *WalletExtension) AllowEndpointRequest(conn userconn.UserConn, endpoint string) error {
+	clientIP := w.ClientIP(conn)
+	if err := w.rateLimiter.Allow("", clientIP, endpoint); err != nil {
+		w.logger.Debug("Request over the rate limits", "endpoint", endpoint, "ip", clientIP, log.ErrKey, err)
+		return err
+	}
+	return nil
+}
+
+// RecordAuditEvent writes an auth event of a user to the audit log
+func (w *WalletExtension) RecordAuditEvent(conn userconn.UserConn, eventType string, userID []byte, account *gethcommon.Address, err error) {
+	w.auditLog.Record(activity.AuditEvent{Type: eventType, UserID: userID, Account: account, IP: w.ClientIP(conn), Err: err})
+}
+
+func (w *WalletExtension) proxyEthRequest(request *common.RPCRequest, conn userconn.UserConn, session *common.SessionDB) (map[string]interface{}, error) {
+	hexUserID := hex.EncodeToString(session.UserID)
+	hexToken := hex.EncodeToString(session.SessionID)
+
 	response := map[string]interface{}{}
 	// all responses must contain the request id. Both successful and unsuccessful.
 	response[common.JSONKeyRPCVersion] = jsonrpc.Version
@@ -107,8 +159,23 @@ func (w *WalletExtension) ProxyEthRequest(request *common.RPCRequest, conn userc
 	// start measuring time for request
 	requestStartTime := time.Now()
 
-	// Check if the request is in the cache
-	isCacheable, key, ttl := cache.IsCacheable(request, hexUserID)
+	// the requests without a user ID are only limited by their IP
+	limitedUserID := hexUserID
+	if hexUserID == hex.EncodeToString([]byte(common.DefaultUser)) {
+		limitedUserID = ""
+	}
+	clientIP := w.ClientIP(conn)
+	if err := w.rateLimiter.Allow(limitedUserID, clientIP, request.Method); err != nil {
+		w.logger.Debug("Request over the rate limits", "method", request.Method, "user", limitedUserID, "ip", clientIP, log.ErrKey, err)
+		return nil, err
+	}
+
+	if err := w.checkSessionAllowsRequest(session, request); err != nil {
+		return nil, err
+	}
+
+	// Check if the request is in the cache (per session, as the sessions of a user can use different accounts)
+	isCacheable, key, ttl := cache.IsCacheable(request, hexToken, w.headTracker.Head())
 
 	// in case of cache hit return the response from the cache
 	if isCacheable {
@@ -133,7 +200,7 @@ func (w *WalletExtension) ProxyEthRequest(request *common.RPCRequest, conn userc
 
 	// wallet extension can override the GetStorageAt to retrieve the current userID
 	if request.Method == rpc.GetStorageAt {
-		if interceptedResponse := w.getStorageAtInterceptor(request, hexUserID); interceptedResponse != nil {
+		if interceptedResponse := w.getStorageAtInterceptor(request, session); interceptedResponse != nil {
 			w.logger.Info("interception successful for getStorageAt, returning userID response")
 			requestEndTime := time.Now()
 			duration := requestEndTime.Sub(requestStartTime)
@@ -163,7 +230,7 @@ func (w *WalletExtension) ProxyEthRequest(request *common.RPCRequest, conn userc
 		return nil, err
 	}
 
-	err = selectedAccountManager.ProxyRequest(request, &rpcResp, conn)
+	err = selectedAccountManager.ProxyRequest(request, &rpcResp, conn, sessionScope(session))
 	if err != nil {
 		if errors.Is(err, rpc.ErrNilResponse) {
 			// if err was for a nil response then we will return an RPC result of null to the caller (this is a valid "not-found" response for some methods)
@@ -236,7 +303,7 @@ func (w *WalletExtension) SubmitViewingKey(address gethcommon.Address, signature
 	}
 	// create an encrypted RPC client with the signed VK and register it with the enclave
 	// todo (@ziga) - Create the clients lazily, to reduce connections to the host.
-	client, err := rpc.NewEncNetworkClient(w.hostAddrHTTP, vk, w.logger)
+	client, err := rpc.NewEncRPCClient(w.nodePool.Client(), vk, w.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create encrypted RPC client for account %s - %w", address, err)
 	}
@@ -281,6 +348,13 @@ func (w *WalletExtension) GenerateAndStoreNewUser() (string, error) {
 		return "", err
 	}
 
+	// the user gets an initial session, whose token is the user ID
+	err = w.storage.AddSession(newInitialSession(userID, w.SessionTTL()))
+	if err != nil {
+		w.Logger().Error(fmt.Sprintf("failed to save session to the database: %s", err))
+		return "", err
+	}
+
 	hexUserID := hex.EncodeToString(userID)
 
 	w.userAccountManager.AddAndReturnAccountManager(hexUserID)
@@ -324,7 +398,7 @@ func (w *WalletExtension) AddAddressToUser(hexUserID string, address string, sig
 
 	accManager := w.userAccountManager.AddAndReturnAccountManager(hexUserID)
 
-	encClient, err := common.CreateEncClient(w.hostAddrHTTP, addressFromMessage.Bytes(), privateKeyBytes, signature, w.Logger())
+	encClient, err := common.CreateEncClient(w.nodePool.Client(), addressFromMessage.Bytes(), privateKeyBytes, signature, w.Logger())
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error creating encrypted client for user: (%s), %w", hexUserID, err).Error())
 		return fmt.Errorf("error creating encrypted client for user: (%s), %w", hexUserID, err)
@@ -385,6 +459,8 @@ func (w *WalletExtension) DeleteUser(hexUserID string) error {
 		return err
 	}
 
+	w.activity.Delete(hexUserID)
+
 	// Delete UserAccountManager for user that revoked userID
 	err = w.userAccountManager.DeleteUserAccountManager(hexUserID)
 	if err != nil {
@@ -425,34 +501,21 @@ func adjustStateRoot(rpcResp interface{}, respMap map[string]interface{}) {
 
 // getStorageAtInterceptor checks if the parameters for getStorageAt are set to values that require interception
 // and return response or nil if the gateway should forward the request to the node.
-func (w *WalletExtension) getStorageAtInterceptor(request *common.RPCRequest, hexUserID string) map[string]interface{} {
+// The intercepted response is the token of the session the request is made with (the userID for the initial session).
+func (w *WalletExtension) getStorageAtInterceptor(request *common.RPCRequest, session *common.SessionDB) map[string]interface{} {
 	// check if parameters are correct, and we can intercept a request, otherwise return nil
 	if w.checkParametersForInterceptedGetStorageAt(request.Params) {
-		// check if userID in the parameters is also in our database
-		userID, err := common.GetUserIDbyte(hexUserID)
-		if err != nil {
-			w.logger.Warn("GetStorageAt called with appropriate parameters to return userID, but not found in the database: ", "userId", hexUserID)
-			return nil
-		}
+		response := map[string]interface{}{}
+		response[common.JSONKeyRPCVersion] = jsonrpc.Version
+		response[common.JSONKeyID] = request.ID
 
 		// check if we have default user (we don't want to send userID of it out)
-		if hexUserID == hex.EncodeToString([]byte(common.DefaultUser)) {
-			response := map[string]interface{}{}
-			response[common.JSONKeyRPCVersion] = jsonrpc.Version
-			response[common.JSONKeyID] = request.ID
+		if bytes.Equal(session.UserID, []byte(common.DefaultUser)) {
 			response[common.JSONKeyResult] = fmt.Sprintf(accountmanager.ErrNoViewingKey, "eth_getStorageAt")
 			return response
 		}
 
-		_, err = w.storage.GetUserPrivateKey(userID)
-		if err != nil {
-			w.logger.Info("Trying to get userID, but it is not present in our database: ", log.ErrKey, err)
-			return nil
-		}
-		response := map[string]interface{}{}
-		response[common.JSONKeyRPCVersion] = jsonrpc.Version
-		response[common.JSONKeyID] = request.ID
-		response[common.JSONKeyResult] = hexUserID
+		response[common.JSONKeyResult] = hex.EncodeToString(session.SessionID)
 		return response
 	}
 	w.logger.Info(fmt.Sprintf("parameters used in the request do not match requited parameters for interception: %s", request.Params))